@@ -0,0 +1,137 @@
+// Command gen-dev-certs creates a throwaway CA plus a server and a client
+// certificate signed by it, for trying out TLS and mutual TLS locally. The
+// files are not meant for production use.
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func main() {
+	outDir := flag.String("out", "cert/dev", "directory to write the certificates to")
+	hosts := flag.String("hosts", "localhost,127.0.0.1,::1", "comma separated DNS names and IPs for the server certificate")
+	clientName := flag.String("client-cn", "dev-client", "common name of the client certificate, used as its principal")
+	validFor := flag.Duration("valid-for", 365*24*time.Hour, "certificate lifetime")
+	flag.Parse()
+
+	if err := os.MkdirAll(*outDir, 0o700); err != nil {
+		log.Fatalf("Failed to create output directory: %v", err)
+	}
+
+	caCert, caKey, err := newCertificate(&x509.Certificate{
+		Subject:               pkix.Name{CommonName: "school-mgmt dev CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}, nil, nil, *validFor)
+	if err != nil {
+		log.Fatalf("Failed to create CA: %v", err)
+	}
+
+	serverTemplate := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "school-mgmt server"},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	for _, host := range strings.Split(*hosts, ",") {
+		host = strings.TrimSpace(host)
+		if ip := net.ParseIP(host); ip != nil {
+			serverTemplate.IPAddresses = append(serverTemplate.IPAddresses, ip)
+		} else if host != "" {
+			serverTemplate.DNSNames = append(serverTemplate.DNSNames, host)
+		}
+	}
+	serverCert, serverKey, err := newCertificate(serverTemplate, caCert, caKey, *validFor)
+	if err != nil {
+		log.Fatalf("Failed to create server certificate: %v", err)
+	}
+
+	clientCert, clientKey, err := newCertificate(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: *clientName},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, caCert, caKey, *validFor)
+	if err != nil {
+		log.Fatalf("Failed to create client certificate: %v", err)
+	}
+
+	files := []struct {
+		name string
+		cert *x509.Certificate
+		key  *ecdsa.PrivateKey
+	}{
+		{"ca", caCert, caKey},
+		{"server", serverCert, serverKey},
+		{"client", clientCert, clientKey},
+	}
+	for _, f := range files {
+		if err := writePEM(filepath.Join(*outDir, f.name+".pem"), f.cert, f.key); err != nil {
+			log.Fatalf("Failed to write %s certificate: %v", f.name, err)
+		}
+	}
+
+	fmt.Printf("Wrote ca, server and client certificates to %s\n", *outDir)
+	fmt.Printf("Start the server with:\n  -tls-cert %[1]s/server.pem -tls-key %[1]s/server-key.pem -tls-client-ca %[1]s/ca.pem\n", *outDir)
+	fmt.Printf("and map the client with TLS_CLIENT_PRINCIPALS=%s=<role>\n", *clientName)
+}
+
+// newCertificate generates a key and signs template with parent. A nil parent
+// makes the certificate self-signed.
+func newCertificate(template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, validFor time.Duration) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	template.SerialNumber = serial
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(validFor)
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// writePEM writes the certificate to path and the key next to it as
+// <name>-key.pem.
+func writePEM(path string, cert *x509.Certificate, key *ecdsa.PrivateKey) error {
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	if err := os.WriteFile(path, certPEM, 0o644); err != nil {
+		return err
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	keyPath := strings.TrimSuffix(path, ".pem") + "-key.pem"
+	return os.WriteFile(keyPath, keyPEM, 0o600)
+}
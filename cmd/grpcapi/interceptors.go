@@ -0,0 +1,47 @@
+package main
+
+import (
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/api/interceptors"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/tracing"
+	"google.golang.org/grpc"
+)
+
+// interceptorChains builds the unary and stream interceptor chains in the
+// order they run. Recovery comes first so a panic anywhere in the chain,
+// tracing included, is turned into an Internal error.
+func interceptorChains(cfg *config.Config) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		interceptors.RecoveryInterceptor,
+		tracing.WrapUnary("request_id", interceptors.RequestIDInterceptor),
+		tracing.WrapUnary("metrics", interceptors.MetricsUnaryInterceptor),
+		tracing.WrapUnary("response_time", interceptors.ResponseTimeInterceptor),
+		tracing.WrapUnary("authentication", interceptors.AuthenticationInterceptor),
+	}
+	// Streams go through the same checks, so a streaming RPC can't bypass them
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		interceptors.RecoveryStreamInterceptor,
+		tracing.WrapStream("request_id", interceptors.RequestIDStreamInterceptor),
+		tracing.WrapStream("metrics", interceptors.MetricsStreamInterceptor),
+		tracing.WrapStream("response_time", interceptors.ResponseTimeStreamInterceptor),
+		tracing.WrapStream("authentication", interceptors.AuthenticationStreamInterceptor),
+	}
+	// Disable rate limiting while benchmarking
+	if cfg.RateLimit.Enabled {
+		r := interceptors.NewRateLimiter(cfg.RateLimit)
+		unaryInterceptors = append(unaryInterceptors, tracing.WrapUnary("rate_limit", r.RateLimitInterceptor))
+		streamInterceptors = append(streamInterceptors, tracing.WrapStream("rate_limit", r.RateLimitStreamInterceptor))
+	}
+	streamInterceptors = append(streamInterceptors,
+		tracing.WrapStream("authorization", interceptors.AuthorizationStreamInterceptor),
+		tracing.WrapStream("validation", interceptors.ValidationStreamInterceptor),
+		tracing.WrapStream("error_mapping", interceptors.ErrorMappingStreamInterceptor),
+	)
+	unaryInterceptors = append(unaryInterceptors,
+		tracing.WrapUnary("audit", interceptors.AuditInterceptor),
+		tracing.WrapUnary("validation", interceptors.ValidationInterceptor),
+		tracing.HandlerInterceptor,
+		interceptors.ErrorMappingInterceptor,
+	)
+	return unaryInterceptors, streamInterceptors
+}
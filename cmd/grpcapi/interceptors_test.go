@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// streamTestDesc is a server-streaming service that isn't listed in
+// streamMethodRoles, so only the chain decides whether it can be opened.
+var streamTestDesc = grpc.ServiceDesc{
+	ServiceName: "test.Streamer",
+	HandlerType: (*interface{})(nil),
+	Streams: []grpc.StreamDesc{{
+		StreamName:    "Watch",
+		ServerStreams: true,
+		Handler: func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		},
+	}},
+}
+
+// panicTestDesc has a unary method whose handler panics.
+var panicTestDesc = grpc.ServiceDesc{
+	ServiceName: "test.Panicker",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Panic",
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := new(emptypb.Empty)
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/test.Panicker/Panic"}
+			return interceptor(ctx, in, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				panic("boom")
+			})
+		},
+	}},
+}
+
+// newTestServer serves the real interceptor chains over an in-memory
+// listener and returns a client connection to it.
+func newTestServer(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+
+	cfg := config.Default()
+	cfg.RateLimit.Enabled = false
+	utils.ConfigureJWT("test-secret", time.Minute)
+
+	unaryInterceptors, streamInterceptors := interceptorChains(&cfg)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	s.RegisterService(&streamTestDesc, struct{}{})
+	s.RegisterService(&panicTestDesc, struct{}{})
+	reflection.Register(s)
+
+	lis := bufconn.Listen(1 << 20)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestStreamChainAuth(t *testing.T) {
+	conn := newTestServer(t)
+
+	token, err := utils.SignToken("507f1f77bcf86cd799439011", "admin", "admin")
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  codes.Code
+	}{
+		{"unauthenticated", "", codes.Unauthenticated},
+		{"invalid token", "not-a-jwt", codes.Unauthenticated},
+		{"authenticated but not allowed", token, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if tt.token != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+tt.token)
+			}
+
+			stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/test.Streamer/Watch")
+			if err != nil {
+				t.Fatalf("open stream: %v", err)
+			}
+			if err := stream.CloseSend(); err != nil {
+				t.Fatalf("close send: %v", err)
+			}
+			err = stream.RecvMsg(&struct{}{})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("got %v (%v), want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestReflectionWithoutAuth(t *testing.T) {
+	conn := newTestServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		t.Fatalf("open reflection stream: %v", err)
+	}
+	err = stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("list services: %v", err)
+	}
+
+	found := false
+	for _, svc := range resp.GetListServicesResponse().GetService() {
+		if svc.Name == "test.Streamer" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("test.Streamer not listed in %v", resp.GetListServicesResponse())
+	}
+}
+
+func TestUnaryChainRecoversPanic(t *testing.T) {
+	conn := newTestServer(t)
+
+	token, err := utils.SignToken("507f1f77bcf86cd799439011", "admin", "admin")
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+
+	err = conn.Invoke(ctx, "/test.Panicker/Panic", &emptypb.Empty{}, &emptypb.Empty{})
+	if got := status.Code(err); got != codes.Internal {
+		t.Errorf("got %v (%v), want %v", got, err, codes.Internal)
+	}
+	if msg := status.Convert(err).Message(); msg != "internal error" {
+		t.Errorf("panic value leaked to the client: %q", msg)
+	}
+}
@@ -2,105 +2,264 @@ package main
 
 import (
 	"context"
-	"embed"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
-	// "time"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/api/handlers"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/api/interceptors"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/health"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/metrics"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/notifications"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/tlsconfig"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/tracing"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
 	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
-	"github.com/joho/godotenv"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	// "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
-//go:embed .env
-var envFile embed.FS
+func main() {
 
-func loadEnvFromEmbeddedFile() {
-	content, err := envFile.ReadFile((".env"))
+	// Load configuration: defaults, then the YAML file, environment and flags
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	printConfig := fs.Bool("print-config", false, "print the effective configuration (secrets redacted) and exit")
+	cfg, err := config.Load(fs, os.Args[1:])
 	if err != nil {
-		log.Fatalf("Error reading .env file: %v", err)
+		log.Fatalf("Invalid configuration: %v", err)
 	}
-
-	tempFile, err := os.CreateTemp("", ".env")
-	if err != nil {
-		log.Fatalf("Error creating .env file: %v", err)
+	if *printConfig {
+		fmt.Print(cfg.Dump())
+		return
 	}
-	defer os.Remove(tempFile.Name())
 
-	_, err = tempFile.Write(content)
-	if err != nil {
-		log.Fatalf("Error writing to temp file: %v", err)
+	utils.InitLogger(cfg.Log.Level, cfg.Log.Format)
+	utils.ConfigureJWT(cfg.Auth.JWTSecret, cfg.Auth.JWTExpiresIn)
+	mongodb.Configure(cfg)
+	interceptors.Configure(cfg)
+	if unknown := mongodb.UnknownRetainedCollections(); len(unknown) > 0 {
+		log.Fatalf("Invalid configuration: privacy.retain has unknown collections %v", unknown)
 	}
 
-	err = tempFile.Close()
-	if err != nil {
-		log.Fatalf("Error writing to temp file: %v", err)
-	}
+	// Cancelled once the server has drained, to stop background goroutines
+	bgCtx, cancelBackground := context.WithCancel(context.Background())
+	defer cancelBackground()
+	var background sync.WaitGroup
 
-	err = godotenv.Load(tempFile.Name())
-	if err != nil {
-		log.Fatalf("Error loading .env file: %v", err)
+	// TLS, optionally mutual, with certificates reloaded when the files change
+	serverOpts := []grpc.ServerOption{}
+	if cfg.TLS.CertFile != "" {
+		reloader, err := tlsconfig.NewReloader(cfg.TLS)
+		if err != nil {
+			log.Fatalf("Failed to load TLS credentials: %v", err)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(reloader.ServerConfig())))
+		goBackground(&background, func() { reloader.Run(bgCtx) })
+		utils.Logger.Info("tls enabled", "mtls", cfg.TLS.ClientCAFile != "")
 	}
-}
-
-func main() {
-
-	// Load env
-	// err := godotenv.Load()
-	// if err != nil {
-	// 	log.Fatalf("Error loading .env file: %v", err)
-	// }
-	loadEnvFromEmbeddedFile()
-
-	// cert := os.Getenv("CERT_FILE")
-	// key := os.Getenv("KEY_FILE")
-
-	// creds, err := credentials.NewServerTLSFromFile(cert, key)
-	// if err != nil {
-	// 	log.Fatalf("Failed to load TLS credentials: %v", err)
-	// }
 
 	// Connect MongoDB
 	client, err := mongodb.CreateMongoClient(context.Background())
 	if err != nil {
 		log.Fatalf("MongoDB connection failed: %v", err)
 	}
-	defer client.Disconnect(context.Background())
 
-	// Not using while benchmarking
-	// r := interceptors.NewRateLimiter(50, time.Minute)
-	// s := grpc.NewServer(grpc.ChainUnaryInterceptor(r.RateLimitInterceptor, interceptors.ResponseTimeInterceptor, interceptors.AuthenticationInterceptor), grpc.Creds(creds))
+	// Tracing; the stats handler extracts W3C trace context from incoming
+	// metadata and opens the server span the rest of the chain nests under
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.Tracing)
+	if err != nil {
+		log.Fatalf("Failed to set up tracing: %v", err)
+	}
 
-	s := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors.ResponseTimeInterceptor, interceptors.AuthenticationInterceptor))
+	if cfg.RateLimit.Enabled && cfg.RateLimit.Store == "mongo" {
+		err = mongodb.EnsureRateLimitIndexesDBHandler(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to create rate limit indexes: %v", err)
+		}
+	}
+	unaryInterceptors, streamInterceptors := interceptorChains(cfg)
+
+	serverOpts = append(serverOpts,
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	s := grpc.NewServer(serverOpts...)
 
 	pb.RegisterTeachersServiceServer(s, &handlers.Server{})
 	pb.RegisterStudentsServiceServer(s, &handlers.Server{})
 	pb.RegisterExecsServiceServer(s, &handlers.Server{})
+	pb.RegisterNotificationsServiceServer(s, &handlers.Server{})
+	pb.RegisterAuditServiceServer(s, &handlers.Server{})
+	pb.RegisterPrivacyServiceServer(s, &handlers.Server{})
+	pb.RegisterDuplicatesServiceServer(s, &handlers.Server{})
+	pb.RegisterClassesServiceServer(s, &handlers.Server{})
+	pb.RegisterSubjectsServiceServer(s, &handlers.Server{})
+	pb.RegisterAttendanceServiceServer(s, &handlers.Server{})
+	pb.RegisterStatisticsServiceServer(s, &handlers.Server{})
+	pb.RegisterGradesServiceServer(s, &handlers.Server{})
+
+	// Health reflects MongoDB connectivity for every registered service
+	var services []string
+	for name := range s.GetServiceInfo() {
+		services = append(services, name)
+	}
+	healthChecker := health.NewChecker(func(ctx context.Context) error {
+		return client.Ping(ctx, nil)
+	}, services...)
+	healthpb.RegisterHealthServer(s, healthChecker.GRPCServer())
+	goBackground(&background, func() { healthChecker.Run(bgCtx, 5*time.Second) })
+
+	healthServer := health.NewHTTPServer(":"+cfg.Server.HealthPort, healthChecker)
+	go serveHTTP("health", healthServer)
 
 	reflection.Register(s)
 
-	// go get github.com/joho/godotenv
-	port := os.Getenv("SERVER_PORT")
+	goBackground(&background, func() { utils.JwtStore.CleanUpExpiredTokens(bgCtx) })
 
-	go utils.JwtStore.CleanUpExpiredTokens()
+	// Serve Prometheus metrics on a separate listener
+	metrics.Register(utils.JwtStore.Size)
+	metricsServer := metrics.NewServer(":" + cfg.Server.MetricsPort)
+	go serveHTTP("metrics", metricsServer)
 
-	fmt.Printf("Server is running on port %s\n", port)
+	// Create indexes for the outbox and audit collections
+	err = mongodb.EnsureNotificationIndexesDBHandler(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to create notification indexes: %v", err)
+	}
+	err = mongodb.EnsureAuditIndexesDBHandler(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to create audit indexes: %v", err)
+	}
+	err = mongodb.EnsureClassIndexesDBHandler(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to create class indexes: %v", err)
+	}
+	err = mongodb.EnsureAssignmentIndexesDBHandler(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to create teaching assignment indexes: %v", err)
+	}
+	err = mongodb.EnsureCurriculumIndexesDBHandler(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to create curriculum indexes: %v", err)
+	}
+	err = mongodb.EnsureAttendanceIndexesDBHandler(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to create attendance indexes: %v", err)
+	}
+	err = mongodb.EnsureGradeIndexesDBHandler(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to create grade indexes: %v", err)
+	}
+	// Existing duplicates block the unique indexes. Without them adds and
+	// updates can create more duplicates, so only keep serving if asked to,
+	// leaving time to find them with FindDuplicates and merge them
+	err = mongodb.EnsureUniqueIndexesDBHandler(context.Background())
+	if err != nil {
+		if !cfg.Mongo.AllowMissingUniqueIndexes {
+			log.Fatalf("Failed to create unique indexes (set mongo.allow_missing_unique_indexes to start anyway): %v", err)
+		}
+		utils.Logger.Error("unique indexes missing, duplicates can still be created", "error", err)
+	}
 
-	lis, err := net.Listen("tcp", ":"+port)
+	// Deliver queued emails in the background
+	outboxWorker := notifications.NewOutboxWorker(notifications.NewSMTPMailer(cfg.Mail), 10*time.Second)
+	goBackground(&background, func() { outboxWorker.Run(bgCtx) })
+
+	utils.Logger.Info("server is running", "port", cfg.Server.Port)
+
+	lis, err := net.Listen("tcp", ":"+cfg.Server.Port)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.Serve(lis)
+	}()
+
+	// Wait for SIGINT/SIGTERM, or for Serve to fail on its own
+	sigCtx, stopSignals := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+
+	select {
+	case <-sigCtx.Done():
+		utils.Logger.Info("shutdown signal received, draining")
+	case err := <-serveErr:
+		utils.Logger.Error("server stopped unexpectedly", "error", err)
+	}
+
+	// Stop advertising readiness first so load balancers move traffic away
+	healthChecker.Drain()
+	gracefulStop(s, cfg.Server.ShutdownTimeout)
+
+	// In-flight RPCs are done; stop background work and wait for it to return
+	cancelBackground()
+	background.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := healthServer.Shutdown(ctx); err != nil {
+		utils.Logger.Error("health server shutdown failed", "error", err)
+	}
+	if err := metricsServer.Shutdown(ctx); err != nil {
+		utils.Logger.Error("metrics server shutdown failed", "error", err)
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		utils.Logger.Error("flushing traces failed", "error", err)
+	}
+	if err := client.Disconnect(ctx); err != nil {
+		utils.Logger.Error("closing MongoDB connection failed", "error", err)
+	}
+	if err := mongodb.DisconnectSharedClient(ctx); err != nil {
+		utils.Logger.Error("closing shared MongoDB connection failed", "error", err)
+	}
+
+	utils.Logger.Info("server stopped")
+	utils.FlushLogs()
+}
+
+// gracefulStop waits up to timeout for in-flight RPCs to finish, then closes
+// any remaining connections.
+func gracefulStop(s *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		utils.Logger.Warn("graceful stop timed out, forcing shutdown", "timeout", timeout.String())
+		s.Stop()
 	}
+}
 
+func goBackground(wg *sync.WaitGroup, fn func()) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		fn()
+	}()
+}
+
+func serveHTTP(name string, server *http.Server) {
+	utils.Logger.Info(name+" server is running", "addr", server.Addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		utils.Logger.Error(name+" server stopped", "error", err)
+	}
 }
@@ -0,0 +1,73 @@
+// Command migrate-classes converts the free-text class names stored on
+// students and teachers into references to ClassesService classes. It reads
+// the same configuration as the server. Run it with -dry-run first to see what
+// would change; running it again later only picks up records still missing a
+// class ID.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+)
+
+var academicYearPattern = regexp.MustCompile(`^[0-9]{4}-[0-9]{4}$`)
+
+func main() {
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	academicYear := fs.String("academic-year", currentAcademicYear(time.Now()), "academic year the migrated classes belong to, YYYY-YYYY")
+	dryRun := fs.Bool("dry-run", false, "report what would change without writing anything")
+	cfg, err := config.Load(fs, os.Args[1:])
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if !academicYearPattern.MatchString(*academicYear) {
+		log.Fatalf("-academic-year must look like 2025-2026, got %q", *academicYear)
+	}
+
+	mongodb.Configure(cfg)
+
+	report, err := mongodb.MigrateClassStringsDBHandler(context.Background(), *academicYear, *dryRun)
+	if err != nil {
+		log.Fatalf("Migration failed: %v", err)
+	}
+
+	if *dryRun {
+		fmt.Println("Dry run, nothing was written.")
+	}
+	fmt.Printf("Classes created:  %d\n", report.ClassesCreated)
+	fmt.Printf("Students updated: %d\n", report.StudentsUpdated)
+	fmt.Printf("Teachers updated: %d\n", report.TeachersUpdated)
+	if !*dryRun {
+		fmt.Printf("Homerooms set:    %d\n", report.HomeroomsSet)
+	}
+
+	if len(report.Unparsed) > 0 {
+		names := make([]string, 0, len(report.Unparsed))
+		for name := range report.Unparsed {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Println("Class names that could not be read, fix these records by hand:")
+		for _, name := range names {
+			fmt.Printf("  %q: %d records\n", name, report.Unparsed[name])
+		}
+	}
+}
+
+// currentAcademicYear assumes the school year starts in August.
+func currentAcademicYear(now time.Time) string {
+	start := now.Year()
+	if now.Month() < time.August {
+		start--
+	}
+	return fmt.Sprintf("%d-%d", start, start+1)
+}
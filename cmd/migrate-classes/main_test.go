@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCurrentAcademicYear(t *testing.T) {
+	tests := []struct {
+		now  time.Time
+		want string
+	}{
+		{time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC), "2025-2026"},
+		{time.Date(2026, time.January, 15, 0, 0, 0, 0, time.UTC), "2025-2026"},
+		{time.Date(2026, time.July, 31, 23, 59, 0, 0, time.UTC), "2025-2026"},
+		{time.Date(2026, time.August, 1, 0, 0, 0, 0, time.UTC), "2026-2027"},
+		{time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC), "2026-2027"},
+	}
+
+	for _, tt := range tests {
+		if got := currentAcademicYear(tt.now); got != tt.want {
+			t.Errorf("currentAcademicYear(%s) = %q, want %q", tt.now.Format("2006-01-02"), got, tt.want)
+		}
+		if !academicYearPattern.MatchString(currentAcademicYear(tt.now)) {
+			t.Errorf("currentAcademicYear(%s) does not match -academic-year's pattern", tt.now.Format("2006-01-02"))
+		}
+	}
+}
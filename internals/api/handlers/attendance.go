@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) MarkAttendance(ctx context.Context, req *pb.MarkAttendanceRequest) (*pb.AttendanceRecords, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := time.Parse(time.DateOnly, req.GetDate()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Date must be a valid YYYY-MM-DD date")
+	}
+	seen := make(map[string]bool, len(req.GetMarks()))
+	for _, mark := range req.GetMarks() {
+		if seen[mark.StudentId] {
+			return nil, status.Error(codes.InvalidArgument, "Each student can only be marked once per call")
+		}
+		seen[mark.StudentId] = true
+	}
+
+	records, err := mongodb.MarkAttendanceDBHandler(ctx, actor, req.GetClassId(), req.GetDate(), req.GetPeriod(), req.GetMarks())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AttendanceRecords{Records: records}, nil
+}
+
+func (s *Server) CorrectAttendance(ctx context.Context, req *pb.AttendanceCorrection) (*pb.AttendanceRecord, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := mongodb.CorrectAttendanceDBHandler(ctx, actor, req.GetId(), req.GetStatus(), req.GetNote(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (s *Server) GetStudentAttendance(ctx context.Context, req *pb.StudentAttendanceRequest) (*pb.AttendanceRecords, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := time.Parse(time.DateOnly, req.GetFromDate())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "From date must be a valid YYYY-MM-DD date")
+	}
+	to, err := time.Parse(time.DateOnly, req.GetToDate())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "To date must be a valid YYYY-MM-DD date")
+	}
+	if to.Before(from) {
+		return nil, status.Error(codes.InvalidArgument, "From date must not be after to date")
+	}
+
+	records, err := mongodb.GetStudentAttendanceDBHandler(ctx, actor, req.GetStudentId(), req.GetFromDate(), req.GetToDate())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AttendanceRecords{Records: records}, nil
+}
+
+func (s *Server) GetClassAttendance(ctx context.Context, req *pb.ClassAttendanceRequest) (*pb.AttendanceRecords, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := time.Parse(time.DateOnly, req.GetDate()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Date must be a valid YYYY-MM-DD date")
+	}
+
+	records, err := mongodb.GetClassAttendanceDBHandler(ctx, actor, req.GetClassId(), req.GetDate())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AttendanceRecords{Records: records}, nil
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAuditPageSize matches the page_size limit in audit.proto.
+const maxAuditPageSize = 500
+
+func (s *Server) QueryAuditLog(ctx context.Context, req *pb.QueryAuditLogRequest) (*pb.AuditEntries, error) {
+	err := utils.AuthorizeUser(ctx, "admin")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	filter := bson.M{}
+	if req.GetActorId() != "" {
+		filter["actor_id"] = req.GetActorId()
+	}
+	if req.GetEntity() != "" {
+		filter["entity"] = req.GetEntity()
+	}
+	if req.GetTargetId() != "" {
+		filter["target_ids"] = req.GetTargetId()
+	}
+	if req.GetAction() != "" {
+		filter["action"] = req.GetAction()
+	}
+
+	// Entries are stored in UTC, so bounds are normalised before comparing
+	timeRange := bson.M{}
+	if req.GetFrom() != "" {
+		from, err := time.Parse(time.RFC3339, req.GetFrom())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "from must be an RFC3339 timestamp")
+		}
+		timeRange["$gte"] = from.UTC().Format(time.RFC3339)
+	}
+	if req.GetTo() != "" {
+		to, err := time.Parse(time.RFC3339, req.GetTo())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "to must be an RFC3339 timestamp")
+		}
+		timeRange["$lte"] = to.UTC().Format(time.RFC3339)
+	}
+	if len(timeRange) > 0 {
+		filter["timestamp"] = timeRange
+	}
+
+	// for pagination
+	pageNumber := req.GetPageNumber()
+	pageSize := req.GetPageSize()
+
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	if pageSize < 1 {
+		pageSize = 50
+	}
+	// The validation interceptor rejects larger pages, but don't rely on it
+	if pageSize > maxAuditPageSize {
+		pageSize = maxAuditPageSize
+	}
+
+	entries, err := mongodb.QueryAuditLogDBHandler(ctx, filter, pageNumber, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AuditEntries{Entries: entries}, nil
+}
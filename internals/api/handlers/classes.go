@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/tracing"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) AddClasses(ctx context.Context, req *pb.Classes) (*pb.Classes, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	for _, class := range req.GetClasses() {
+		if class.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "New class entries should not have an ID")
+		}
+		if class.GradeLevel == 0 || class.Section == "" || class.AcademicYear == "" {
+			return nil, status.Error(codes.InvalidArgument, "New class entries need a grade level, section and academic year")
+		}
+	}
+
+	addedClasses, err := mongodb.AddClassesDBHandler(ctx, req.GetClasses())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Classes{Classes: addedClasses}, nil
+}
+
+func (s *Server) GetClasses(ctx context.Context, req *pb.GetClassesRequest) (*pb.Classes, error) {
+	_, span := tracing.Tracer().Start(ctx, "build query")
+	filter, err := BuildFilterForTeacher(req.Class, models.Class{})
+	if err != nil {
+		span.End()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sortOptions := BuildSortOptions(req.GetSortBy())
+	span.End()
+
+	classes, err := mongodb.GetClassesDBHandler(ctx, sortOptions, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Classes{Classes: classes}, nil
+}
+
+func (s *Server) UpdateClasses(ctx context.Context, req *pb.Classes) (*pb.Classes, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	updatedClasses, err := mongodb.UpdateClassesDBHandler(ctx, req.GetClasses())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Classes{Classes: updatedClasses}, nil
+}
+
+func (s *Server) DeleteClasses(ctx context.Context, req *pb.ClassIds) (*pb.DeleteClassesConfirmation, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	deletedIds, err := mongodb.DeleteClassesDBHandler(ctx, req.GetIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteClassesConfirmation{Status: "Classes deleted successfully", DeletedIds: deletedIds}, nil
+}
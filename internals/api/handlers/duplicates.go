@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+)
+
+func (s *Server) FindDuplicates(ctx context.Context, req *pb.FindDuplicatesRequest) (*pb.DuplicateGroups, error) {
+	err := utils.AuthorizeUser(ctx, "admin")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	groups, err := mongodb.FindDuplicatesDBHandler(ctx, req.GetKind())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DuplicateGroups{Groups: groups}, nil
+}
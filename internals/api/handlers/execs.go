@@ -2,15 +2,15 @@ package handlers
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/audit"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/tracing"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
 	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
 	"google.golang.org/grpc/codes"
@@ -19,19 +19,18 @@ import (
 )
 
 func (s *Server) AddExecs(ctx context.Context, req *pb.Execs) (*pb.Execs, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	for _, exec := range req.GetExecs() {
 		if exec.Id != "" {
 			return nil, status.Error(codes.InvalidArgument, "New exec entries should not have an ID")
 		}
+		if exec.FirstName == "" || exec.LastName == "" || exec.Email == "" || exec.Username == "" {
+			return nil, status.Error(codes.InvalidArgument, "New exec entries need a first name, last name, email and username")
+		}
 	}
 
 	addedExecs, err := mongodb.AddExecsDBHandler(ctx, req.GetExecs())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Execs{Execs: addedExecs}, nil
@@ -44,45 +43,41 @@ func (s *Server) GetExecs(ctx context.Context, req *pb.GetExecsRequest) (*pb.Exe
 		return nil, utils.ErrorHandler(err, err.Error())
 	}
 
-	if err = req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	// Filtering, getting the filters from the request
+	_, span := tracing.Tracer().Start(ctx, "build query")
 	filter, err := BuildFilterForTeacher(req.Exec, models.Exec{})
 	if err != nil {
+		span.End()
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// Sorting, getting the sort options from the request
 	sortOptions := BuildSortOptions(req.GetSortBy())
+	span.End()
 
 	// Access the database to fetch data
 	execs, err := mongodb.GetExecsDBHandler(ctx, sortOptions, filter)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Execs{Execs: execs}, nil
 }
 func (s *Server) UpdateExecs(ctx context.Context, req *pb.Execs) (*pb.Execs, error) {
-	// if err := req.Validate(); err != nil {
-	// 	return nil, status.Error(codes.InvalidArgument, err.Error())
-	// }
+	err := utils.AuthorizeUser(ctx, "admin")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
 
 	updatedExecs, err := mongodb.UpdateExecsDBHandler(ctx, req.GetExecs())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Execs{Execs: updatedExecs}, nil
 }
 
 func (s *Server) DeleteExecs(ctx context.Context, req *pb.ExecIds) (*pb.DeleteExecsConfirmation, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	ids := req.GetIds()
 	var execIdsToDelete []string
 
@@ -92,29 +87,34 @@ func (s *Server) DeleteExecs(ctx context.Context, req *pb.ExecIds) (*pb.DeleteEx
 
 	deletedIds, err := mongodb.DeleteExecsDBHandler(ctx, execIdsToDelete)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.DeleteExecsConfirmation{Status: "Execs deleted successfully", DeletedIds: deletedIds}, nil
 }
 
 func (s *Server) Login(ctx context.Context, req *pb.ExecLoginRequest) (*pb.ExecLoginResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
+	audit.SetActor(ctx, "", req.GetUsername(), "")
 
 	exec, err := mongodb.LoginExecDBHandler(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
+	audit.SetActor(ctx, exec.Id, exec.Username, exec.Role)
+	audit.AddTarget(ctx, exec.Id)
+
 	if exec.InactiveStatus {
 		return nil, status.Error(codes.Unauthenticated, "Account is inactive")
 	}
 
+	if exec.AccountStatus == mongodb.AccountPending {
+		return nil, status.Error(codes.Unauthenticated, "Account has not been activated")
+	}
+
 	err = utils.VerifyPassword(req.GetPassword(), exec.Password)
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Incorrect password")
+		return nil, status.Error(codes.Unauthenticated, "Incorrect password")
 	}
 
 	tokenString, err := utils.SignToken(exec.Id, exec.Username, exec.Role)
@@ -129,13 +129,9 @@ func (s *Server) Login(ctx context.Context, req *pb.ExecLoginRequest) (*pb.ExecL
 }
 
 func (s *Server) UpdatePassword(ctx context.Context, req *pb.UpdatePasswordRequest) (*pb.UpdatePasswordResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	token, err := mongodb.UpdatePasswordExecDBHandler(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	if token == "" {
@@ -149,13 +145,9 @@ func (s *Server) UpdatePassword(ctx context.Context, req *pb.UpdatePasswordReque
 }
 
 func (s *Server) DeactivateUser(ctx context.Context, req *pb.ExecIds) (*pb.Confirmation, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	res, err := mongodb.DeactivateUserDBHandler(ctx, req.GetIds())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	if res.ModifiedCount == 0 {
@@ -170,14 +162,10 @@ func (s *Server) DeactivateUser(ctx context.Context, req *pb.ExecIds) (*pb.Confi
 }
 
 func (s *Server) ForgotPassword(ctx context.Context, req *pb.ForgotPasswordRequest) (*pb.ForgotPasswordResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	email := req.GetEmail()
 	message, err := mongodb.ForgotPasswordExecDBHandler(ctx, email)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.ForgotPasswordResponse{
@@ -193,17 +181,14 @@ func (s *Server) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest
 		return nil, status.Error(codes.InvalidArgument, "Passwords do not match")
 	}
 
-	bytes, err := hex.DecodeString(token)
+	tokenInDb, err := hashToken(token)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "internal error")
 	}
 
-	hashedToken := sha256.Sum256(bytes)
-	tokenInDb := hex.EncodeToString(hashedToken[:])
-
 	err = mongodb.ResetPasswordDBHandler(ctx, tokenInDb, req.GetNewPassword())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Confirmation{
@@ -241,7 +226,85 @@ func (s *Server) Logout(ctx context.Context, req *pb.EmptyRequest) (*pb.ExecLogo
 
 	utils.JwtStore.AddToken(token, expirytime)
 
+	if userId, ok := ctx.Value(utils.ContextKey("userId")).(string); ok {
+		audit.AddTarget(ctx, userId)
+	}
+
 	return &pb.ExecLogoutResponse{
 		LoggedOut: true,
 	}, nil
 }
+
+func (s *Server) ActivateAccount(ctx context.Context, req *pb.ActivateAccountRequest) (*pb.Confirmation, error) {
+	if req.GetNewPassword() != req.GetConfirmPassword() {
+		return nil, status.Error(codes.InvalidArgument, "Passwords do not match")
+	}
+
+	tokenInDb, err := hashToken(req.GetActivationCode())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid activation code")
+	}
+
+	err = mongodb.ActivateAccountDBHandler(ctx, tokenInDb, req.GetNewPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Confirmation{
+		Confirmation: true,
+	}, nil
+}
+
+func (s *Server) ResendActivation(ctx context.Context, req *pb.ExecIds) (*pb.ResendActivationResponse, error) {
+	err := utils.AuthorizeUser(ctx, "admin")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	resentIds, err := mongodb.ResendActivationDBHandler(ctx, req.GetIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResendActivationResponse{
+		Confirmation: len(resentIds) > 0,
+		ResentIds:    resentIds,
+	}, nil
+}
+
+func (s *Server) RequestEmailChange(ctx context.Context, req *pb.RequestEmailChangeRequest) (*pb.Confirmation, error) {
+	userId, ok := ctx.Value(utils.ContextKey("userId")).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized Access")
+	}
+
+	err := mongodb.RequestEmailChangeDBHandler(ctx, userId, req.GetNewEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Confirmation{
+		Confirmation: true,
+	}, nil
+}
+
+func (s *Server) ConfirmEmailChange(ctx context.Context, req *pb.ConfirmEmailChangeRequest) (*pb.Confirmation, error) {
+	userId, ok := ctx.Value(utils.ContextKey("userId")).(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized Access")
+	}
+
+	tokenInDb, err := hashToken(req.GetVerificationCode())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid verification code")
+	}
+
+	err = mongodb.ConfirmEmailChangeDBHandler(ctx, userId, tokenInDb)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Confirmation{
+		Confirmation: true,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+)
+
+func TestUpdateExecsRequiresAdmin(t *testing.T) {
+	// An exec pointing their own teacher_id at another teacher would reach
+	// that teacher's classes
+	req := &pb.Execs{Execs: []*pb.Exec{
+		{Id: "0123456789abcdef01234567", TeacherId: "89abcdef0123456789abcdef"},
+	}}
+
+	for _, role := range []string{"exec", "manager", ""} {
+		t.Run(role, func(t *testing.T) {
+			ctx := context.Background()
+			if role != "" {
+				ctx = context.WithValue(ctx, utils.ContextKey("role"), role)
+			}
+			_, err := (&Server{}).UpdateExecs(ctx, req)
+			if !errors.Is(err, utils.ErrPermissionDenied) {
+				t.Errorf("UpdateExecs() as %q = %v, want permission denied", role, err)
+			}
+		})
+	}
+}
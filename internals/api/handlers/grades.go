@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetAssessments(ctx context.Context, req *pb.GetAssessmentsRequest) (*pb.Assessments, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assessments, err := mongodb.GetAssessmentsDBHandler(ctx, actor, req.GetClassId(), req.GetSubjectId(), req.GetTerm())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Assessments{Assessments: assessments}, nil
+}
+
+func (s *Server) AddAssessments(ctx context.Context, req *pb.Assessments) (*pb.Assessments, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assessment := range req.GetAssessments() {
+		if assessment.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "New assessment entries should not have an ID")
+		}
+		if assessment.ClassId == "" || assessment.SubjectId == "" || assessment.Title == "" || assessment.Type == pb.AssessmentType_ASSESSMENT_TYPE_UNSPECIFIED {
+			return nil, status.Error(codes.InvalidArgument, "New assessment entries need a class, subject, title and type")
+		}
+		if assessment.MaxScore <= 0 || assessment.Weight <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Max score and weight must be greater than zero")
+		}
+		err = validateAssessmentDate(assessment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	addedAssessments, err := mongodb.AddAssessmentsDBHandler(ctx, actor, req.GetAssessments())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Assessments{Assessments: addedAssessments}, nil
+}
+
+func (s *Server) UpdateAssessments(ctx context.Context, req *pb.Assessments) (*pb.Assessments, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, assessment := range req.GetAssessments() {
+		err = validateAssessmentDate(assessment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updatedAssessments, err := mongodb.UpdateAssessmentsDBHandler(ctx, actor, req.GetAssessments())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Assessments{Assessments: updatedAssessments}, nil
+}
+
+func (s *Server) DeleteAssessments(ctx context.Context, req *pb.AssessmentIds) (*pb.DeleteAssessmentsConfirmation, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedIds, err := mongodb.DeleteAssessmentsDBHandler(ctx, actor, req.GetIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAssessmentsConfirmation{Status: "Assessments deleted successfully", DeletedIds: deletedIds}, nil
+}
+
+func (s *Server) RecordScores(ctx context.Context, req *pb.RecordScoresRequest) (*pb.Scores, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(req.GetScores()))
+	for _, entry := range req.GetScores() {
+		if seen[entry.StudentId] {
+			return nil, status.Error(codes.InvalidArgument, "Each student can only be scored once per call")
+		}
+		seen[entry.StudentId] = true
+	}
+
+	scores, err := mongodb.RecordScoresDBHandler(ctx, actor, req.GetAssessmentId(), req.GetScores())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Scores{Scores: scores}, nil
+}
+
+func (s *Server) GetScores(ctx context.Context, req *pb.AssessmentId) (*pb.Scores, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := mongodb.GetScoresDBHandler(ctx, actor, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Scores{Scores: scores}, nil
+}
+
+func (s *Server) GetStudentGrades(ctx context.Context, req *pb.StudentGradesRequest) (*pb.Grades, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := mongodb.GetStudentGradesDBHandler(ctx, actor, req.GetStudentId(), req.GetTerm())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Grades{Grades: grades}, nil
+}
+
+func (s *Server) GetClassGrades(ctx context.Context, req *pb.ClassGradesRequest) (*pb.Grades, error) {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grades, err := mongodb.GetClassGradesDBHandler(ctx, actor, req.GetClassId(), req.GetSubjectId(), req.GetTerm())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Grades{Grades: grades}, nil
+}
+
+func (s *Server) GetGradingScale(ctx context.Context, req *pb.EmptyRequest) (*pb.GradingScale, error) {
+	_, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GradingScale{Boundaries: mongodb.GradingScale()}, nil
+}
+
+func validateAssessmentDate(assessment *pb.Assessment) error {
+	if assessment.Date == "" {
+		return nil
+	}
+	if _, err := time.Parse(time.DateOnly, assessment.Date); err != nil {
+		return status.Error(codes.InvalidArgument, "Date must be a valid YYYY-MM-DD date")
+	}
+	return nil
+}
@@ -1,10 +1,13 @@
 package handlers
 
 import (
-	"fmt"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"reflect"
 	"strings"
 
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -24,11 +27,13 @@ func BuildFilterForTeacher(object interface{}, model interface{}) (bson.M, error
 	if !val.IsValid() || (val.Kind() == reflect.Ptr && val.IsNil()) {
 		return filter, nil
 	}
-	modelVal := val.Elem()
-	modelType := modelVal.Type()
+	// copy the request fields onto a fresh model so the bson tags come from
+	// the model, not the generated struct
+	reqVal := val.Elem()
+	reqType := reqVal.Type()
 
-	reqVal := modelVal
-	reqType := modelType
+	modelVal := reflect.New(reflect.TypeOf(model)).Elem()
+	modelType := modelVal.Type()
 
 	for i := 0; i < reqVal.NumField(); i++ {
 		fieldVal := reqVal.Field(i)
@@ -36,7 +41,7 @@ func BuildFilterForTeacher(object interface{}, model interface{}) (bson.M, error
 
 		if fieldVal.IsValid() && !fieldVal.IsZero() {
 			modelField := modelVal.FieldByName(fieldName)
-			if modelField.IsValid() && modelField.CanSet() {
+			if modelField.IsValid() && modelField.CanSet() && fieldVal.Type().AssignableTo(modelField.Type()) {
 				modelField.Set(fieldVal)
 			}
 		}
@@ -54,17 +59,17 @@ func BuildFilterForTeacher(object interface{}, model interface{}) (bson.M, error
 				// objID, err := primitive.ObjectIDFromHex(object.Id)
 				objID, err := primitive.ObjectIDFromHex(reqVal.FieldByName(fieldName).Interface().(string))
 				if err != nil {
-					return nil, utils.ErrorHandler(err, "Invalid ID format")
+					return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
 				}
 				filter[bsonTag] = objID
 			} else {
-				filter[bsonTag] = fieldVal.Interface().(string)
+				filter[bsonTag] = fieldVal.Interface()
 			}
 			// filter[bsonTag] = fieldVal.Interface().(string)
 		}
 	}
 
-	fmt.Println("Filter:", filter)
+	utils.Logger.Debug("built filter", "filter", filter)
 	return filter, nil
 }
 
@@ -78,6 +83,33 @@ func BuildSortOptions(sortFields []*pb.SortField) bson.D {
 		}
 		sortOptions = append(sortOptions, bson.E{Key: sortField.Field, Value: order})
 	}
-	fmt.Println("Sort Options:", sortOptions)
+	utils.Logger.Debug("built sort options", "sort", sortOptions)
 	return sortOptions
 }
+
+// hashToken turns a hex token received from a user into the SHA-256 form
+// stored in the database.
+func hashToken(token string) (string, error) {
+	bytes, err := hex.DecodeString(token)
+	if err != nil {
+		return "", err
+	}
+
+	hashedToken := sha256.Sum256(bytes)
+	return hex.EncodeToString(hashedToken[:]), nil
+}
+
+// actorFromContext identifies the caller of a class-scoped RPC. Admins and
+// managers may act on any class; execs only on their linked teacher's.
+func actorFromContext(ctx context.Context) (mongodb.Actor, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager", "exec")
+	if err != nil {
+		return mongodb.Actor{}, utils.ErrorHandler(err, err.Error())
+	}
+
+	userId, _ := ctx.Value(utils.ContextKey("userId")).(string)
+	return mongodb.Actor{
+		Id:       userId,
+		AnyClass: utils.AuthorizeUser(ctx, "admin", "manager") == nil,
+	}, nil
+}
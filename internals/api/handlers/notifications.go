@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/audit"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+)
+
+func (s *Server) GetFailedNotifications(ctx context.Context, req *pb.GetFailedNotificationsRequest) (*pb.Notifications, error) {
+	err := utils.AuthorizeUser(ctx, "admin")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	notifications, err := mongodb.GetFailedNotificationsDBHandler(ctx, req.GetRecipient())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Notifications{Notifications: notifications}, nil
+}
+
+func (s *Server) RetryNotifications(ctx context.Context, req *pb.NotificationIds) (*pb.RetryNotificationsResponse, error) {
+	err := utils.AuthorizeUser(ctx, "admin")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	retriedIds, err := mongodb.RetryNotificationsDBHandler(ctx, req.GetIds())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range retriedIds {
+		audit.AddTarget(ctx, id)
+	}
+
+	return &pb.RetryNotificationsResponse{
+		Confirmation: len(retriedIds) > 0,
+		RetriedIds:   retriedIds,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/audit"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+)
+
+func (s *Server) ExportPersonData(ctx context.Context, req *pb.PersonRef) (*pb.PersonDataExport, error) {
+	err := utils.AuthorizeUser(ctx, "admin")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	audit.AddTarget(ctx, req.GetId())
+
+	archive, err := mongodb.ExportPersonDataDBHandler(ctx, req.GetKind(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PersonDataExport{
+		Format:  "application/json",
+		Archive: archive,
+	}, nil
+}
+
+func (s *Server) ErasePersonData(ctx context.Context, req *pb.ErasePersonDataRequest) (*pb.ErasureReceipt, error) {
+	err := utils.AuthorizeUser(ctx, "admin")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	requestedBy, _ := ctx.Value(utils.ContextKey("userId")).(string)
+	person := req.GetPerson()
+
+	receipt, err := mongodb.ErasePersonDataDBHandler(ctx, person.GetKind(), person.GetId(), requestedBy, req.GetReason(), mongodb.ConfiguredErasurePolicy())
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := mongodb.ReceiptPayload(receipt)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "internal error")
+	}
+
+	return &pb.ErasureReceipt{
+		ReceiptId: receipt.Id,
+		Payload:   string(payload),
+		Signature: receipt.Signature,
+		Algorithm: "HMAC-SHA256",
+	}, nil
+}
@@ -6,4 +6,13 @@ type Server struct {
 	pb.UnimplementedTeachersServiceServer
 	pb.UnimplementedStudentsServiceServer
 	pb.UnimplementedExecsServiceServer
+	pb.UnimplementedNotificationsServiceServer
+	pb.UnimplementedAuditServiceServer
+	pb.UnimplementedPrivacyServiceServer
+	pb.UnimplementedDuplicatesServiceServer
+	pb.UnimplementedClassesServiceServer
+	pb.UnimplementedSubjectsServiceServer
+	pb.UnimplementedAttendanceServiceServer
+	pb.UnimplementedStatisticsServiceServer
+	pb.UnimplementedGradesServiceServer
 }
\ No newline at end of file
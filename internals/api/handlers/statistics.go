@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+)
+
+func (s *Server) GetStatistics(ctx context.Context, req *pb.EmptyRequest) (*pb.SchoolStatistics, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	stats, err := mongodb.GetStatisticsDBHandler(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
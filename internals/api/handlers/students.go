@@ -5,16 +5,14 @@ import (
 
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/tracing"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
 	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (s *Server) AddStudents(ctx context.Context, req *pb.Students) (*pb.Students, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	for _, student := range req.GetStudents() {
 		if student.Id != "" {
 			return nil, status.Error(codes.InvalidArgument, "New student entries should not have an ID")
@@ -23,25 +21,24 @@ func (s *Server) AddStudents(ctx context.Context, req *pb.Students) (*pb.Student
 
 	addedStudents, err := mongodb.AddStudentsDBHandler(ctx, req.GetStudents())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Students{Students: addedStudents}, nil
 }
 
 func (s *Server) GetStudents(ctx context.Context, req *pb.GetStudentsRequest) (*pb.Students, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	// Filtering, getting the filters from the request
+	_, span := tracing.Tracer().Start(ctx, "build query")
 	filter, err := BuildFilterForTeacher(req.Student, models.Student{})
 	if err != nil {
+		span.End()
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// Sorting, getting the sort options from the request
 	sortOptions := BuildSortOptions(req.GetSortBy())
+	span.End()
 
 	// for pagination
 	pageNumber := req.GetPageNumber()
@@ -57,28 +54,20 @@ func (s *Server) GetStudents(ctx context.Context, req *pb.GetStudentsRequest) (*
 	// Access the database to fetch data
 	students, err := mongodb.GetStudentsDBHandler(ctx, sortOptions, filter, pageNumber, pageSize)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Students{Students: students}, nil
 }
 func (s *Server) UpdateStudents(ctx context.Context, req *pb.Students) (*pb.Students, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	updatedStudents, err := mongodb.UpdateStudentsDBHandler(ctx, req.GetStudents())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Students{Students: updatedStudents}, nil
 }
 func (s *Server) DeleteStudents(ctx context.Context, req *pb.StudentIds) (*pb.DeleteStudentsConfirmation, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	ids := req.GetIds()
 	var studentIdsToDelete []string
 
@@ -88,43 +77,53 @@ func (s *Server) DeleteStudents(ctx context.Context, req *pb.StudentIds) (*pb.De
 
 	deletedIds, err := mongodb.DeleteStudentsDBHandler(ctx, studentIdsToDelete)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.DeleteStudentsConfirmation{Status: "Students deleted successfully", DeletedIds: deletedIds}, nil
 }
 
-func (s *Server) GetStudentsByClassTeacher (ctx context.Context, req *pb.TeacherId) (*pb.Students, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
+func (s *Server) GetStudentsByClassTeacher (ctx context.Context, req *pb.ClassTeacherRequest) (*pb.Students, error) {
 	teacherId := req.GetId()
 	if teacherId == "" {
 		return nil, status.Error(codes.InvalidArgument, "Teacher ID is required")
 	}
 	
-	students, err := mongodb.GetStudentsByClassTeacherDBHandler(ctx, teacherId)
+	students, err := mongodb.GetStudentsByClassTeacherDBHandler(ctx, teacherId, req.GetSubjectId())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Students{Students: students}, nil
 }
 
-func (s *Server) GetStudentCountByClassTeacher (ctx context.Context, req *pb.TeacherId) (*pb.StudentCount, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
+func (s *Server) GetStudentCountByClassTeacher (ctx context.Context, req *pb.ClassTeacherRequest) (*pb.StudentCount, error) {
 	teacherId := req.GetId()
 	if teacherId == "" {
 		return nil, status.Error(codes.InvalidArgument, "Teacher ID is required")
 	}
 
-	count, err := mongodb.GetStudentCountByClassTeacherDBHandler(ctx, teacherId)
+	count, err := mongodb.GetStudentCountByClassTeacherDBHandler(ctx, teacherId, req.GetSubjectId())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.StudentCount{Status: true, StudentCount: count}, nil
-}
\ No newline at end of file
+}
+func (s *Server) MergeStudents(ctx context.Context, req *pb.MergeStudentsRequest) (*pb.Student, error) {
+	err := utils.AuthorizeUser(ctx, "admin")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	if req.GetSurvivorId() == req.GetDuplicateId() {
+		return nil, status.Error(codes.InvalidArgument, "A student cannot be merged into itself")
+	}
+
+	student, err := mongodb.MergeStudentsDBHandler(ctx, req.GetSurvivorId(), req.GetDuplicateId(), req.GetFieldsFromDuplicate())
+	if err != nil {
+		return nil, err
+	}
+
+	return student, nil
+}
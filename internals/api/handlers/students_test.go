@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"testing"
+
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestClassTeacherRequestReadsTeacherId(t *testing.T) {
+	// Clients built before subjects were added still send a TeacherId
+	raw, err := proto.Marshal(&pb.TeacherId{Id: "0123456789abcdef01234567"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var req pb.ClassTeacherRequest
+	if err := proto.Unmarshal(raw, &req); err != nil {
+		t.Fatalf("unmarshalling a TeacherId as ClassTeacherRequest: %v", err)
+	}
+	if req.GetId() != "0123456789abcdef01234567" || req.GetSubjectId() != "" {
+		t.Errorf("ClassTeacherRequest = %v, want the teacher ID and no subject", &req)
+	}
+}
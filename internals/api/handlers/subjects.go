@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/tracing"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) AddSubjects(ctx context.Context, req *pb.Subjects) (*pb.Subjects, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	for _, subject := range req.GetSubjects() {
+		if subject.Id != "" {
+			return nil, status.Error(codes.InvalidArgument, "New subject entries should not have an ID")
+		}
+		if subject.Code == "" || subject.Name == "" {
+			return nil, status.Error(codes.InvalidArgument, "New subject entries need a code and a name")
+		}
+	}
+
+	addedSubjects, err := mongodb.AddSubjectsDBHandler(ctx, req.GetSubjects())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Subjects{Subjects: addedSubjects}, nil
+}
+
+func (s *Server) GetSubjects(ctx context.Context, req *pb.GetSubjectsRequest) (*pb.Subjects, error) {
+	_, span := tracing.Tracer().Start(ctx, "build query")
+	filter, err := BuildFilterForTeacher(req.Subject, models.Subject{})
+	if err != nil {
+		span.End()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sortOptions := BuildSortOptions(req.GetSortBy())
+	span.End()
+
+	subjects, err := mongodb.GetSubjectsDBHandler(ctx, sortOptions, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Subjects{Subjects: subjects}, nil
+}
+
+func (s *Server) UpdateSubjects(ctx context.Context, req *pb.Subjects) (*pb.Subjects, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	updatedSubjects, err := mongodb.UpdateSubjectsDBHandler(ctx, req.GetSubjects())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Subjects{Subjects: updatedSubjects}, nil
+}
+
+func (s *Server) DeleteSubjects(ctx context.Context, req *pb.SubjectIds) (*pb.DeleteSubjectsConfirmation, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	deletedIds, err := mongodb.DeleteSubjectsDBHandler(ctx, req.GetIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteSubjectsConfirmation{Status: "Subjects deleted successfully", DeletedIds: deletedIds}, nil
+}
+
+func (s *Server) GetCurriculum(ctx context.Context, req *pb.GetCurriculumRequest) (*pb.Curriculum, error) {
+	curriculum, err := mongodb.GetCurriculumDBHandler(ctx, req.GetGradeLevel())
+	if err != nil {
+		return nil, err
+	}
+
+	return curriculum, nil
+}
+
+func (s *Server) SetCurriculum(ctx context.Context, req *pb.Curriculum) (*pb.Curriculum, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	curriculum, err := mongodb.SetCurriculumDBHandler(ctx, req.GetGradeLevel(), req.GetSubjectIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return curriculum, nil
+}
+
+func (s *Server) GetTeacherQualifications(ctx context.Context, req *pb.TeacherId) (*pb.TeacherQualifications, error) {
+	subjectIds, err := mongodb.GetTeacherQualificationsDBHandler(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TeacherQualifications{TeacherId: req.GetId(), SubjectIds: subjectIds}, nil
+}
+
+func (s *Server) SetTeacherQualifications(ctx context.Context, req *pb.TeacherQualifications) (*pb.TeacherQualifications, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	subjectIds, err := mongodb.SetTeacherQualificationsDBHandler(ctx, req.GetTeacherId(), req.GetSubjectIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TeacherQualifications{TeacherId: req.GetTeacherId(), SubjectIds: subjectIds}, nil
+}
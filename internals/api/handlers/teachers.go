@@ -5,6 +5,8 @@ import (
 
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/tracing"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
 	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
 
 	"google.golang.org/grpc/codes"
@@ -12,10 +14,6 @@ import (
 )
 
 func (s *Server) AddTeachers(ctx context.Context, req *pb.Teachers) (*pb.Teachers, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	for _, teacher := range req.GetTeachers() {
 		if teacher.Id != "" {
 			return nil, status.Error(codes.InvalidArgument, "New teacher entries should not have an ID")
@@ -24,53 +22,44 @@ func (s *Server) AddTeachers(ctx context.Context, req *pb.Teachers) (*pb.Teacher
 
 	addedTeachers, err := mongodb.AddTeachersDBHandler(ctx, req.GetTeachers())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Teachers{Teachers: addedTeachers}, nil
 }
 
 func (s *Server) GetTeachers(ctx context.Context, req *pb.GetTeachersRequest) (*pb.Teachers, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	// Filtering, getting the filters from the request
+	_, span := tracing.Tracer().Start(ctx, "build query")
 	filter, err := BuildFilterForTeacher(req.Teacher, models.Teacher{})
 	if err != nil {
+		span.End()
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// Sorting, getting the sort options from the request
 	sortOptions := BuildSortOptions(req.GetSortBy())
+	span.End()
 
 	// Access the databse to fetch data
 	teachers, err := mongodb.GetTeachersDBHandler(ctx, sortOptions, filter)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Teachers{Teachers: teachers}, nil
 }
 
 func (s *Server) UpdateTeachers(ctx context.Context, req *pb.Teachers) (*pb.Teachers, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	updatedTeachers, err := mongodb.UpdateTeachersDBHandler(ctx, req.GetTeachers())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.Teachers{Teachers: updatedTeachers}, nil
 }
 
 func (s *Server) DeleteTeachers(ctx context.Context, req *pb.TeacherIds) (*pb.DeleteTeachersConfirmation, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
 	ids := req.GetIds()
 	var teacherIdsToDelete []string
 
@@ -80,8 +69,62 @@ func (s *Server) DeleteTeachers(ctx context.Context, req *pb.TeacherIds) (*pb.De
 
 	deletedIds, err := mongodb.DeleteTeachersDBHandler(ctx, teacherIdsToDelete)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.DeleteTeachersConfirmation{Status: "Teachers deleted successfully", DeletedIds: deletedIds}, nil
 }
+
+func (s *Server) AssignTeacher(ctx context.Context, req *pb.TeachingAssignment) (*pb.TeachingAssignment, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	if req.Id != "" {
+		return nil, status.Error(codes.InvalidArgument, "New assignments should not have an ID")
+	}
+	if req.TeacherId == "" || req.ClassId == "" || req.SubjectId == "" || req.Term == "" {
+		return nil, status.Error(codes.InvalidArgument, "Assignments need a teacher ID, class ID, subject ID and term")
+	}
+
+	assignment, err := mongodb.AssignTeacherDBHandler(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+func (s *Server) UnassignTeacher(ctx context.Context, req *pb.TeachingAssignmentId) (*pb.UnassignTeacherConfirmation, error) {
+	err := utils.AuthorizeUser(ctx, "admin", "manager")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, err.Error())
+	}
+
+	err = mongodb.UnassignTeacherDBHandler(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnassignTeacherConfirmation{Status: "Assignment removed successfully", DeletedId: req.GetId()}, nil
+}
+
+func (s *Server) ListAssignments(ctx context.Context, req *pb.ListAssignmentsRequest) (*pb.TeachingAssignments, error) {
+	_, span := tracing.Tracer().Start(ctx, "build query")
+	filter, err := BuildFilterForTeacher(req.Assignment, models.TeachingAssignment{})
+	if err != nil {
+		span.End()
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sortOptions := BuildSortOptions(req.GetSortBy())
+	span.End()
+
+	assignments, err := mongodb.ListAssignmentsDBHandler(ctx, sortOptions, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TeachingAssignments{Assignments: assignments}, nil
+}
@@ -0,0 +1,129 @@
+package interceptors
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/audit"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type auditedMethod struct {
+	action string
+	entity string
+}
+
+// auditedMethods lists every RPC that changes state or authenticates a user.
+// Read-only calls are not audited.
+var auditedMethods = map[string]auditedMethod{
+	"/main.StudentsService/AddStudents":    {"create", "students"},
+	"/main.StudentsService/UpdateStudents": {"update", "students"},
+	"/main.StudentsService/DeleteStudents": {"delete", "students"},
+	"/main.StudentsService/MergeStudents":  {"merge", "students"},
+
+	"/main.TeachersService/AddTeachers":     {"create", "teachers"},
+	"/main.TeachersService/UpdateTeachers":  {"update", "teachers"},
+	"/main.TeachersService/DeleteTeachers":  {"delete", "teachers"},
+	"/main.TeachersService/AssignTeacher":   {"assign", "teaching_assignments"},
+	"/main.TeachersService/UnassignTeacher": {"unassign", "teaching_assignments"},
+
+	"/main.ClassesService/AddClasses":    {"create", "classes"},
+	"/main.ClassesService/UpdateClasses": {"update", "classes"},
+	"/main.ClassesService/DeleteClasses": {"delete", "classes"},
+
+	"/main.SubjectsService/AddSubjects":              {"create", "subjects"},
+	"/main.SubjectsService/UpdateSubjects":           {"update", "subjects"},
+	"/main.SubjectsService/DeleteSubjects":           {"delete", "subjects"},
+	"/main.SubjectsService/SetCurriculum":            {"update", "curricula"},
+	"/main.SubjectsService/SetTeacherQualifications": {"update", "teachers"},
+
+	"/main.AttendanceService/MarkAttendance":    {"mark", "attendance"},
+	"/main.AttendanceService/CorrectAttendance": {"correct", "attendance"},
+
+	"/main.GradesService/AddAssessments":    {"create", "assessments"},
+	"/main.GradesService/UpdateAssessments": {"update", "assessments"},
+	"/main.GradesService/DeleteAssessments": {"delete", "assessments"},
+	"/main.GradesService/RecordScores":      {"record", "scores"},
+
+	"/main.ExecsService/AddExecs":           {"create", "execs"},
+	"/main.ExecsService/UpdateExecs":        {"update", "execs"},
+	"/main.ExecsService/DeleteExecs":        {"delete", "execs"},
+	"/main.ExecsService/Login":              {"login", "execs"},
+	"/main.ExecsService/Logout":             {"logout", "execs"},
+	"/main.ExecsService/UpdatePassword":     {"update_password", "execs"},
+	"/main.ExecsService/ResetPassword":      {"reset_password", "execs"},
+	"/main.ExecsService/ForgotPassword":     {"forgot_password", "execs"},
+	"/main.ExecsService/DeactivateUser":     {"deactivate", "execs"},
+	"/main.ExecsService/ActivateAccount":    {"activate", "execs"},
+	"/main.ExecsService/ResendActivation":   {"resend_activation", "execs"},
+	"/main.ExecsService/RequestEmailChange": {"request_email_change", "execs"},
+	"/main.ExecsService/ConfirmEmailChange": {"confirm_email_change", "execs"},
+
+	"/main.NotificationsService/RetryNotifications": {"retry", "notifications"},
+
+	"/main.PrivacyService/ExportPersonData": {"export", "privacy"},
+	"/main.PrivacyService/ErasePersonData":  {"erase", "privacy"},
+}
+
+// AuditInterceptor writes one audit_log entry per mutating RPC, successful or
+// not. It has to run after AuthenticationInterceptor so the actor is known.
+func AuditInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method, ok := auditedMethods[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	auditCtx, rec := audit.NewContext(ctx)
+	rec.ActorId, _ = ctx.Value(utils.ContextKey("userId")).(string)
+	rec.ActorUsername, _ = ctx.Value(utils.ContextKey("username")).(string)
+	rec.Role, _ = ctx.Value(utils.ContextKey("role")).(string)
+
+	resp, err := handler(auditCtx, req)
+
+	entry := &models.AuditEntry{
+		Timestamp:     time.Now().UTC().Format(time.RFC3339),
+		ActorId:       rec.ActorId,
+		ActorUsername: rec.ActorUsername,
+		Role:          rec.Role,
+		Method:        info.FullMethod,
+		Action:        method.action,
+		Entity:        method.entity,
+		TargetIds:     rec.TargetIds,
+		Changes:       rec.Changes,
+		ClientIp:      clientIP(ctx),
+		RequestId:     requestID(ctx),
+		StatusCode:    status.Code(err).String(),
+	}
+
+	// The entry is written even if the client has gone away
+	if auditErr := mongodb.InsertAuditEntryDBHandler(context.WithoutCancel(ctx), entry); auditErr != nil {
+		utils.LoggerFromContext(ctx).Error("failed to write audit entry", "error", auditErr)
+	}
+
+	return resp, err
+}
+
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+func requestID(ctx context.Context) string {
+	if reqID, ok := ctx.Value(utils.ContextKey("requestId")).(string); ok {
+		return reqID
+	}
+	return incomingRequestID(ctx)
+}
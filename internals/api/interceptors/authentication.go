@@ -3,8 +3,6 @@ package interceptors
 import (
 	"context"
 	"fmt"
-	"log"
-	"os"
 	"strings"
 
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
@@ -15,19 +13,53 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// skipMethods can be called without authenticating. Reflection only
+// describes the API, so tools like grpcurl can list services before logging in.
+var skipMethods = map[string]bool{
+	"/main.ExecsService/Login":           true,
+	"/main.ExecsService/ForgotPassword":  true,
+	"/main.ExecsService/ResetPassword":   true,
+	"/main.ExecsService/ActivateAccount": true,
+	"/grpc.health.v1.Health/Check":       true,
+	"/grpc.health.v1.Health/List":        true,
+	"/grpc.health.v1.Health/Watch":       true,
+
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      true,
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
+}
+
 func AuthenticationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	log.Println("AuthenticationInterceptor started")
-
-	// skip specific rpcs
-	log.Println(info.FullMethod)
-	skipMethods := map[string]bool{
-		"/main.ExecsService/Login":          true,
-		"/main.ExecsService/ForgotPassword": true,
-		"/main.ExecsService/ResetPassword":  true,
+	newCtx, err := authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(newCtx, req)
+}
+
+func AuthenticationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	newCtx, err := authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &wrappedStream{ServerStream: ss, ctx: newCtx})
+}
+
+// authenticate checks the caller's JWT or client certificate and returns a
+// context carrying their identity.
+func authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	if skipMethods[fullMethod] {
+		return ctx, nil
 	}
 
-	if skipMethods[info.FullMethod] {
-		return handler(ctx, req)
+	// Service-to-service calls authenticate with a client certificate
+	if cert, role, ok := clientCertPrincipal(ctx); ok {
+		principal := "cert:" + cert.Subject.CommonName
+		newCtx := context.WithValue(ctx, utils.ContextKey("role"), role)
+		newCtx = context.WithValue(newCtx, utils.ContextKey("userId"), principal)
+		newCtx = context.WithValue(newCtx, utils.ContextKey("username"), cert.Subject.CommonName)
+		newCtx = context.WithValue(newCtx, utils.ContextKey("expiresAt"), certExpiresAt(cert))
+		newCtx = utils.ContextWithLogger(newCtx, utils.LoggerFromContext(ctx).With("user_id", principal, "role", role))
+		return newCtx, nil
 	}
 
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -36,7 +68,7 @@ func AuthenticationInterceptor(ctx context.Context, req interface{}, info *grpc.
 	}
 
 	authHeader, ok := md["authorization"]
-	if !ok {
+	if !ok || len(authHeader) == 0 {
 		return nil, status.Errorf(codes.Unauthenticated, "authorization token unavailable")
 	}
 
@@ -48,14 +80,12 @@ func AuthenticationInterceptor(ctx context.Context, req interface{}, info *grpc.
 		return nil, status.Error(codes.Unauthenticated, "Unauthorized Access")
 	}
 
-	jwtSecret := os.Getenv("JWT_SECRET")
-
 	parsedToken, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
 		_, ok := token.Method.(*jwt.SigningMethodHMAC)
 		if !ok {
 			return nil, status.Errorf(codes.Unauthenticated, "Unauthorized Access")
 		}
-		return []byte(jwtSecret), nil
+		return utils.JWTSecret(), nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "Unauthorized Access")
@@ -75,9 +105,20 @@ func AuthenticationInterceptor(ctx context.Context, req interface{}, info *grpc.
 		return nil, status.Error(codes.Unauthenticated, "Unauthorized Access")
 	}
 
-	userId := claims["uid"].(string)
-	username := claims["user"].(string)
-	expiresAtF64 := claims["exp"].(float64)
+	// A correctly signed token can still be missing claims, so none of these
+	// assertions may be unchecked.
+	userId, ok := claims["uid"].(string)
+	if !ok || userId == "" {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized Access")
+	}
+	username, ok := claims["user"].(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized Access")
+	}
+	expiresAtF64, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized Access")
+	}
 	expiresAtI64 := int64(expiresAtF64)
 	expiresAt := fmt.Sprintf("%v", expiresAtI64)
 
@@ -85,7 +126,7 @@ func AuthenticationInterceptor(ctx context.Context, req interface{}, info *grpc.
 	newCtx = context.WithValue(newCtx, utils.ContextKey("userId"), userId)
 	newCtx = context.WithValue(newCtx, utils.ContextKey("username"), username)
 	newCtx = context.WithValue(newCtx, utils.ContextKey("expiresAt"), expiresAt)
+	newCtx = utils.ContextWithLogger(newCtx, utils.LoggerFromContext(ctx).With("user_id", userId, "role", role))
 
-	log.Println("Auth interceptor ending")
-	return handler(newCtx, req)
+	return newCtx, nil
 }
@@ -0,0 +1,88 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthenticateMalformedClaims(t *testing.T) {
+	utils.ConfigureJWT("test-secret", time.Minute)
+	exp := time.Now().Add(time.Minute).Unix()
+
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		want   codes.Code
+	}{
+		{"valid", jwt.MapClaims{"uid": "u1", "user": "admin", "role": "admin", "exp": exp}, codes.OK},
+		{"missing uid", jwt.MapClaims{"user": "admin", "role": "admin", "exp": exp}, codes.Unauthenticated},
+		{"empty uid", jwt.MapClaims{"uid": "", "user": "admin", "role": "admin", "exp": exp}, codes.Unauthenticated},
+		{"numeric uid", jwt.MapClaims{"uid": 42, "user": "admin", "role": "admin", "exp": exp}, codes.Unauthenticated},
+		{"missing role", jwt.MapClaims{"uid": "u1", "user": "admin", "exp": exp}, codes.Unauthenticated},
+		{"array role", jwt.MapClaims{"uid": "u1", "user": "admin", "role": []string{"admin"}, "exp": exp}, codes.Unauthenticated},
+		{"missing user", jwt.MapClaims{"uid": "u1", "role": "admin", "exp": exp}, codes.Unauthenticated},
+		{"missing exp", jwt.MapClaims{"uid": "u1", "user": "admin", "role": "admin"}, codes.Unauthenticated},
+		{"string exp", jwt.MapClaims{"uid": "u1", "user": "admin", "role": "admin", "exp": "tomorrow"}, codes.Unauthenticated},
+		{"expired", jwt.MapClaims{"uid": "u1", "user": "admin", "role": "admin", "exp": time.Now().Add(-time.Minute).Unix()}, codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, tt.claims).SignedString(utils.JWTSecret())
+			if err != nil {
+				t.Fatalf("sign token: %v", err)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+			var authErr error
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						t.Fatalf("authenticate panicked: %v", r)
+					}
+				}()
+				_, authErr = authenticate(ctx, "/main.StudentsService/GetStudents")
+			}()
+			if got := status.Code(authErr); got != tt.want {
+				t.Errorf("got %v (%v), want %v", got, authErr, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthenticateTokenSource(t *testing.T) {
+	utils.ConfigureJWT("test-secret", time.Minute)
+	claims := jwt.MapClaims{"uid": "u1", "user": "admin", "role": "admin", "exp": time.Now().Add(time.Minute).Unix()}
+	wrongKey, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("other-secret"))
+	unsigned, _ := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want codes.Code
+	}{
+		{"no metadata", nil, codes.Unauthenticated},
+		{"no authorization header", metadata.Pairs("x-request-id", "r1"), codes.Unauthenticated},
+		{"garbage token", metadata.Pairs("authorization", "Bearer garbage"), codes.Unauthenticated},
+		{"wrong key", metadata.Pairs("authorization", "Bearer "+wrongKey), codes.Unauthenticated},
+		{"alg none", metadata.Pairs("authorization", "Bearer "+unsigned), codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			_, err := authenticate(ctx, "/main.StudentsService/GetStudents")
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("got %v (%v), want %v", got, err, tt.want)
+			}
+		})
+	}
+}
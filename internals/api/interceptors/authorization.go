@@ -0,0 +1,29 @@
+package interceptors
+
+import (
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamMethodRoles lists the roles allowed to open each streaming RPC. Unary
+// handlers check roles themselves with utils.AuthorizeUser; streams are denied
+// unless listed here, so a new streaming RPC can't be opened to everyone by
+// accident.
+var streamMethodRoles = map[string][]string{}
+
+func AuthorizationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if skipMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+
+	roles, ok := streamMethodRoles[info.FullMethod]
+	if !ok {
+		return status.Error(codes.PermissionDenied, "user not authorized for access")
+	}
+	if err := utils.AuthorizeUser(ss.Context(), roles...); err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,35 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// clientCertPrincipal returns the verified client certificate of an mTLS
+// connection together with the role configured for its common name. Services
+// calling with a known certificate don't need a JWT.
+func clientCertPrincipal(ctx context.Context) (*x509.Certificate, string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, "", false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, "", false
+	}
+
+	leaf := tlsInfo.State.VerifiedChains[0][0]
+	role, ok := settings.TLS.ClientPrincipals[leaf.Subject.CommonName]
+	if !ok {
+		return nil, "", false
+	}
+	return leaf, role, true
+}
+
+func certExpiresAt(cert *x509.Certificate) string {
+	return fmt.Sprintf("%v", cert.NotAfter.Unix())
+}
@@ -0,0 +1,163 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain is reported in every ErrorInfo detail.
+const errorDomain = "grpcapi.school-mgmt"
+
+var codeForKind = map[utils.ErrorKind]codes.Code{
+	utils.KindInternal:         codes.Internal,
+	utils.KindNotFound:         codes.NotFound,
+	utils.KindAlreadyExists:    codes.AlreadyExists,
+	utils.KindInvalidArgument:  codes.InvalidArgument,
+	utils.KindConflict:         codes.FailedPrecondition,
+	utils.KindPermissionDenied: codes.PermissionDenied,
+}
+
+// ErrorMappingInterceptor converts errors returned by handlers into gRPC
+// statuses: domain errors get the code for their kind, validation errors get
+// BadRequest field violations, and both carry an ErrorInfo reason. Errors that
+// already are statuses pass through untouched. Any other error is logged and
+// reaches the client only as "internal error", since driver and library
+// messages can reveal internals. It sits last in the chain so
+// the audit, metrics and tracing interceptors all see the final code.
+func ErrorMappingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, toStatusError(ctx, err)
+	}
+	return resp, nil
+}
+
+// ErrorMappingStreamInterceptor is the stream counterpart of
+// ErrorMappingInterceptor.
+func ErrorMappingStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, ss); err != nil {
+		return toStatusError(ss.Context(), err)
+	}
+	return nil
+}
+
+func toStatusError(ctx context.Context, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	if violations := fieldViolations(err, ""); len(violations) > 0 {
+		message := fmt.Sprintf("invalid %s: %s", violations[0].Field, violations[0].Description)
+		if len(violations) > 1 {
+			message += fmt.Sprintf(" (and %d more violations)", len(violations)-1)
+		}
+		return statusWithDetails(codes.InvalidArgument, message, utils.ReasonValidationFailed, nil,
+			&errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	var domainErr *utils.Error
+	if errors.As(err, &domainErr) {
+		code, ok := codeForKind[domainErr.Kind]
+		if !ok {
+			code = codes.Internal
+		}
+		if domainErr.Field == "" {
+			return statusWithDetails(code, domainErr.Message, domainErr.Reason, nil)
+		}
+		metadata := map[string]string{"field": domainErr.Field}
+		if domainErr.Kind == utils.KindInvalidArgument {
+			return statusWithDetails(code, domainErr.Message, domainErr.Reason, metadata,
+				&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+					{Field: domainErr.Field, Description: domainErr.Message},
+				}})
+		}
+		return statusWithDetails(code, domainErr.Message, domainErr.Reason, metadata)
+	}
+
+	utils.LoggerFromContext(ctx).Error("unmapped error", "error", err)
+	return statusWithDetails(codes.Internal, "internal error", utils.ReasonInternal, nil)
+}
+
+// statusWithDetails builds a status carrying an ErrorInfo with reason and
+// metadata, followed by any extra details.
+func statusWithDetails(code codes.Code, message, reason string, metadata map[string]string, details ...protoadapt.MessageV1) error {
+	if reason == "" {
+		reason = utils.ReasonInternal
+	}
+	details = append(details, &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: metadata})
+
+	st, err := status.New(code, message).WithDetails(details...)
+	if err != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
+
+// validationError is implemented by every protoc-gen-validate error type.
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiValidationError is implemented by the errors ValidateAll returns.
+type multiValidationError interface {
+	AllErrors() []error
+}
+
+// fieldViolations flattens protoc-gen-validate errors into BadRequest field
+// violations, following embedded message errors so nested fields get a full
+// path such as "execs[0].email". It returns nil for any other error.
+func fieldViolations(err error, prefix string) []*errdetails.BadRequest_FieldViolation {
+	var multi multiValidationError
+	if errors.As(err, &multi) {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(e, prefix)...)
+		}
+		return violations
+	}
+
+	var verr validationError
+	if !errors.As(err, &verr) {
+		return nil
+	}
+
+	field := snakeCase(verr.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+	if nested := fieldViolations(verr.Cause(), field); len(nested) > 0 {
+		return nested
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: verr.Reason()}}
+}
+
+// snakeCase turns the Go field names protoc-gen-validate reports (FirstName,
+// Execs[0]) back into proto field names (first_name, execs[0]).
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && name[i-1] != '[' {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
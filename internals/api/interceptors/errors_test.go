@@ -0,0 +1,242 @@
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorMappingInterceptor(t *testing.T) {
+	validID := "0123456789abcdef01234567"
+	invalidMarks := (&pb.MarkAttendanceRequest{
+		ClassId: validID,
+		Date:    "2026-01-05",
+		Period:  13,
+		Marks: []*pb.AttendanceMark{
+			{StudentId: validID, Status: pb.AttendanceStatus_PRESENT},
+			{StudentId: "bad", Status: pb.AttendanceStatus_PRESENT},
+		},
+	}).ValidateAll()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantMsg    string
+		wantReason string
+		wantMeta   map[string]string
+		wantFields []string
+	}{
+		{
+			name:       "not found",
+			err:        utils.NotFoundError(utils.ReasonNotFound, "student not found"),
+			wantCode:   codes.NotFound,
+			wantMsg:    "student not found",
+			wantReason: utils.ReasonNotFound,
+		},
+		{
+			name:       "already exists with field",
+			err:        utils.AlreadyExistsError("email", utils.ReasonAlreadyExists, "email already in use"),
+			wantCode:   codes.AlreadyExists,
+			wantMsg:    "email already in use",
+			wantReason: utils.ReasonAlreadyExists,
+			wantMeta:   map[string]string{"field": "email"},
+		},
+		{
+			name:       "invalid argument with field",
+			err:        utils.InvalidArgumentError("id", utils.ReasonInvalidID, "invalid id"),
+			wantCode:   codes.InvalidArgument,
+			wantMsg:    "invalid id",
+			wantReason: utils.ReasonInvalidID,
+			wantMeta:   map[string]string{"field": "id"},
+			wantFields: []string{"id"},
+		},
+		{
+			name:       "conflict",
+			err:        utils.ConflictError(utils.ReasonLegalHold, "record is under legal hold"),
+			wantCode:   codes.FailedPrecondition,
+			wantMsg:    "record is under legal hold",
+			wantReason: utils.ReasonLegalHold,
+		},
+		{
+			name:       "permission denied",
+			err:        utils.PermissionDeniedError(utils.ReasonPermissionDenied, "not allowed"),
+			wantCode:   codes.PermissionDenied,
+			wantMsg:    "not allowed",
+			wantReason: utils.ReasonPermissionDenied,
+		},
+		{
+			name:       "wrapped domain error",
+			err:        fmt.Errorf("loading: %w", utils.NotFoundError(utils.ReasonNotFound, "class not found")),
+			wantCode:   codes.NotFound,
+			wantMsg:    "class not found",
+			wantReason: utils.ReasonNotFound,
+		},
+		{
+			name:       "internal keeps its message",
+			err:        utils.ErrorHandler(errors.New("connection reset"), "error retrieving data"),
+			wantCode:   codes.Internal,
+			wantMsg:    "error retrieving data",
+			wantReason: utils.ReasonInternal,
+		},
+		{
+			name:       "unknown kind",
+			err:        &utils.Error{Kind: utils.ErrorKind(99), Message: "odd"},
+			wantCode:   codes.Internal,
+			wantMsg:    "odd",
+			wantReason: utils.ReasonInternal,
+		},
+		{
+			name:       "plain error",
+			err:        errors.New("boom"),
+			wantCode:   codes.Internal,
+			wantMsg:    "internal error",
+			wantReason: utils.ReasonInternal,
+		},
+		{
+			name:       "wrapped driver error",
+			err:        fmt.Errorf("update: %w", errors.New("connection(mongo-0:27017[-3]) incomplete read of message header")),
+			wantCode:   codes.Internal,
+			wantMsg:    "internal error",
+			wantReason: utils.ReasonInternal,
+		},
+		{
+			name:       "validation errors",
+			err:        invalidMarks,
+			wantCode:   codes.InvalidArgument,
+			wantMsg:    "invalid period: value must be inside range [0, 12] (and 2 more violations)",
+			wantReason: utils.ReasonValidationFailed,
+			wantFields: []string{"period", "marks[1].student_id", "marks[1].student_id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ErrorMappingInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"},
+				func(ctx context.Context, req interface{}) (interface{}, error) { return nil, tt.err })
+
+			st, ok := status.FromError(err)
+			if !ok {
+				t.Fatalf("error %v is not a status", err)
+			}
+			if st.Code() != tt.wantCode {
+				t.Errorf("code = %v, want %v", st.Code(), tt.wantCode)
+			}
+			if tt.wantMsg != "" && st.Message() != tt.wantMsg {
+				t.Errorf("message = %q, want %q", st.Message(), tt.wantMsg)
+			}
+
+			info, fields := errorDetails(st)
+			if info == nil {
+				t.Fatal("status has no ErrorInfo")
+			}
+			if info.Reason != tt.wantReason || info.Domain != errorDomain {
+				t.Errorf("ErrorInfo = %s/%s, want %s/%s", info.Domain, info.Reason, errorDomain, tt.wantReason)
+			}
+			if len(info.Metadata) > 0 || len(tt.wantMeta) > 0 {
+				if !reflect.DeepEqual(info.Metadata, tt.wantMeta) {
+					t.Errorf("ErrorInfo metadata = %v, want %v", info.Metadata, tt.wantMeta)
+				}
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("field violations = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestErrorMappingPassesStatusesThrough(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{"status", status.Error(codes.Unauthenticated, "no token"), codes.Unauthenticated},
+		{"canceled", context.Canceled, codes.Canceled},
+		{"deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := &wrappedStream{ctx: context.Background()}
+			err := ErrorMappingStreamInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: "/test/Stream"},
+				func(srv interface{}, ss grpc.ServerStream) error { return tt.err })
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+
+	if err := ErrorMappingStreamInterceptor(nil, &wrappedStream{ctx: context.Background()}, &grpc.StreamServerInfo{},
+		func(srv interface{}, ss grpc.ServerStream) error { return nil }); err != nil {
+		t.Errorf("nil error mapped to %v", err)
+	}
+}
+
+func TestUnmappedErrorIsLoggedNotSent(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil)).With("request_id", "req-123")
+	ctx := utils.ContextWithLogger(context.Background(), logger)
+
+	secret := "dial tcp 10.0.3.7:27017: connection refused"
+	_, err := ErrorMappingInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return nil, errors.New(secret) })
+
+	st := status.Convert(err)
+	if strings.Contains(st.Message(), "10.0.3.7") {
+		t.Errorf("status message %q leaks the error", st.Message())
+	}
+	for _, detail := range st.Details() {
+		if strings.Contains(fmt.Sprint(detail), "10.0.3.7") {
+			t.Errorf("status detail %v leaks the error", detail)
+		}
+	}
+	if !strings.Contains(logs.String(), secret) || !strings.Contains(logs.String(), "req-123") {
+		t.Errorf("error not logged with the request ID: %s", logs.String())
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"Id":                  "id",
+		"FirstName":           "first_name",
+		"Execs[0]":            "execs[0]",
+		"HomeroomTeacherId":   "homeroom_teacher_id",
+		"already_snake_cased": "already_snake_cased",
+	}
+	for in, want := range tests {
+		if got := snakeCase(in); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+// errorDetails returns the ErrorInfo and the BadRequest field names carried
+// by st.
+func errorDetails(st *status.Status) (*errdetails.ErrorInfo, []string) {
+	var info *errdetails.ErrorInfo
+	var fields []string
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	return info, fields
+}
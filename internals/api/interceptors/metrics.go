@@ -0,0 +1,50 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const loginMethod = "/main.ExecsService/Login"
+
+func MetricsUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	inFlight := metrics.RPCInFlight.WithLabelValues(info.FullMethod)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeRPC(info.FullMethod, start, err)
+
+	// Login outcomes are tracked separately since a spike in failures is
+	// worth alerting on by itself
+	if info.FullMethod == loginMethod {
+		result := "success"
+		if err != nil {
+			result = "failure"
+		}
+		metrics.LoginAttempts.WithLabelValues(result).Inc()
+	}
+
+	return resp, err
+}
+
+func MetricsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	inFlight := metrics.RPCInFlight.WithLabelValues(info.FullMethod)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	start := time.Now()
+	err := handler(srv, ss)
+	observeRPC(info.FullMethod, start, err)
+	return err
+}
+
+func observeRPC(method string, start time.Time, err error) {
+	metrics.RPCLatency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	metrics.RPCRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+}
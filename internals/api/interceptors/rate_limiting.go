@@ -2,62 +2,209 @@ package interceptors
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"math"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/metrics"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
+// RateLimitStore holds token buckets. Take removes one token from the bucket
+// for key, refilling it at rate tokens per second up to capacity, and reports
+// how long to wait when the bucket is empty. Refund gives back a token taken
+// for a call that another bucket then rejected.
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, rate float64, capacity int) (bool, time.Duration, error)
+	Refund(ctx context.Context, key string, capacity int) error
+}
+
 type rateLimiter struct {
-	mu        sync.Mutex
-	visitors  map[string]int
-	limit     int
-	resetTime time.Duration
+	store RateLimitStore
+	cfg   config.RateLimitConfig
 }
 
-func NewRateLimiter(limit int, resetTime time.Duration) *rateLimiter {
-	rl := &rateLimiter{
-		visitors:  make(map[string]int),
-		limit:     limit,
-		resetTime: resetTime,
+// NewRateLimiter returns a limiter using the quotas in cfg. Buckets live in
+// memory unless cfg.Store is "mongo", in which case all replicas share them.
+func NewRateLimiter(cfg config.RateLimitConfig) *rateLimiter {
+	var store RateLimitStore = newMemoryStore()
+	if cfg.Store == "mongo" {
+		store = mongoStore{}
 	}
-	// start the reset routine
-	go rl.resetVisitorCount()
-	return rl
+	return &rateLimiter{store: store, cfg: cfg}
 }
 
-func (rl *rateLimiter) resetVisitorCount() {
-	for {
-		time.Sleep(rl.resetTime)
-		rl.mu.Lock()
-		rl.visitors = make(map[string]int)
-		rl.mu.Unlock()
+func (rl *rateLimiter) RateLimitInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := rl.allow(ctx, info.FullMethod); err != nil {
+		return nil, err
 	}
+	return handler(ctx, req)
 }
 
-func (rl *rateLimiter) RateLimitInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	fmt.Println("Rate Limiter Middleware being returned...")
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
+func (rl *rateLimiter) RateLimitStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := rl.allow(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// limit is one bucket a call must take a token from.
+type limit struct {
+	name  string
+	key   string
+	quota config.Quota
+}
 
-	p, ok := peer.FromContext(ctx)
+// allow takes a token from the caller's IP, user and method buckets, and
+// rejects the call as soon as one is empty, refunding the tokens already
+// taken so rejected calls do not use up the other quotas. It must run after
+// authentication so that signed in users are limited by user ID as well as by
+// address.
+func (rl *rateLimiter) allow(ctx context.Context, fullMethod string) error {
+	var taken []limit
+	for _, l := range rl.limits(ctx, fullMethod) {
+		allowed, wait, err := rl.store.Take(ctx, l.key, l.quota.Rate(), l.quota.Capacity())
+		if err != nil {
+			// Fail open; an unavailable store should not take the API down
+			utils.LoggerFromContext(ctx).Error("rate limit store unavailable", "error", err)
+			continue
+		}
+		if allowed {
+			taken = append(taken, l)
+			continue
+		}
+		rl.refund(ctx, taken)
+
+		utils.LoggerFromContext(ctx).Warn("rate limit exceeded", "bucket", l.name, "key", l.key, "retry_after", wait.String())
+		metrics.RateLimitRejections.WithLabelValues(fullMethod).Inc()
+
+		st, err := status.New(codes.ResourceExhausted, "Too many requests").WithDetails(&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(wait),
+		})
+		if err != nil {
+			return status.Error(codes.ResourceExhausted, "Too many requests")
+		}
+		return st.Err()
+	}
+	return nil
+}
+
+func (rl *rateLimiter) refund(ctx context.Context, taken []limit) {
+	for _, l := range taken {
+		if err := rl.store.Refund(ctx, l.key, l.quota.Capacity()); err != nil {
+			utils.LoggerFromContext(ctx).Error("rate limit refund failed", "bucket", l.name, "key", l.key, "error", err)
+		}
+	}
+}
+
+// limits lists the buckets for a call: the client IP's and the signed in
+// user's, unless turned off, and the caller's bucket for the method.
+func (rl *rateLimiter) limits(ctx context.Context, fullMethod string) []limit {
+	var limits []limit
+	caller := "ip:" + clientIP(ctx)
+	if rl.cfg.PerIP.Requests > 0 {
+		limits = append(limits, limit{name: "ip", key: caller, quota: rl.cfg.PerIP})
+	}
+	if userId, ok := ctx.Value(utils.ContextKey("userId")).(string); ok && userId != "" {
+		caller = "user:" + userId
+		if rl.cfg.PerUser.Requests > 0 {
+			limits = append(limits, limit{name: "user", key: caller, quota: rl.cfg.PerUser})
+		}
+	}
+	return append(limits, limit{name: "method", key: caller + "|" + fullMethod, quota: rl.quota(fullMethod)})
+}
+
+func (rl *rateLimiter) quota(fullMethod string) config.Quota {
+	if q, ok := rl.cfg.Methods[fullMethod]; ok {
+		return q
+	}
+	if q, ok := rl.cfg.Methods[fullMethod[strings.LastIndex(fullMethod, "/")+1:]]; ok {
+		return q
+	}
+	return rl.cfg.Default
+}
+
+type bucket struct {
+	tokens   float64
+	updated  time.Time
+	rate     float64
+	capacity int
+}
+
+// memoryStore keeps buckets for this process only. The lock is held just long
+// enough to update one bucket.
+type memoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{buckets: make(map[string]*bucket), lastSweep: time.Now(), now: time.Now}
+}
+
+func (m *memoryStore) Take(_ context.Context, key string, rate float64, capacity int) (bool, time.Duration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
 	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "unable to get client IP")
+		b = &bucket{tokens: float64(capacity), updated: now, rate: rate, capacity: capacity}
+		m.buckets[key] = b
 	}
 
-	visitorIP := p.Addr.String()
-	rl.visitors[visitorIP]++
-	log.Printf("+++++++++ Vistor count from IP: %s: %d\n", visitorIP, rl.visitors[visitorIP])
+	b.tokens = math.Min(float64(capacity), b.tokens+now.Sub(b.updated).Seconds()*rate)
+	b.updated = now
 
-	if rl.visitors[visitorIP] > rl.limit {
-		return nil, status.Error(codes.ResourceExhausted, "Too many requests")
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
 	}
+	return false, time.Duration((1 - b.tokens) / rate * float64(time.Second)), nil
+}
 
-	fmt.Println("Rate Limiter ends...")
-	return handler(ctx, req)
-}
\ No newline at end of file
+func (m *memoryStore) Refund(_ context.Context, key string, capacity int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if b, ok := m.buckets[key]; ok {
+		b.tokens = math.Min(float64(capacity), b.tokens+1)
+	}
+	return nil
+}
+
+// sweep drops buckets that have been idle long enough to be full again, so
+// the map does not grow with every address ever seen.
+func (m *memoryStore) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < time.Minute {
+		return
+	}
+	m.lastSweep = now
+	for key, b := range m.buckets {
+		if b.tokens+now.Sub(b.updated).Seconds()*b.rate >= float64(b.capacity) {
+			delete(m.buckets, key)
+		}
+	}
+}
+
+type mongoStore struct{}
+
+func (mongoStore) Take(ctx context.Context, key string, rate float64, capacity int) (bool, time.Duration, error) {
+	return mongodb.TakeRateLimitTokenDBHandler(ctx, key, rate, capacity)
+}
+
+func (mongoStore) Refund(ctx context.Context, key string, capacity int) error {
+	return mongodb.RefundRateLimitTokenDBHandler(ctx, key, capacity)
+}
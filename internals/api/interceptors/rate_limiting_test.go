@@ -0,0 +1,204 @@
+package interceptors
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// fakeClock is a settable time source for memoryStore.
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time          { return c.t }
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestMemoryStore() (*memoryStore, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	m := newMemoryStore()
+	m.now = clock.now
+	m.lastSweep = clock.t
+	return m, clock
+}
+
+func TestMemoryStoreTake(t *testing.T) {
+	type take struct {
+		after   time.Duration
+		allowed bool
+		wait    time.Duration
+	}
+	tests := []struct {
+		name     string
+		rate     float64
+		capacity int
+		takes    []take
+	}{
+		{
+			name: "starts full", rate: 1, capacity: 3,
+			takes: []take{{0, true, 0}, {0, true, 0}, {0, true, 0}, {0, false, time.Second}},
+		},
+		{
+			name: "refills at rate", rate: 2, capacity: 1,
+			takes: []take{{0, true, 0}, {0, false, 500 * time.Millisecond}, {250 * time.Millisecond, false, 250 * time.Millisecond}, {250 * time.Millisecond, true, 0}},
+		},
+		{
+			name: "refill capped at capacity", rate: 10, capacity: 2,
+			takes: []take{{0, true, 0}, {0, true, 0}, {time.Hour, true, 0}, {0, true, 0}, {0, false, 100 * time.Millisecond}},
+		},
+		{
+			name: "slow rate", rate: 5.0 / 60, capacity: 5,
+			takes: []take{{0, true, 0}, {0, true, 0}, {0, true, 0}, {0, true, 0}, {0, true, 0}, {0, false, 12 * time.Second}, {6 * time.Second, false, 6 * time.Second}, {6 * time.Second, true, 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, clock := newTestMemoryStore()
+			for i, tk := range tt.takes {
+				clock.advance(tk.after)
+				allowed, wait, err := m.Take(context.Background(), "k", tt.rate, tt.capacity)
+				if err != nil {
+					t.Fatalf("take %d: %v", i, err)
+				}
+				if allowed != tk.allowed {
+					t.Fatalf("take %d: allowed = %v, want %v", i, allowed, tk.allowed)
+				}
+				if diff := wait - tk.wait; diff < -time.Millisecond || diff > time.Millisecond {
+					t.Errorf("take %d: wait = %v, want %v", i, wait, tk.wait)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryStoreKeysAreSeparate(t *testing.T) {
+	m, _ := newTestMemoryStore()
+	if ok, _, _ := m.Take(context.Background(), "a", 1, 1); !ok {
+		t.Fatal("first take on a rejected")
+	}
+	if ok, _, _ := m.Take(context.Background(), "a", 1, 1); ok {
+		t.Fatal("second take on a allowed")
+	}
+	if ok, _, _ := m.Take(context.Background(), "b", 1, 1); !ok {
+		t.Fatal("take on b rejected after a ran out")
+	}
+}
+
+func TestMemoryStoreSweep(t *testing.T) {
+	m, clock := newTestMemoryStore()
+	m.Take(context.Background(), "idle", 1, 2)
+	m.Take(context.Background(), "busy", 0.001, 2)
+
+	clock.advance(2 * time.Minute)
+	m.Take(context.Background(), "other", 1, 2)
+
+	if _, ok := m.buckets["idle"]; ok {
+		t.Error("refilled bucket was not swept")
+	}
+	if _, ok := m.buckets["busy"]; !ok {
+		t.Error("bucket still refilling was swept")
+	}
+}
+
+func TestRateLimiterBuckets(t *testing.T) {
+	cfg := config.RateLimitConfig{
+		PerIP:   config.Quota{Requests: 4, Per: time.Hour},
+		PerUser: config.Quota{Requests: 3, Per: time.Hour},
+		Default: config.Quota{Requests: 2, Per: time.Hour},
+		Methods: map[string]config.Quota{"Login": {Requests: 1, Per: time.Hour}},
+	}
+
+	type call struct {
+		ip     string
+		userId string
+		method string
+		want   codes.Code
+	}
+	tests := []struct {
+		name  string
+		cfg   config.RateLimitConfig
+		calls []call
+	}{
+		{
+			name: "per method",
+			cfg:  cfg,
+			calls: []call{
+				{"10.0.0.1", "", "/main.ExecsService/Login", codes.OK},
+				{"10.0.0.1", "", "/main.ExecsService/Login", codes.ResourceExhausted},
+				{"10.0.0.2", "", "/main.ExecsService/Login", codes.OK},
+			},
+		},
+		{
+			name: "per user across methods",
+			cfg:  cfg,
+			calls: []call{
+				{"10.0.0.1", "u1", "/main.StudentsService/GetStudents", codes.OK},
+				{"10.0.0.2", "u1", "/main.StudentsService/GetStudents", codes.OK},
+				{"10.0.0.3", "u1", "/main.TeachersService/GetTeachers", codes.OK},
+				{"10.0.0.4", "u1", "/main.ClassesService/GetClasses", codes.ResourceExhausted},
+				{"10.0.0.4", "u2", "/main.ClassesService/GetClasses", codes.OK},
+			},
+		},
+		{
+			name: "per IP across users",
+			cfg:  cfg,
+			calls: []call{
+				{"10.0.0.1", "u1", "/main.StudentsService/GetStudents", codes.OK},
+				{"10.0.0.1", "u2", "/main.StudentsService/GetStudents", codes.OK},
+				{"10.0.0.1", "u3", "/main.StudentsService/GetStudents", codes.OK},
+				{"10.0.0.1", "", "/main.ExecsService/Login", codes.OK},
+				{"10.0.0.1", "u4", "/main.StudentsService/GetStudents", codes.ResourceExhausted},
+				{"10.0.0.2", "u4", "/main.StudentsService/GetStudents", codes.OK},
+			},
+		},
+		{
+			name: "rejected calls refund the other buckets",
+			cfg:  cfg,
+			calls: []call{
+				{"10.0.0.1", "", "/main.ExecsService/Login", codes.OK},
+				{"10.0.0.1", "", "/main.ExecsService/Login", codes.ResourceExhausted},
+				{"10.0.0.1", "", "/main.ExecsService/Login", codes.ResourceExhausted},
+				{"10.0.0.1", "", "/main.ExecsService/Login", codes.ResourceExhausted},
+				{"10.0.0.1", "u1", "/main.StudentsService/GetStudents", codes.OK},
+				{"10.0.0.1", "u1", "/main.StudentsService/GetStudents", codes.OK},
+				{"10.0.0.1", "u1", "/main.StudentsService/GetStudents", codes.ResourceExhausted},
+				{"10.0.0.1", "u1", "/main.TeachersService/GetTeachers", codes.OK},
+				{"10.0.0.1", "u1", "/main.ClassesService/GetClasses", codes.ResourceExhausted},
+			},
+		},
+		{
+			name: "per IP and per user off",
+			cfg:  config.RateLimitConfig{Default: cfg.Default},
+			calls: []call{
+				{"10.0.0.1", "u1", "/main.StudentsService/GetStudents", codes.OK},
+				{"10.0.0.1", "u1", "/main.TeachersService/GetTeachers", codes.OK},
+				{"10.0.0.1", "u1", "/main.ClassesService/GetClasses", codes.OK},
+				{"10.0.0.1", "u1", "/main.SubjectsService/GetSubjects", codes.OK},
+				{"10.0.0.1", "u1", "/main.ExecsService/GetExecs", codes.OK},
+				{"10.0.0.1", "u1", "/main.ExecsService/GetExecs", codes.OK},
+				{"10.0.0.1", "u1", "/main.ExecsService/GetExecs", codes.ResourceExhausted},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, _ := newTestMemoryStore()
+			rl := &rateLimiter{store: store, cfg: tt.cfg}
+			for i, c := range tt.calls {
+				ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(c.ip), Port: 5000 + i}})
+				if c.userId != "" {
+					ctx = context.WithValue(ctx, utils.ContextKey("userId"), c.userId)
+				}
+				err := rl.allow(ctx, c.method)
+				if got := status.Code(err); got != c.want {
+					t.Errorf("call %d (%s %s %s): got %v, want %v", i, c.ip, c.userId, c.method, got, c.want)
+				}
+			}
+		})
+	}
+}
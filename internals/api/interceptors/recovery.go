@@ -0,0 +1,68 @@
+package interceptors
+
+import (
+	"context"
+	"runtime/debug"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/metrics"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryInterceptor turns a panic in a unary handler, or in any interceptor
+// after it in the chain, into an Internal error instead of crashing the server.
+// It should be first in the chain.
+func RecoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	ctx, reqID := ensureRequestID(ctx)
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ctx, info.FullMethod, reqID, r)
+			grpc.SetTrailer(ctx, metadata.Pairs(requestIDHeader, reqID))
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// RecoveryStreamInterceptor turns a panic in a stream handler, or in any
+// interceptor after it, into an Internal error instead of crashing the server.
+func RecoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	ctx, reqID := ensureRequestID(ss.Context())
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ctx, info.FullMethod, reqID, r)
+			ss.SetTrailer(metadata.Pairs(requestIDHeader, reqID))
+		}
+	}()
+	return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+}
+
+// ensureRequestID adds a fresh x-request-id to the incoming metadata when the
+// client sent none, so the request ID interceptor further down the chain
+// reuses it and a recovered panic can be logged and reported with the same ID.
+func ensureRequestID(ctx context.Context) (context.Context, string) {
+	if reqID := incomingRequestID(ctx); reqID != "" {
+		return ctx, reqID
+	}
+	reqID := newRequestID()
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(requestIDHeader, reqID)
+	return metadata.NewIncomingContext(ctx, md), reqID
+}
+
+// recovered logs the panic with its stack and returns the error sent to the
+// client. The panic value is never included since it may carry internals;
+// the client gets reqID in the x-request-id trailer to quote instead.
+func recovered(ctx context.Context, fullMethod, reqID string, r interface{}) error {
+	metrics.PanicsRecovered.WithLabelValues(fullMethod).Inc()
+	utils.LoggerFromContext(ctx).Error("panic in handler",
+		"method", fullMethod,
+		"request_id", reqID,
+		"panic", r,
+		"stack", string(debug.Stack()),
+	)
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,144 @@
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler grpc.UnaryHandler
+		want    codes.Code
+	}{
+		{"no panic", func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }, codes.OK},
+		{"error passes through", func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "missing")
+		}, codes.NotFound},
+		{"panic with string", func(ctx context.Context, req interface{}) (interface{}, error) { panic("boom") }, codes.Internal},
+		{"panic with error", func(ctx context.Context, req interface{}) (interface{}, error) { panic(errors.New("boom")) }, codes.Internal},
+		{"nil map write", func(ctx context.Context, req interface{}) (interface{}, error) {
+			var m map[string]int
+			m["x"] = 1
+			return nil, nil
+		}, codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+			_, err := RecoveryInterceptor(context.Background(), nil, info, tt.handler)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("got %v (%v), want %v", got, err, tt.want)
+			}
+			if tt.want == codes.Internal && status.Convert(err).Message() != "internal error" {
+				t.Errorf("panic value leaked to the client: %q", status.Convert(err).Message())
+			}
+		})
+	}
+}
+
+func TestRecoveryStreamInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}
+	err := RecoveryStreamInterceptor(nil, &trailerStream{ctx: context.Background()}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		panic("boom")
+	})
+	if got := status.Code(err); got != codes.Internal {
+		t.Errorf("got %v (%v), want %v", got, err, codes.Internal)
+	}
+}
+
+func TestRecoveryReportsRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		clientID string
+	}{
+		{"client request ID", "client-id-1"},
+		{"generated request ID", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			ctx := utils.ContextWithLogger(context.Background(), slog.New(slog.NewJSONHandler(&logs, nil)))
+			if tt.clientID != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(requestIDHeader, tt.clientID))
+			}
+			ts := &transportStream{}
+			ctx = grpc.NewContextWithServerTransportStream(ctx, ts)
+
+			var handlerID string
+			info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+			_, err := RecoveryInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerID = incomingRequestID(ctx)
+				panic("boom")
+			})
+			if got := status.Code(err); got != codes.Internal {
+				t.Fatalf("got %v (%v), want %v", got, err, codes.Internal)
+			}
+
+			trailerID := ts.trailer.Get(requestIDHeader)
+			if len(trailerID) != 1 || trailerID[0] == "" {
+				t.Fatalf("trailer %v has no request ID", ts.trailer)
+			}
+			if tt.clientID != "" && trailerID[0] != tt.clientID {
+				t.Errorf("trailer request ID = %q, want the client's %q", trailerID[0], tt.clientID)
+			}
+			if handlerID != trailerID[0] {
+				t.Errorf("handler saw request ID %q, trailer has %q", handlerID, trailerID[0])
+			}
+			if !strings.Contains(logs.String(), `"request_id":"`+trailerID[0]+`"`) {
+				t.Errorf("panic not logged with request ID %q: %s", trailerID[0], logs.String())
+			}
+		})
+	}
+}
+
+func TestRecoveryStreamReportsRequestID(t *testing.T) {
+	ss := &trailerStream{ctx: context.Background()}
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}
+	var handlerID string
+	err := RecoveryStreamInterceptor(nil, ss, info, func(srv interface{}, stream grpc.ServerStream) error {
+		handlerID = incomingRequestID(stream.Context())
+		panic("boom")
+	})
+	if got := status.Code(err); got != codes.Internal {
+		t.Fatalf("got %v (%v), want %v", got, err, codes.Internal)
+	}
+	if got := ss.trailer.Get(requestIDHeader); len(got) != 1 || got[0] == "" || got[0] != handlerID {
+		t.Errorf("trailer request ID = %v, want the handler's %q", got, handlerID)
+	}
+}
+
+// trailerStream records the trailer set on a stream.
+type trailerStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	trailer metadata.MD
+}
+
+func (s *trailerStream) Context() context.Context { return s.ctx }
+
+func (s *trailerStream) SetTrailer(md metadata.MD) { s.trailer = metadata.Join(s.trailer, md) }
+
+// transportStream records the trailer set on a unary call.
+type transportStream struct {
+	trailer metadata.MD
+}
+
+func (s *transportStream) Method() string                  { return "" }
+func (s *transportStream) SetHeader(md metadata.MD) error  { return nil }
+func (s *transportStream) SendHeader(md metadata.MD) error { return nil }
+
+func (s *transportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
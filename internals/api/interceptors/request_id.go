@@ -0,0 +1,67 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const requestIDHeader = "x-request-id"
+
+// RequestIDInterceptor makes sure every call has a request ID, reusing the
+// client's x-request-id when present, and returns it in the response headers.
+// It also stores a logger carrying the ID, method and peer in the context, so
+// it should come right after recovery in the chain.
+func RequestIDInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	newCtx, reqID := withRequestID(ctx, info.FullMethod)
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, reqID))
+	return handler(newCtx, req)
+}
+
+func RequestIDStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	newCtx, reqID := withRequestID(ss.Context(), info.FullMethod)
+	ss.SetHeader(metadata.Pairs(requestIDHeader, reqID))
+	return handler(srv, &wrappedStream{ServerStream: ss, ctx: newCtx})
+}
+
+func withRequestID(ctx context.Context, fullMethod string) (context.Context, string) {
+	reqID := incomingRequestID(ctx)
+	if reqID == "" {
+		reqID = newRequestID()
+	}
+
+	logger := utils.Logger.With(
+		"request_id", reqID,
+		"method", fullMethod,
+		"peer", clientIP(ctx),
+	)
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsValid() {
+		logger = logger.With("trace_id", spanCtx.TraceID().String())
+	}
+
+	newCtx := context.WithValue(ctx, utils.ContextKey("requestId"), reqID)
+	newCtx = utils.ContextWithLogger(newCtx, logger)
+	return newCtx, reqID
+}
+
+func incomingRequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ids := md.Get(requestIDHeader); len(ids) > 0 && len(ids[0]) <= 128 {
+		return ids[0]
+	}
+	return ""
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -2,10 +2,9 @@ package interceptors
 
 import (
 	"context"
-	"fmt"
-	"log"
 	"time"
 
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -13,7 +12,6 @@ import (
 
 func ResponseTimeInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 
-	log.Println("ResponseTimeInterceptor invoked")
 	// Record the start time
 	start := time.Now()
 
@@ -25,11 +23,31 @@ func ResponseTimeInterceptor(ctx context.Context, req interface{}, info *grpc.Un
 
 	// Log the request details with duration
 	st, _ := status.FromError(err)
-	fmt.Printf("Method: %s, Status: %s, Response Time: %s\n", info.FullMethod, st.Code(), elapsed)
+	utils.LoggerFromContext(ctx).Info("rpc completed",
+		"code", st.Code().String(),
+		"duration_ms", float64(elapsed.Microseconds())/1000,
+	)
 
 	md := metadata.Pairs("X-Response-Time", elapsed.String())
 	grpc.SendHeader(ctx, md)
 
-	log.Println("Sending response from ResponseTimeInterceptor")
 	return resp, err
 }
+
+// ResponseTimeStreamInterceptor logs the duration of a stream. Headers have
+// usually gone out with the first message by the time it ends, so the
+// duration is returned as a trailer instead.
+func ResponseTimeStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	elapsed := time.Since(start)
+
+	st, _ := status.FromError(err)
+	utils.LoggerFromContext(ss.Context()).Info("stream completed",
+		"code", st.Code().String(),
+		"duration_ms", float64(elapsed.Microseconds())/1000,
+	)
+
+	ss.SetTrailer(metadata.Pairs("X-Response-Time", elapsed.String()))
+	return err
+}
@@ -0,0 +1,11 @@
+package interceptors
+
+import "github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+
+// settings holds the configuration the interceptors need. It is set once by
+// Configure before the server starts.
+var settings = config.Default()
+
+func Configure(cfg *config.Config) {
+	settings = *cfg
+}
@@ -0,0 +1,18 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// wrappedStream lets a stream interceptor hand a modified context down the
+// chain, the way unary interceptors pass a new ctx to the handler.
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
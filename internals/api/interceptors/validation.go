@@ -0,0 +1,88 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validator is implemented by every message generated by protoc-gen-validate.
+// ValidateAll is used rather than Validate so the caller gets every violation
+// at once instead of only the first.
+type validator interface {
+	ValidateAll() error
+}
+
+// ValidationInterceptor checks every request against its proto rules before
+// the handler runs. In debug mode the response is checked as well, which
+// catches handlers that build messages their own clients would reject.
+func ValidationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := validateRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	if err := validateResponse(ctx, info.FullMethod, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ValidationStreamInterceptor applies the same checks to every message a
+// stream receives and, in debug mode, sends.
+func ValidationStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingStream{ServerStream: ss, fullMethod: info.FullMethod})
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+	fullMethod string
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(s.Context(), m)
+}
+
+func (s *validatingStream) SendMsg(m interface{}) error {
+	if err := validateResponse(s.Context(), s.fullMethod, m); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+func validateRequest(ctx context.Context, req interface{}) error {
+	v, ok := req.(validator)
+	if !ok {
+		return nil
+	}
+	if err := v.ValidateAll(); err != nil {
+		return toStatusError(ctx, err)
+	}
+	return nil
+}
+
+// validateResponse reports a response that breaks its own rules as an
+// internal error, since it is a server bug rather than a client mistake.
+func validateResponse(ctx context.Context, fullMethod string, resp interface{}) error {
+	if !settings.Server.Debug {
+		return nil
+	}
+	v, ok := resp.(validator)
+	if !ok {
+		return nil
+	}
+	if err := v.ValidateAll(); err != nil {
+		utils.LoggerFromContext(ctx).Error("response failed validation", "method", fullMethod, "error", err)
+		return status.Error(codes.Internal, "response failed validation")
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidationInterceptor(t *testing.T) {
+	saved := settings
+	defer func() { settings = saved }()
+
+	tests := []struct {
+		name        string
+		debug       bool
+		req         interface{}
+		resp        interface{}
+		wantCode    codes.Code
+		wantHandler bool
+	}{
+		{
+			name:        "valid request",
+			req:         &pb.QueryAuditLogRequest{PageSize: 500},
+			resp:        &pb.Class{},
+			wantCode:    codes.OK,
+			wantHandler: true,
+		},
+		{
+			name:     "invalid request skips the handler",
+			req:      &pb.QueryAuditLogRequest{PageSize: 501},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:        "messages without rules pass",
+			req:         "not a proto message",
+			resp:        "not a proto message",
+			wantCode:    codes.OK,
+			wantHandler: true,
+		},
+		{
+			name:        "invalid response ignored outside debug mode",
+			req:         &pb.QueryAuditLogRequest{},
+			resp:        &pb.Class{Capacity: -1},
+			wantCode:    codes.OK,
+			wantHandler: true,
+		},
+		{
+			name:        "invalid response in debug mode",
+			debug:       true,
+			req:         &pb.QueryAuditLogRequest{},
+			resp:        &pb.Class{Capacity: -1},
+			wantCode:    codes.Internal,
+			wantHandler: true,
+		},
+		{
+			name:        "valid response in debug mode",
+			debug:       true,
+			req:         &pb.QueryAuditLogRequest{},
+			resp:        &pb.Class{GradeLevel: 9, Section: "A"},
+			wantCode:    codes.OK,
+			wantHandler: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings.Server.Debug = tt.debug
+			called := false
+			resp, err := ValidationInterceptor(context.Background(), tt.req, &grpc.UnaryServerInfo{FullMethod: "/test/Method"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					called = true
+					return tt.resp, nil
+				})
+
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if called != tt.wantHandler {
+				t.Errorf("handler called = %v, want %v", called, tt.wantHandler)
+			}
+			if err != nil && resp != nil {
+				t.Errorf("response %v returned with error %v", resp, err)
+			}
+		})
+	}
+}
+
+func TestValidationStreamInterceptor(t *testing.T) {
+	saved := settings
+	defer func() { settings = saved }()
+	settings.Server.Debug = true
+
+	tests := []struct {
+		name         string
+		recv         *pb.QueryAuditLogRequest
+		send         *pb.Class
+		wantRecvCode codes.Code
+		wantSendCode codes.Code
+		wantSent     bool
+	}{
+		{
+			name:         "valid messages",
+			recv:         &pb.QueryAuditLogRequest{PageSize: 10},
+			send:         &pb.Class{Room: "B-12"},
+			wantRecvCode: codes.OK,
+			wantSendCode: codes.OK,
+			wantSent:     true,
+		},
+		{
+			name:         "invalid received message",
+			recv:         &pb.QueryAuditLogRequest{PageSize: 1000},
+			send:         &pb.Class{},
+			wantRecvCode: codes.InvalidArgument,
+			wantSendCode: codes.OK,
+			wantSent:     true,
+		},
+		{
+			name:         "invalid sent message is not sent",
+			recv:         &pb.QueryAuditLogRequest{},
+			send:         &pb.Class{Room: "B_12"},
+			wantRecvCode: codes.OK,
+			wantSendCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := &fakeServerStream{ctx: context.Background(), recv: tt.recv}
+			var recvErr, sendErr error
+			err := ValidationStreamInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: "/test/Stream"},
+				func(srv interface{}, stream grpc.ServerStream) error {
+					recvErr = stream.RecvMsg(&pb.QueryAuditLogRequest{})
+					sendErr = stream.SendMsg(tt.send)
+					return nil
+				})
+			if err != nil {
+				t.Fatalf("ValidationStreamInterceptor() = %v", err)
+			}
+
+			if code := status.Code(recvErr); code != tt.wantRecvCode {
+				t.Errorf("RecvMsg code = %v, want %v", code, tt.wantRecvCode)
+			}
+			if code := status.Code(sendErr); code != tt.wantSendCode {
+				t.Errorf("SendMsg code = %v, want %v", code, tt.wantSendCode)
+			}
+			if sent := len(ss.sent) > 0; sent != tt.wantSent {
+				t.Errorf("message sent = %v, want %v", sent, tt.wantSent)
+			}
+		})
+	}
+}
+
+// fakeServerStream receives recv once and records what is sent.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	recv proto.Message
+	sent []interface{}
+}
+
+func (s *fakeServerStream) Context() context.Context { return s.ctx }
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.recv)
+	return nil
+}
+
+func (s *fakeServerStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
@@ -0,0 +1,145 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+)
+
+type contextKey struct{}
+
+// Record collects what a single RPC did. The audit interceptor puts one in the
+// context and the repository layer fills it in through the hooks below.
+type Record struct {
+	mu            sync.Mutex
+	ActorId       string
+	ActorUsername string
+	Role          string
+	TargetIds     []string
+	Changes       []models.AuditChange
+}
+
+// redactedFields never have their values written to the audit log.
+var redactedFields = map[string]bool{
+	"password":             true,
+	"password_reset_token": true,
+	"activation_token":     true,
+	"email_change_token":   true,
+}
+
+// IsSecretField reports whether values of the named field must never be
+// written out, whether to the audit log or to a data export.
+func IsSecretField(field string) bool {
+	return redactedFields[field]
+}
+
+func NewContext(ctx context.Context) (context.Context, *Record) {
+	rec := &Record{}
+	return context.WithValue(ctx, contextKey{}, rec), rec
+}
+
+func FromContext(ctx context.Context) *Record {
+	rec, _ := ctx.Value(contextKey{}).(*Record)
+	return rec
+}
+
+// SetActor overrides the actor taken from the JWT. Used by calls such as Login
+// where the caller is only known once the request has been processed.
+func SetActor(ctx context.Context, id, username, role string) {
+	rec := FromContext(ctx)
+	if rec == nil {
+		return
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.ActorId = id
+	rec.ActorUsername = username
+	rec.Role = role
+}
+
+func AddTarget(ctx context.Context, targetId string) {
+	rec := FromContext(ctx)
+	if rec == nil {
+		return
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.addTarget(targetId)
+}
+
+// RecordChange stores the field-level difference between before and after for
+// one document. Pass nil for before on inserts and nil for after on deletes.
+func RecordChange(ctx context.Context, targetId string, before, after map[string]interface{}) {
+	rec := FromContext(ctx)
+	if rec == nil {
+		return
+	}
+	changes := Diff(targetId, before, after)
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.addTarget(targetId)
+	rec.Changes = append(rec.Changes, changes...)
+}
+
+func (rec *Record) addTarget(targetId string) {
+	for _, id := range rec.TargetIds {
+		if id == targetId {
+			return
+		}
+	}
+	rec.TargetIds = append(rec.TargetIds, targetId)
+}
+
+// Diff lists the fields whose values differ between before and after, with
+// secret values replaced by a placeholder.
+func Diff(targetId string, before, after map[string]interface{}) []models.AuditChange {
+	fields := map[string]bool{}
+	for k := range before {
+		fields[k] = true
+	}
+	for k := range after {
+		fields[k] = true
+	}
+	delete(fields, "_id")
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var changes []models.AuditChange
+	for _, field := range keys {
+		oldVal, hadOld := before[field]
+		newVal, hasNew := after[field]
+		if hadOld && hasNew && reflect.DeepEqual(oldVal, newVal) {
+			continue
+		}
+
+		change := models.AuditChange{TargetId: targetId, Field: field}
+		if hadOld && oldVal != nil {
+			change.Before = fmt.Sprintf("%v", oldVal)
+		}
+		if hasNew && newVal != nil {
+			change.After = fmt.Sprintf("%v", newVal)
+		}
+		if redactedFields[field] {
+			change.Before = redact(change.Before)
+			change.After = redact(change.After)
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func redact(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "[REDACTED]"
+}
@@ -0,0 +1,459 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the complete server configuration. Values are layered, each source
+// overriding the previous one: built-in defaults, the YAML file, environment
+// variables and finally command-line flags.
+type Config struct {
+	Server     ServerConfig     `yaml:"server"`
+	TLS        TLSConfig        `yaml:"tls"`
+	RateLimit  RateLimitConfig  `yaml:"rate_limit"`
+	Mongo      MongoConfig      `yaml:"mongo"`
+	Auth       AuthConfig       `yaml:"auth"`
+	Mail       MailConfig       `yaml:"mail"`
+	Log        LogConfig        `yaml:"log"`
+	Tracing    TracingConfig    `yaml:"tracing"`
+	Privacy    PrivacyConfig    `yaml:"privacy"`
+	Statistics StatisticsConfig `yaml:"statistics"`
+	Grades     GradesConfig     `yaml:"grades"`
+}
+
+type ServerConfig struct {
+	Port            string        `yaml:"port"`
+	HealthPort      string        `yaml:"health_port"`
+	MetricsPort     string        `yaml:"metrics_port"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// Debug turns on checks that are too costly for production, such as
+	// validating every response against its proto rules.
+	Debug bool `yaml:"debug"`
+}
+
+// TLSConfig enables TLS when CertFile is set. Setting ClientCAFile turns on
+// mutual TLS: client certificates signed by that CA are verified, and their
+// common name is looked up in ClientPrincipals to get the caller's role.
+type TLSConfig struct {
+	CertFile          string            `yaml:"cert_file"`
+	KeyFile           string            `yaml:"key_file"`
+	ClientCAFile      string            `yaml:"client_ca_file"`
+	RequireClientCert bool              `yaml:"require_client_cert"`
+	ClientPrincipals  map[string]string `yaml:"client_principals"`
+	ReloadInterval    time.Duration     `yaml:"reload_interval"`
+}
+
+// RateLimitConfig sets token bucket quotas. A call must get a token from each
+// of three buckets:
+//   - per IP: every call from the client address, signed in or not
+//   - per user: every call by a signed in user, whatever the method
+//   - per method: each caller (user ID when authenticated, otherwise client
+//     IP) and method, using Default unless Methods has a quota for it
+//
+// Methods can be listed by full name (/main.ExecsService/Login) or just the
+// method name. PerIP or PerUser with zero requests turns that bucket off.
+type RateLimitConfig struct {
+	Enabled bool             `yaml:"enabled"`
+	Store   string           `yaml:"store"`
+	PerIP   Quota            `yaml:"per_ip"`
+	PerUser Quota            `yaml:"per_user"`
+	Default Quota            `yaml:"default"`
+	Methods map[string]Quota `yaml:"methods"`
+}
+
+// Quota allows Requests per Per on average, with bursts of up to Burst
+// requests. Burst defaults to Requests.
+type Quota struct {
+	Requests int           `yaml:"requests"`
+	Per      time.Duration `yaml:"per"`
+	Burst    int           `yaml:"burst,omitempty"`
+}
+
+// Rate returns the refill rate in tokens per second.
+func (q Quota) Rate() float64 {
+	return float64(q.Requests) / q.Per.Seconds()
+}
+
+func (q Quota) Capacity() int {
+	if q.Burst > 0 {
+		return q.Burst
+	}
+	return q.Requests
+}
+
+type MongoConfig struct {
+	URI     string `yaml:"uri"`
+	URIFile string `yaml:"uri_file"`
+	// AllowMissingUniqueIndexes lets the server start when a unique index
+	// can't be built because of existing duplicates, so they can be found and
+	// merged through the API. Until then the field isn't unique.
+	AllowMissingUniqueIndexes bool `yaml:"allow_missing_unique_indexes"`
+}
+
+type AuthConfig struct {
+	JWTSecret           string        `yaml:"jwt_secret"`
+	JWTSecretFile       string        `yaml:"jwt_secret_file"`
+	JWTExpiresIn        time.Duration `yaml:"jwt_expires_in"`
+	ResetTokenTTL       time.Duration `yaml:"reset_token_ttl"`
+	ActivationTokenTTL  time.Duration `yaml:"activation_token_ttl"`
+	EmailChangeTokenTTL time.Duration `yaml:"email_change_token_ttl"`
+}
+
+type MailConfig struct {
+	SMTPHost     string `yaml:"smtp_host"`
+	SMTPPort     string `yaml:"smtp_port"`
+	Username     string `yaml:"username"`
+	Password     string `yaml:"password"`
+	PasswordFile string `yaml:"password_file"`
+	From         string `yaml:"from"`
+}
+
+type LogConfig struct {
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+}
+
+type TracingConfig struct {
+	Exporter    string `yaml:"exporter"`
+	ServiceName string `yaml:"service_name"`
+}
+
+type PrivacyConfig struct {
+	// ErasureModes maps a person kind (student, teacher, exec) to delete or
+	// anonymize
+	ErasureModes map[string]string `yaml:"erasure_modes"`
+	LegalHoldIDs []string          `yaml:"legal_hold_ids"`
+	// Retain lists linked data, as collection.field, kept on erasure, e.g.
+	// records the school must hold on to. Retained data is still exported.
+	Retain []string `yaml:"retain"`
+	// ReceiptSecret signs erasure receipts. It must differ from the JWT
+	// secret, so a leaked token key cannot forge receipts.
+	ReceiptSecret     string `yaml:"receipt_secret"`
+	ReceiptSecretFile string `yaml:"receipt_secret_file"`
+}
+
+// StatisticsConfig sets how long dashboard statistics are cached. Zero turns
+// the cache off.
+type StatisticsConfig struct {
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+}
+
+// GradesConfig holds the grading scale used to turn weighted averages into
+// letter grades. Boundaries are listed from the highest minimum percentage
+// down, and the last one must start at 0 so every average gets a letter.
+type GradesConfig struct {
+	Scale []GradeBoundary `yaml:"scale"`
+}
+
+type GradeBoundary struct {
+	Letter string  `yaml:"letter"`
+	Min    float64 `yaml:"min"`
+}
+
+const redacted = "[REDACTED]"
+
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Port:            "50051",
+			HealthPort:      "8081",
+			MetricsPort:     "9090",
+			ShutdownTimeout: 30 * time.Second,
+		},
+		TLS: TLSConfig{
+			ClientPrincipals: map[string]string{},
+			ReloadInterval:   30 * time.Second,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled: true,
+			Store:   "memory",
+			PerIP:   Quota{Requests: 100, Per: time.Second, Burst: 200},
+			PerUser: Quota{Requests: 50, Per: time.Second, Burst: 100},
+			Default: Quota{Requests: 20, Per: time.Second, Burst: 40},
+			Methods: map[string]Quota{
+				"Login":           {Requests: 5, Per: time.Minute},
+				"ForgotPassword":  {Requests: 3, Per: time.Hour},
+				"ResetPassword":   {Requests: 5, Per: time.Hour},
+				"ActivateAccount": {Requests: 5, Per: time.Hour},
+			},
+		},
+		Mongo: MongoConfig{
+			URI: "mongodb://localhost:27017",
+		},
+		Auth: AuthConfig{
+			JWTExpiresIn:        15 * time.Minute,
+			ResetTokenTTL:       10 * time.Minute,
+			ActivationTokenTTL:  3 * 24 * time.Hour,
+			EmailChangeTokenTTL: time.Hour,
+		},
+		Mail: MailConfig{
+			SMTPHost: "localhost",
+			SMTPPort: "1025",
+			From:     "schooladmin@school.com",
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		Tracing: TracingConfig{
+			Exporter:    "otlp",
+			ServiceName: "school-mgmt-grpc",
+		},
+		Privacy: PrivacyConfig{
+			ErasureModes: map[string]string{
+				"student": "anonymize",
+				"teacher": "anonymize",
+				"exec":    "anonymize",
+			},
+			Retain: []string{
+				"audit_log.actor_id",
+				"audit_log.target_ids",
+				"attendance.recorded_by",
+				"scores.recorded_by",
+				"assessments.created_by",
+			},
+		},
+		Statistics: StatisticsConfig{
+			CacheTTL: time.Minute,
+		},
+		Grades: GradesConfig{
+			Scale: []GradeBoundary{
+				{Letter: "A", Min: 90},
+				{Letter: "B", Min: 80},
+				{Letter: "C", Min: 70},
+				{Letter: "D", Min: 60},
+				{Letter: "F", Min: 0},
+			},
+		},
+	}
+}
+
+// Load registers the configuration flags on fs, parses args and builds the
+// layered configuration. The YAML file comes from -config or CONFIG_FILE and is
+// optional. Secrets given as *_file paths are read from disk, which is how
+// Docker and Kubernetes secrets are usually mounted.
+func Load(fs *flag.FlagSet, args []string) (*Config, error) {
+	cfg := Default()
+	settings := cfg.settings()
+
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML config file")
+	flagValues := map[string]string{}
+	for _, s := range settings {
+		if s.flag == "" {
+			continue
+		}
+		name := s.flag
+		fs.Func(name, s.usage, func(raw string) error {
+			flagValues[name] = raw
+			return nil
+		})
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *configFile != "" {
+		if err := cfg.loadFile(*configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range settings {
+		raw, ok := os.LookupEnv(s.env)
+		if !ok || s.env == "" {
+			continue
+		}
+		if err := s.set(raw); err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", s.env, err)
+		}
+	}
+
+	for _, s := range settings {
+		raw, ok := flagValues[s.flag]
+		if !ok {
+			continue
+		}
+		if err := s.set(raw); err != nil {
+			return nil, fmt.Errorf("flag -%s: %w", s.flag, err)
+		}
+	}
+
+	if err := cfg.resolveSecrets(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func (c *Config) loadFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// resolveSecrets replaces secrets that were given as file references with the
+// file contents.
+func (c *Config) resolveSecrets() error {
+	secrets := []struct {
+		value *string
+		file  string
+		name  string
+	}{
+		{&c.Mongo.URI, c.Mongo.URIFile, "mongo.uri_file"},
+		{&c.Auth.JWTSecret, c.Auth.JWTSecretFile, "auth.jwt_secret_file"},
+		{&c.Mail.Password, c.Mail.PasswordFile, "mail.password_file"},
+		{&c.Privacy.ReceiptSecret, c.Privacy.ReceiptSecretFile, "privacy.receipt_secret_file"},
+	}
+	for _, secret := range secrets {
+		if secret.file == "" {
+			continue
+		}
+		content, err := os.ReadFile(secret.file)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", secret.name, err)
+		}
+		*secret.value = strings.TrimSpace(string(content))
+	}
+	return nil
+}
+
+// Validate reports every invalid setting at once.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	for name, port := range map[string]string{
+		"server.port":         c.Server.Port,
+		"server.health_port":  c.Server.HealthPort,
+		"server.metrics_port": c.Server.MetricsPort,
+		"mail.smtp_port":      c.Mail.SMTPPort,
+	} {
+		n, err := strconv.Atoi(port)
+		check(err == nil && n > 0 && n < 65536, "%s must be a port number, got %q", name, port)
+	}
+	check(c.Server.ShutdownTimeout > 0, "server.shutdown_timeout must be positive")
+
+	check((c.TLS.CertFile == "") == (c.TLS.KeyFile == ""), "tls.cert_file and tls.key_file must be set together")
+	check(c.TLS.ClientCAFile == "" || c.TLS.CertFile != "", "tls.client_ca_file requires tls.cert_file")
+	check(!c.TLS.RequireClientCert || c.TLS.ClientCAFile != "", "tls.require_client_cert requires tls.client_ca_file")
+	check(c.TLS.ReloadInterval > 0, "tls.reload_interval must be positive")
+	for name, role := range c.TLS.ClientPrincipals {
+		check(oneOf(role, "admin", "manager", "exec"), "tls.client_principals.%s must be admin, manager or exec, got %q", name, role)
+	}
+
+	check(oneOf(c.RateLimit.Store, "memory", "mongo"), "rate_limit.store must be memory or mongo, got %q", c.RateLimit.Store)
+	check(c.RateLimit.PerIP.Requests == 0 || c.RateLimit.PerIP.Requests > 0 && c.RateLimit.PerIP.Per > 0, "rate_limit.per_ip needs positive requests and per, or zero requests to turn it off")
+	check(c.RateLimit.PerUser.Requests == 0 || c.RateLimit.PerUser.Requests > 0 && c.RateLimit.PerUser.Per > 0, "rate_limit.per_user needs positive requests and per, or zero requests to turn it off")
+	check(c.RateLimit.Default.Requests > 0 && c.RateLimit.Default.Per > 0, "rate_limit.default needs positive requests and per")
+	for method, quota := range c.RateLimit.Methods {
+		check(quota.Requests > 0 && quota.Per > 0, "rate_limit.methods.%s needs positive requests and per", method)
+	}
+
+	check(strings.HasPrefix(c.Mongo.URI, "mongodb://") || strings.HasPrefix(c.Mongo.URI, "mongodb+srv://"),
+		"mongo.uri must be a mongodb:// or mongodb+srv:// URI")
+
+	check(c.Auth.JWTSecret != "", "auth.jwt_secret is required")
+	check(c.Auth.JWTExpiresIn > 0, "auth.jwt_expires_in must be positive")
+	check(c.Auth.ResetTokenTTL > 0, "auth.reset_token_ttl must be positive")
+	check(c.Auth.ActivationTokenTTL > 0, "auth.activation_token_ttl must be positive")
+	check(c.Auth.EmailChangeTokenTTL > 0, "auth.email_change_token_ttl must be positive")
+
+	check(c.Mail.SMTPHost != "", "mail.smtp_host is required")
+	check(c.Mail.From != "", "mail.from is required")
+
+	check(oneOf(c.Log.Level, "debug", "info", "warn", "error"), "log.level must be debug, info, warn or error, got %q", c.Log.Level)
+	check(oneOf(c.Log.Format, "json", "text"), "log.format must be json or text, got %q", c.Log.Format)
+
+	check(oneOf(c.Tracing.Exporter, "otlp", "stdout", "none"), "tracing.exporter must be otlp, stdout or none, got %q", c.Tracing.Exporter)
+
+	for kind, mode := range c.Privacy.ErasureModes {
+		check(oneOf(kind, "student", "teacher", "exec"), "privacy.erasure_modes: unknown person kind %q", kind)
+		check(oneOf(mode, "delete", "anonymize"), "privacy.erasure_modes.%s must be delete or anonymize, got %q", kind, mode)
+	}
+	for _, name := range c.Privacy.Retain {
+		collection, field, ok := strings.Cut(name, ".")
+		check(ok && collection != "" && field != "", "privacy.retain entries must be collection.field, got %q", name)
+	}
+	check(c.Privacy.ReceiptSecret != "", "privacy.receipt_secret is required")
+	check(c.Privacy.ReceiptSecret == "" || c.Privacy.ReceiptSecret != c.Auth.JWTSecret, "privacy.receipt_secret must differ from auth.jwt_secret")
+
+	check(c.Statistics.CacheTTL >= 0, "statistics.cache_ttl must not be negative")
+
+	check(len(c.Grades.Scale) > 0, "grades.scale needs at least one boundary")
+	for i, boundary := range c.Grades.Scale {
+		check(boundary.Letter != "", "grades.scale[%d] needs a letter", i)
+		check(boundary.Min >= 0 && boundary.Min <= 100, "grades.scale[%d].min must be between 0 and 100, got %v", i, boundary.Min)
+		check(i == 0 || boundary.Min < c.Grades.Scale[i-1].Min, "grades.scale must be ordered from the highest min down")
+	}
+	if n := len(c.Grades.Scale); n > 0 {
+		check(c.Grades.Scale[n-1].Min == 0, "the last grades.scale boundary must have min 0")
+	}
+
+	return errors.Join(errs...)
+}
+
+// Redacted returns a copy of the configuration that is safe to log.
+func (c Config) Redacted() Config {
+	hide := func(s string) string {
+		if s == "" {
+			return ""
+		}
+		return redacted
+	}
+
+	c.Auth.JWTSecret = hide(c.Auth.JWTSecret)
+	c.Mail.Password = hide(c.Mail.Password)
+	c.Privacy.ReceiptSecret = hide(c.Privacy.ReceiptSecret)
+	if u, err := url.Parse(c.Mongo.URI); err == nil && u.User != nil {
+		if _, hasPassword := u.User.Password(); hasPassword {
+			u.User = url.UserPassword(u.User.Username(), "REDACTED")
+			c.Mongo.URI = u.String()
+		}
+	} else if err != nil {
+		c.Mongo.URI = hide(c.Mongo.URI)
+	}
+	return c
+}
+
+// Dump renders the redacted configuration as YAML.
+func (c Config) Dump() string {
+	out, err := yaml.Marshal(c.Redacted())
+	if err != nil {
+		return err.Error()
+	}
+	return string(out)
+}
+
+func oneOf(value string, allowed ...string) bool {
+	for _, a := range allowed {
+		if value == a {
+			return true
+		}
+	}
+	return false
+}
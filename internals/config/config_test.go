@@ -0,0 +1,163 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateGradingScale(t *testing.T) {
+	tests := []struct {
+		name    string
+		scale   []GradeBoundary
+		wantErr string
+	}{
+		{
+			name:  "default scale",
+			scale: Default().Grades.Scale,
+		},
+		{
+			name:  "single boundary",
+			scale: []GradeBoundary{{Letter: "P", Min: 0}},
+		},
+		{
+			name:    "empty",
+			scale:   nil,
+			wantErr: "grades.scale needs at least one boundary",
+		},
+		{
+			name:    "missing letter",
+			scale:   []GradeBoundary{{Letter: "A", Min: 50}, {Min: 0}},
+			wantErr: "grades.scale[1] needs a letter",
+		},
+		{
+			name:    "min above 100",
+			scale:   []GradeBoundary{{Letter: "A", Min: 101}, {Letter: "F", Min: 0}},
+			wantErr: "grades.scale[0].min must be between 0 and 100",
+		},
+		{
+			name:    "negative min",
+			scale:   []GradeBoundary{{Letter: "A", Min: 50}, {Letter: "F", Min: -1}},
+			wantErr: "grades.scale[1].min must be between 0 and 100",
+		},
+		{
+			name:    "ascending",
+			scale:   []GradeBoundary{{Letter: "F", Min: 0}, {Letter: "A", Min: 90}},
+			wantErr: "grades.scale must be ordered from the highest min down",
+		},
+		{
+			name:    "repeated min",
+			scale:   []GradeBoundary{{Letter: "A", Min: 90}, {Letter: "B", Min: 90}, {Letter: "F", Min: 0}},
+			wantErr: "grades.scale must be ordered from the highest min down",
+		},
+		{
+			name:    "last boundary above 0",
+			scale:   []GradeBoundary{{Letter: "A", Min: 90}, {Letter: "B", Min: 80}},
+			wantErr: "the last grades.scale boundary must have min 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default()
+			c.Auth.JWTSecret = "secret"
+			c.Privacy.ReceiptSecret = "receipt-secret"
+			c.Grades.Scale = tt.scale
+
+			err := c.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() = %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateReceiptSecret(t *testing.T) {
+	tests := []struct {
+		name          string
+		receiptSecret string
+		wantErr       string
+	}{
+		{name: "separate secret", receiptSecret: "receipt-secret"},
+		{name: "missing", receiptSecret: "", wantErr: "privacy.receipt_secret is required"},
+		{name: "same as the JWT secret", receiptSecret: "secret", wantErr: "privacy.receipt_secret must differ from auth.jwt_secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default()
+			c.Auth.JWTSecret = "secret"
+			c.Privacy.ReceiptSecret = tt.receiptSecret
+			if err := c.resolveSecrets(); err != nil {
+				t.Fatalf("resolveSecrets() = %v", err)
+			}
+
+			err := c.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() = %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetGradingScale(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    []GradeBoundary
+		wantErr bool
+	}{
+		{
+			name: "pairs",
+			raw:  "A=90, B=75.5 ,F=0",
+			want: []GradeBoundary{{Letter: "A", Min: 90}, {Letter: "B", Min: 75.5}, {Letter: "F", Min: 0}},
+		},
+		{
+			name: "trailing comma",
+			raw:  "P=0,",
+			want: []GradeBoundary{{Letter: "P", Min: 0}},
+		},
+		{
+			name:    "missing equals",
+			raw:     "A90,F=0",
+			wantErr: true,
+		},
+		{
+			name:    "not a number",
+			raw:     "A=ninety",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var scale []GradeBoundary
+			err := setGradingScale(&scale)(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("setGradingScale(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(scale) != len(tt.want) {
+				t.Fatalf("setGradingScale(%q) = %v, want %v", tt.raw, scale, tt.want)
+			}
+			for i := range scale {
+				if scale[i] != tt.want[i] {
+					t.Errorf("setGradingScale(%q)[%d] = %v, want %v", tt.raw, i, scale[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
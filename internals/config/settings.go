@@ -0,0 +1,218 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// setting ties a config field to its environment variable and flag.
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	set   func(raw string) error
+}
+
+func (c *Config) settings() []setting {
+	return []setting{
+		{"SERVER_PORT", "port", "gRPC listen port", setString(&c.Server.Port)},
+		{"HEALTH_PORT", "health-port", "HTTP health probe port", setString(&c.Server.HealthPort)},
+		{"METRICS_PORT", "metrics-port", "Prometheus metrics port", setString(&c.Server.MetricsPort)},
+		{"SHUTDOWN_TIMEOUT", "shutdown-timeout", "how long to wait for in-flight RPCs on shutdown", setDuration(&c.Server.ShutdownTimeout)},
+		{"DEBUG", "debug", "enable development checks such as response validation", setBool(&c.Server.Debug)},
+
+		{"CERT_FILE", "tls-cert", "TLS certificate file; enables TLS", setString(&c.TLS.CertFile)},
+		{"KEY_FILE", "tls-key", "TLS private key file", setString(&c.TLS.KeyFile)},
+		{"TLS_CLIENT_CA_FILE", "tls-client-ca", "CA bundle for verifying client certificates; enables mTLS", setString(&c.TLS.ClientCAFile)},
+		{"TLS_REQUIRE_CLIENT_CERT", "tls-require-client-cert", "reject clients without a valid certificate", setBool(&c.TLS.RequireClientCert)},
+		{"TLS_CLIENT_PRINCIPALS", "", "", setMap(c.TLS.ClientPrincipals)},
+		{"TLS_RELOAD_INTERVAL", "", "", setDuration(&c.TLS.ReloadInterval)},
+
+		{"RATE_LIMIT_ENABLED", "rate-limit", "enable rate limiting", setBool(&c.RateLimit.Enabled)},
+		{"RATE_LIMIT_STORE", "rate-limit-store", "memory, or mongo to share budgets between replicas", setString(&c.RateLimit.Store)},
+		{"RATE_LIMIT_PER_IP", "", "", setQuota(&c.RateLimit.PerIP)},
+		{"RATE_LIMIT_PER_USER", "", "", setQuota(&c.RateLimit.PerUser)},
+		{"RATE_LIMIT_DEFAULT", "", "", setQuota(&c.RateLimit.Default)},
+		{"RATE_LIMIT_METHODS", "", "", setQuotas(c.RateLimit.Methods)},
+
+		{"MONGODB_URI", "mongo-uri", "MongoDB connection URI", setString(&c.Mongo.URI)},
+		{"MONGODB_URI_FILE", "", "", setString(&c.Mongo.URIFile)},
+		{"MONGODB_ALLOW_MISSING_UNIQUE_INDEXES", "allow-missing-unique-indexes", "start even if duplicates block a unique index", setBool(&c.Mongo.AllowMissingUniqueIndexes)},
+
+		{"JWT_SECRET", "", "", setString(&c.Auth.JWTSecret)},
+		{"JWT_SECRET_FILE", "jwt-secret-file", "file holding the JWT signing secret", setString(&c.Auth.JWTSecretFile)},
+		{"JWT_EXPIRES_IN", "jwt-expires-in", "JWT lifetime", setDuration(&c.Auth.JWTExpiresIn)},
+		{"RESET_TOKEN_EXP_DURATION", "", "", setMinutes(&c.Auth.ResetTokenTTL)},
+		{"ACTIVATION_TOKEN_EXP_DURATION", "", "", setMinutes(&c.Auth.ActivationTokenTTL)},
+		{"EMAIL_CHANGE_TOKEN_EXP_DURATION", "", "", setMinutes(&c.Auth.EmailChangeTokenTTL)},
+
+		{"SMTP_HOST", "smtp-host", "SMTP server host", setString(&c.Mail.SMTPHost)},
+		{"SMTP_PORT", "smtp-port", "SMTP server port", setString(&c.Mail.SMTPPort)},
+		{"SMTP_USERNAME", "", "", setString(&c.Mail.Username)},
+		{"SMTP_PASSWORD", "", "", setString(&c.Mail.Password)},
+		{"SMTP_PASSWORD_FILE", "", "", setString(&c.Mail.PasswordFile)},
+		{"MAIL_FROM", "", "", setString(&c.Mail.From)},
+
+		{"LOG_LEVEL", "log-level", "debug, info, warn or error", setString(&c.Log.Level)},
+		{"LOG_FORMAT", "log-format", "json or text", setString(&c.Log.Format)},
+
+		{"OTEL_TRACES_EXPORTER", "trace-exporter", "otlp, stdout or none", setString(&c.Tracing.Exporter)},
+		{"OTEL_SERVICE_NAME", "", "", setString(&c.Tracing.ServiceName)},
+
+		{"ERASURE_MODE_STUDENT", "", "", setMapEntry(c.Privacy.ErasureModes, "student")},
+		{"ERASURE_MODE_TEACHER", "", "", setMapEntry(c.Privacy.ErasureModes, "teacher")},
+		{"ERASURE_MODE_EXEC", "", "", setMapEntry(c.Privacy.ErasureModes, "exec")},
+		{"LEGAL_HOLD_IDS", "", "", setList(&c.Privacy.LegalHoldIDs)},
+		{"ERASURE_RETAIN", "", "", setList(&c.Privacy.Retain)},
+		{"ERASURE_RECEIPT_SECRET", "", "", setString(&c.Privacy.ReceiptSecret)},
+		{"ERASURE_RECEIPT_SECRET_FILE", "", "", setString(&c.Privacy.ReceiptSecretFile)},
+
+		{"STATISTICS_CACHE_TTL", "", "", setDuration(&c.Statistics.CacheTTL)},
+
+		{"GRADING_SCALE", "", "", setGradingScale(&c.Grades.Scale)},
+	}
+}
+
+func setString(p *string) func(string) error {
+	return func(raw string) error {
+		*p = raw
+		return nil
+	}
+}
+
+func setBool(p *bool) func(string) error {
+	return func(raw string) error {
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		*p = b
+		return nil
+	}
+}
+
+func setDuration(p *time.Duration) func(string) error {
+	return func(raw string) error {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		*p = d
+		return nil
+	}
+}
+
+// setMinutes also accepts a bare number of minutes, which is what the token
+// expiry variables held before they became durations.
+func setMinutes(p *time.Duration) func(string) error {
+	return func(raw string) error {
+		if mins, err := strconv.Atoi(raw); err == nil {
+			*p = time.Duration(mins) * time.Minute
+			return nil
+		}
+		return setDuration(p)(raw)
+	}
+}
+
+func setList(p *[]string) func(string) error {
+	return func(raw string) error {
+		var items []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		*p = items
+		return nil
+	}
+}
+
+// setMap reads comma separated key=value pairs.
+func setMap(m map[string]string) func(string) error {
+	return func(raw string) error {
+		for _, pair := range strings.Split(raw, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			key, value, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("expected key=value, got %q", pair)
+			}
+			m[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+		return nil
+	}
+}
+
+// setQuota reads a quota written as requests/period, e.g. 5/1m.
+func setQuota(q *Quota) func(string) error {
+	return func(raw string) error {
+		requests, per, ok := strings.Cut(strings.TrimSpace(raw), "/")
+		if !ok {
+			return fmt.Errorf("expected requests/period, got %q", raw)
+		}
+		n, err := strconv.Atoi(requests)
+		if err != nil {
+			return err
+		}
+		d, err := time.ParseDuration(per)
+		if err != nil {
+			return err
+		}
+		*q = Quota{Requests: n, Per: d}
+		return nil
+	}
+}
+
+// setQuotas reads comma separated method=requests/period pairs.
+func setQuotas(m map[string]Quota) func(string) error {
+	return func(raw string) error {
+		for _, pair := range strings.Split(raw, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			method, value, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("expected method=requests/period, got %q", pair)
+			}
+			var q Quota
+			if err := setQuota(&q)(value); err != nil {
+				return err
+			}
+			m[strings.TrimSpace(method)] = q
+		}
+		return nil
+	}
+}
+
+// setGradingScale reads comma separated letter=min pairs, e.g. A=90,B=80,F=0.
+func setGradingScale(p *[]GradeBoundary) func(string) error {
+	return func(raw string) error {
+		var scale []GradeBoundary
+		for _, pair := range strings.Split(raw, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			letter, value, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("expected letter=min, got %q", pair)
+			}
+			min, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return err
+			}
+			scale = append(scale, GradeBoundary{Letter: strings.TrimSpace(letter), Min: min})
+		}
+		*p = scale
+		return nil
+	}
+}
+
+func setMapEntry(m map[string]string, key string) func(string) error {
+	return func(raw string) error {
+		m[key] = raw
+		return nil
+	}
+}
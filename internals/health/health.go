@@ -0,0 +1,133 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Checker backs both the grpc.health.v1 service and the HTTP probes. The
+// server is ready while MongoDB answers pings and it is not draining.
+type Checker struct {
+	grpcServer *health.Server
+	services   []string
+	ping       func(ctx context.Context) error
+
+	mu       sync.RWMutex
+	dbUp     bool
+	draining bool
+}
+
+// NewChecker returns a Checker reporting on services, plus the overall ""
+// service. ping is used to probe MongoDB. Everything starts NOT_SERVING until
+// the first successful ping.
+func NewChecker(ping func(ctx context.Context) error, services ...string) *Checker {
+	c := &Checker{
+		grpcServer: health.NewServer(),
+		services:   services,
+		ping:       ping,
+	}
+	c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// GRPCServer returns the grpc.health.v1 implementation to register.
+func (c *Checker) GRPCServer() *health.Server {
+	return c.grpcServer
+}
+
+// Run pings MongoDB every interval until ctx is cancelled.
+func (c *Checker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.check(ctx, interval)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Checker) check(ctx context.Context, timeout time.Duration) {
+	pingCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := c.ping(pingCtx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.draining {
+		return
+	}
+
+	up := err == nil
+	if up != c.dbUp {
+		if up {
+			utils.Logger.Info("health: MongoDB reachable, serving")
+		} else {
+			utils.Logger.Warn("health: MongoDB unreachable, not serving", "error", err)
+		}
+	}
+	c.dbUp = up
+
+	if up {
+		c.setStatus(healthpb.HealthCheckResponse_SERVING)
+	} else {
+		c.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+}
+
+// Drain marks every service NOT_SERVING for good, so load balancers stop
+// sending traffic before the server shuts down.
+func (c *Checker) Drain() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.draining = true
+	c.grpcServer.Shutdown()
+}
+
+// Ready reports whether the server should receive traffic.
+func (c *Checker) Ready() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.dbUp && !c.draining
+}
+
+func (c *Checker) setStatus(servingStatus healthpb.HealthCheckResponse_ServingStatus) {
+	c.grpcServer.SetServingStatus("", servingStatus)
+	for _, service := range c.services {
+		c.grpcServer.SetServingStatus(service, servingStatus)
+	}
+}
+
+// NewHTTPServer serves /healthz (the process is up) and /readyz (the server
+// is ready for traffic) on addr, for load balancers that can't speak gRPC.
+func NewHTTPServer(addr string, c *Checker) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ok\n"))
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if !c.Ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("not ready\n"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ready\n"))
+	})
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
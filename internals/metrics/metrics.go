@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// Registry holds every collector exposed on /metrics. A dedicated registry
+// keeps library defaults from leaking in unnoticed.
+var Registry = prometheus.NewRegistry()
+
+var (
+	RPCRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Total number of RPCs completed, by method and status code.",
+	}, []string{"method", "code"})
+
+	RPCLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Time taken to handle an RPC, by method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	RPCInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grpc_server_in_flight_requests",
+		Help: "Number of RPCs currently being handled, by method.",
+	}, []string{"method"})
+
+	MongoLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "mongodb_command_duration_seconds",
+		Help:    "Time taken by MongoDB commands, by command and outcome.",
+		Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"command", "outcome"})
+
+	RateLimitRejections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "rate_limit_rejections_total",
+		Help: "Requests rejected by the rate limiter, by method.",
+	}, []string{"method"})
+
+	LoginAttempts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "login_attempts_total",
+		Help: "Login attempts, by result (success or failure).",
+	}, []string{"result"})
+
+	PanicsRecovered = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_panics_recovered_total",
+		Help: "Handler panics turned into Internal errors, by method.",
+	}, []string{"method"})
+)
+
+var registerOnce sync.Once
+
+// Register adds all collectors to Registry. revokedTokens reports the current
+// size of the JWT revocation store.
+func Register(revokedTokens func() int) {
+	registerOnce.Do(func() {
+		Registry.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+			RPCRequests,
+			RPCLatency,
+			RPCInFlight,
+			MongoLatency,
+			RateLimitRejections,
+			LoginAttempts,
+			PanicsRecovered,
+			prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+				Name: "jwt_revocation_store_size",
+				Help: "Number of logged out tokens held until they expire.",
+			}, func() float64 { return float64(revokedTokens()) }),
+		)
+	})
+}
+
+// NewServer returns an HTTP server exposing /metrics on addr.
+func NewServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
+
+// NewMongoMonitor times every MongoDB command sent by a client it is attached to.
+func NewMongoMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			MongoLatency.WithLabelValues(e.CommandName, "success").Observe(e.Duration.Seconds())
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			MongoLatency.WithLabelValues(e.CommandName, "failure").Observe(e.Duration.Seconds())
+		},
+	}
+}
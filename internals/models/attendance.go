@@ -0,0 +1,22 @@
+package models
+
+type AttendanceRecord struct {
+	Id         string             `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	StudentId  string             `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	ClassId    string             `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	Date       string             `protobuf:"date,omitempty" bson:"date,omitempty"`
+	Period     int32              `protobuf:"period,omitempty" bson:"period"`
+	Status     string             `protobuf:"status,omitempty" bson:"status,omitempty"`
+	Note       string             `protobuf:"note,omitempty" bson:"note,omitempty"`
+	RecordedBy string             `protobuf:"recorded_by,omitempty" bson:"recorded_by,omitempty"`
+	RecordedAt string             `protobuf:"recorded_at,omitempty" bson:"recorded_at,omitempty"`
+	History    []AttendanceChange `protobuf:"history,omitempty" bson:"history,omitempty"`
+}
+
+type AttendanceChange struct {
+	Status     string `protobuf:"status,omitempty" bson:"status,omitempty"`
+	Note       string `protobuf:"note,omitempty" bson:"note,omitempty"`
+	RecordedBy string `protobuf:"recorded_by,omitempty" bson:"recorded_by,omitempty"`
+	RecordedAt string `protobuf:"recorded_at,omitempty" bson:"recorded_at,omitempty"`
+	Reason     string `protobuf:"reason,omitempty" bson:"reason,omitempty"`
+}
@@ -0,0 +1,24 @@
+package models
+
+type AuditEntry struct {
+	Id            string        `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Timestamp     string        `protobuf:"timestamp,omitempty" bson:"timestamp,omitempty"`
+	ActorId       string        `protobuf:"actor_id,omitempty" bson:"actor_id,omitempty"`
+	ActorUsername string        `protobuf:"actor_username,omitempty" bson:"actor_username,omitempty"`
+	Role          string        `protobuf:"role,omitempty" bson:"role,omitempty"`
+	Method        string        `protobuf:"method,omitempty" bson:"method,omitempty"`
+	Action        string        `protobuf:"action,omitempty" bson:"action,omitempty"`
+	Entity        string        `protobuf:"entity,omitempty" bson:"entity,omitempty"`
+	TargetIds     []string      `protobuf:"target_ids,omitempty" bson:"target_ids,omitempty"`
+	Changes       []AuditChange `protobuf:"changes,omitempty" bson:"changes,omitempty"`
+	ClientIp      string        `protobuf:"client_ip,omitempty" bson:"client_ip,omitempty"`
+	RequestId     string        `protobuf:"request_id,omitempty" bson:"request_id,omitempty"`
+	StatusCode    string        `protobuf:"status_code,omitempty" bson:"status_code,omitempty"`
+}
+
+type AuditChange struct {
+	TargetId string `protobuf:"target_id,omitempty" bson:"target_id,omitempty"`
+	Field    string `protobuf:"field,omitempty" bson:"field,omitempty"`
+	Before   string `protobuf:"before,omitempty" bson:"before,omitempty"`
+	After    string `protobuf:"after,omitempty" bson:"after,omitempty"`
+}
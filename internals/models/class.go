@@ -0,0 +1,11 @@
+package models
+
+type Class struct {
+	Id                string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	GradeLevel        int32  `protobuf:"grade_level,omitempty" bson:"grade_level,omitempty"`
+	Section           string `protobuf:"section,omitempty" bson:"section,omitempty"`
+	AcademicYear      string `protobuf:"academic_year,omitempty" bson:"academic_year,omitempty"`
+	Room              string `protobuf:"room,omitempty" bson:"room,omitempty"`
+	Capacity          int32  `protobuf:"capacity,omitempty" bson:"capacity,omitempty"`
+	HomeroomTeacherId string `protobuf:"homeroom_teacher_id,omitempty" bson:"homeroom_teacher_id,omitempty"`
+}
@@ -0,0 +1,14 @@
+package models
+
+type ErasureReceipt struct {
+	Id          string   `json:"receipt_id" bson:"_id,omitempty"`
+	PersonKind  string   `json:"person_kind" bson:"person_kind,omitempty"`
+	PersonId    string   `json:"person_id" bson:"person_id,omitempty"`
+	Mode        string   `json:"mode" bson:"mode,omitempty"`
+	RequestedBy string   `json:"requested_by" bson:"requested_by,omitempty"`
+	Reason      string   `json:"reason,omitempty" bson:"reason,omitempty"`
+	ErasedAt    string   `json:"erased_at" bson:"erased_at,omitempty"`
+	Affected    []string `json:"affected" bson:"affected,omitempty"`
+	Retained    []string `json:"retained,omitempty" bson:"retained,omitempty"`
+	Signature   string   `json:"-" bson:"signature,omitempty"`
+}
@@ -0,0 +1,24 @@
+package models
+
+type Assessment struct {
+	Id        string  `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	ClassId   string  `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	SubjectId string  `protobuf:"subject_id,omitempty" bson:"subject_id,omitempty"`
+	Term      string  `protobuf:"term,omitempty" bson:"term,omitempty"`
+	Title     string  `protobuf:"title,omitempty" bson:"title,omitempty"`
+	Type      string  `protobuf:"type,omitempty" bson:"type,omitempty"`
+	MaxScore  float32 `protobuf:"max_score,omitempty" bson:"max_score,omitempty"`
+	Weight    float32 `protobuf:"weight,omitempty" bson:"weight,omitempty"`
+	Date      string  `protobuf:"date,omitempty" bson:"date,omitempty"`
+	CreatedBy string  `protobuf:"created_by,omitempty" bson:"created_by,omitempty"`
+}
+
+type Score struct {
+	Id           string  `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	AssessmentId string  `protobuf:"assessment_id,omitempty" bson:"assessment_id,omitempty"`
+	StudentId    string  `protobuf:"student_id,omitempty" bson:"student_id,omitempty"`
+	Score        float32 `protobuf:"score,omitempty" bson:"score"`
+	Excused      bool    `protobuf:"excused,omitempty" bson:"excused"`
+	RecordedBy   string  `protobuf:"recorded_by,omitempty" bson:"recorded_by,omitempty"`
+	RecordedAt   string  `protobuf:"recorded_at,omitempty" bson:"recorded_at,omitempty"`
+}
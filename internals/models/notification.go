@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+type Notification struct {
+	Id             string    `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	IdempotencyKey string    `protobuf:"idempotency_key,omitempty" bson:"idempotency_key,omitempty"`
+	Recipient      string    `protobuf:"recipient,omitempty" bson:"recipient,omitempty"`
+	Subject        string    `protobuf:"subject,omitempty" bson:"subject,omitempty"`
+	Body           string    `protobuf:"body,omitempty" bson:"body,omitempty"`
+	Status         string    `protobuf:"status,omitempty" bson:"status,omitempty"`
+	Attempts       int32     `protobuf:"attempts,omitempty" bson:"attempts"`
+	LastError      string    `protobuf:"last_error,omitempty" bson:"last_error,omitempty"`
+	NextAttemptAt  time.Time `protobuf:"next_attempt_at,omitempty" bson:"next_attempt_at,omitempty"`
+	LockedUntil    time.Time `protobuf:"locked_until,omitempty" bson:"locked_until,omitempty"`
+	CreatedAt      string    `protobuf:"created_at,omitempty" bson:"created_at,omitempty"`
+	SentAt         string    `protobuf:"sent_at,omitempty" bson:"sent_at,omitempty"`
+}
@@ -1,9 +1,11 @@
 package models
 
 type Student struct {
-	Id        string `protobuf:"id,omitempty" bson:"_id,omitempty"`
-	FirstName string `protobuf:"first_name,omitempty" bson:"first_name,omitempty"`
-	LastName  string `protobuf:"last_name,omitempty" bson:"last_name,omitempty"`
-	Email     string `protobuf:"email,omitempty" bson:"email,omitempty"`
-	Class     string `protobuf:"class,omitempty" bson:"class,omitempty"`
-}
\ No newline at end of file
+	Id          string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	FirstName   string `protobuf:"first_name,omitempty" bson:"first_name,omitempty"`
+	LastName    string `protobuf:"last_name,omitempty" bson:"last_name,omitempty"`
+	Email       string `protobuf:"email,omitempty" bson:"email,omitempty"`
+	Class       string `protobuf:"class,omitempty" bson:"class,omitempty"`
+	DateOfBirth string `protobuf:"date_of_birth,omitempty" bson:"date_of_birth,omitempty"`
+	ClassId     string `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+}
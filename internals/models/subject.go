@@ -0,0 +1,17 @@
+package models
+
+type Subject struct {
+	Id          string  `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	Code        string  `protobuf:"code,omitempty" bson:"code,omitempty"`
+	Name        string  `protobuf:"name,omitempty" bson:"name,omitempty"`
+	Department  string  `protobuf:"department,omitempty" bson:"department,omitempty"`
+	Credits     float32 `protobuf:"credits,omitempty" bson:"credits,omitempty"`
+	GradeLevels []int32 `protobuf:"grade_levels,omitempty" bson:"grade_levels,omitempty"`
+}
+
+// Curriculum lists the subjects taught in a grade level. There is one per
+// grade level.
+type Curriculum struct {
+	GradeLevel int32    `bson:"grade_level"`
+	SubjectIds []string `bson:"subject_ids"`
+}
@@ -7,4 +7,5 @@ type Teacher struct {
 	Email     string `protobuf:"email,omitempty" bson:"email,omitempty"`
 	Class     string `protobuf:"class,omitempty" bson:"class,omitempty"`
 	Subject   string `protobuf:"subject,omitempty" bson:"subject,omitempty"`
+	ClassId   string `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
 }
\ No newline at end of file
@@ -0,0 +1,9 @@
+package models
+
+type TeachingAssignment struct {
+	Id        string `protobuf:"id,omitempty" bson:"_id,omitempty"`
+	TeacherId string `protobuf:"teacher_id,omitempty" bson:"teacher_id,omitempty"`
+	ClassId   string `protobuf:"class_id,omitempty" bson:"class_id,omitempty"`
+	SubjectId string `protobuf:"subject_id,omitempty" bson:"subject_id,omitempty"`
+	Term      string `protobuf:"term,omitempty" bson:"term,omitempty"`
+}
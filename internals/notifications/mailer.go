@@ -0,0 +1,60 @@
+package notifications
+
+import (
+	"fmt"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+)
+
+type Message struct {
+	IdempotencyKey string
+	To             string
+	Subject        string
+	Body           string
+}
+
+type Mailer interface {
+	Send(msg Message) error
+}
+
+type SMTPMailer struct {
+	Addr string
+	From string
+	Auth smtp.Auth
+}
+
+// NewSMTPMailer builds a mailer from the mail settings. Authentication is
+// skipped when no username is set, which is what local catchers like MailHog
+// expect.
+func NewSMTPMailer(cfg config.MailConfig) *SMTPMailer {
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.SMTPHost)
+	}
+
+	return &SMTPMailer{
+		Addr: cfg.SMTPHost + ":" + cfg.SMTPPort,
+		From: cfg.From,
+		Auth: auth,
+	}
+}
+
+func (m *SMTPMailer) Send(msg Message) error {
+	// The idempotency key doubles as the Message-ID so that a message
+	// redelivered after a crash can be recognised as a duplicate downstream.
+	headers := []string{
+		"From: " + m.From,
+		"To: " + msg.To,
+		"Subject: " + msg.Subject,
+		fmt.Sprintf("Message-ID: <%s@school>", strings.ReplaceAll(msg.IdempotencyKey, ":", ".")),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}
+	body := strings.Join(headers, "\r\n") + "\r\n\r\n" + msg.Body
+
+	return smtp.SendMail(m.Addr, m.Auth, m.From, []string{msg.To}, []byte(body))
+}
@@ -0,0 +1,109 @@
+package notifications
+
+import (
+	"context"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/repositories/mongodb"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+)
+
+const (
+	defaultBatchSize   = 20
+	defaultMaxAttempts = 5
+	defaultBaseBackoff = 30 * time.Second
+	defaultMaxBackoff  = time.Hour
+)
+
+// OutboxWorker delivers messages queued in the notifications collection.
+// Failed deliveries are retried with exponential backoff and dead-lettered
+// once they run out of attempts.
+type OutboxWorker struct {
+	mailer       Mailer
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int32
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+}
+
+func NewOutboxWorker(mailer Mailer, pollInterval time.Duration) *OutboxWorker {
+	return &OutboxWorker{
+		mailer:       mailer,
+		pollInterval: pollInterval,
+		batchSize:    defaultBatchSize,
+		maxAttempts:  defaultMaxAttempts,
+		baseBackoff:  defaultBaseBackoff,
+		maxBackoff:   defaultMaxBackoff,
+	}
+}
+
+func (w *OutboxWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.processBatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *OutboxWorker) processBatch(ctx context.Context) {
+	notifications, err := mongodb.ClaimNotificationsDBHandler(ctx, w.batchSize)
+	if err != nil {
+		utils.Logger.Error("outbox worker: unable to claim notifications", "error", err)
+		return
+	}
+
+	for _, n := range notifications {
+		// Attempts counts this claim. Going over the budget means earlier
+		// claims expired without an outcome, e.g. the worker crashed while
+		// sending, so don't try again.
+		if n.Attempts > w.maxAttempts {
+			utils.Logger.Warn("outbox worker: claim expired too often, dead-lettering", "notification_id", n.Id, "attempts", n.Attempts)
+			err := mongodb.MarkNotificationFailedDBHandler(ctx, n.Id, "delivery did not complete", time.Now(), true)
+			if err != nil {
+				utils.Logger.Error("outbox worker: unable to record failed delivery", "notification_id", n.Id, "error", err)
+			}
+			continue
+		}
+
+		err := w.mailer.Send(Message{
+			IdempotencyKey: n.IdempotencyKey,
+			To:             n.Recipient,
+			Subject:        n.Subject,
+			Body:           n.Body,
+		})
+		if err == nil {
+			if err := mongodb.MarkNotificationSentDBHandler(ctx, n.Id); err != nil {
+				utils.Logger.Error("outbox worker: unable to mark notification as sent", "notification_id", n.Id, "error", err)
+			}
+			continue
+		}
+
+		attempts := n.Attempts
+		dead := attempts >= w.maxAttempts
+		utils.Logger.Warn("outbox worker: delivery failed", "notification_id", n.Id, "attempts", attempts, "dead", dead, "error", err)
+		nextAttempt := time.Now().Add(w.backoff(attempts))
+		if err := mongodb.MarkNotificationFailedDBHandler(ctx, n.Id, err.Error(), nextAttempt, dead); err != nil {
+			utils.Logger.Error("outbox worker: unable to record failed delivery", "notification_id", n.Id, "error", err)
+		}
+	}
+}
+
+// backoff returns baseBackoff doubled for every attempt already made, capped at maxBackoff.
+func (w *OutboxWorker) backoff(attempts int32) time.Duration {
+	delay := w.baseBackoff
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= w.maxBackoff {
+			return w.maxBackoff
+		}
+	}
+	return delay
+}
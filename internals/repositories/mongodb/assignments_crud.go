@@ -0,0 +1,261 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// legacyAssignmentIndex is the unique index from when assignments named their
+// subject in free text. It covered subject rather than subject_id.
+const legacyAssignmentIndex = "unique_assignment"
+
+// EnsureAssignmentIndexesDBHandler stops the same teacher being assigned the
+// same subject in the same class and term twice. The index also serves
+// lookups by teacher. Assignments made before subjects had IDs are migrated
+// first, see migrateLegacyAssignments.
+func EnsureAssignmentIndexesDBHandler(ctx context.Context) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+	coll := db.Collection("teaching_assignments")
+
+	// The legacy index has to go before subject is unset, since assignments
+	// that differ only by subject would then clash on it
+	_, err = coll.Indexes().DropOne(ctx, legacyAssignmentIndex)
+	if err != nil && !isIndexNotFound(err) && !isNamespaceNotFound(err) {
+		return utils.ErrorHandler(err, "Error dropping legacy teaching assignment index")
+	}
+	err = migrateLegacyAssignments(ctx, db)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error migrating teaching assignment subjects")
+	}
+
+	_, err = coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "teacher_id", Value: 1}, {Key: "class_id", Value: 1}, {Key: "subject_id", Value: 1}, {Key: "term", Value: 1}},
+			Options: options.Index().SetName("unique_teacher_class_subject_term").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "class_id", Value: 1}},
+			Options: options.Index().SetName("class_id"),
+		},
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, "Error creating teaching assignment indexes")
+	}
+	return nil
+}
+
+// migrateLegacyAssignments moves the free-text subject of assignments made
+// before subjects had IDs into subject_id. Text matching the code or name of
+// a catalog subject becomes that subject's ID; anything else is copied as is,
+// so the assignment stays listed until it is reassigned. Migrated assignments
+// no longer match the filter, so running it again is safe.
+func migrateLegacyAssignments(ctx context.Context, db *mongo.Database) error {
+	coll := db.Collection("teaching_assignments")
+	legacy := bson.M{"subject": bson.M{"$exists": true}, "subject_id": bson.M{"$exists": false}}
+
+	values, err := coll.Distinct(ctx, "subject", legacy)
+	if err != nil || len(values) == 0 {
+		return err
+	}
+
+	cursor, err := db.Collection("subjects").Find(ctx, bson.M{},
+		options.Find().SetProjection(bson.M{"code": 1, "name": 1}))
+	if err != nil {
+		return err
+	}
+	var subjects []models.Subject
+	if err := cursor.All(ctx, &subjects); err != nil {
+		return err
+	}
+	catalog := subjectCatalog(subjects)
+
+	for _, value := range values {
+		subject, ok := value.(string)
+		if !ok {
+			continue
+		}
+		filter := bson.M{"subject": subject, "subject_id": bson.M{"$exists": false}}
+		_, err = coll.UpdateMany(ctx, filter, bson.M{
+			"$set":   bson.M{"subject_id": resolveLegacySubject(subject, catalog)},
+			"$unset": bson.M{"subject": ""},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// subjectCatalog maps the lower case code and name of every subject to its
+// ID. Codes are unique and win over a name that happens to read the same.
+func subjectCatalog(subjects []models.Subject) map[string]string {
+	catalog := make(map[string]string, 2*len(subjects))
+	for _, subject := range subjects {
+		if name := strings.ToLower(strings.TrimSpace(subject.Name)); name != "" {
+			if _, taken := catalog[name]; !taken {
+				catalog[name] = subject.Id
+			}
+		}
+	}
+	for _, subject := range subjects {
+		if code := strings.ToLower(strings.TrimSpace(subject.Code)); code != "" {
+			catalog[code] = subject.Id
+		}
+	}
+	return catalog
+}
+
+// resolveLegacySubject returns the ID of the catalog subject a free-text
+// subject names, or the text itself when none matches.
+func resolveLegacySubject(subject string, catalog map[string]string) string {
+	if id, ok := catalog[strings.ToLower(strings.TrimSpace(subject))]; ok {
+		return id
+	}
+	return subject
+}
+
+func AssignTeacherDBHandler(ctx context.Context, pbAssignment *pb.TeachingAssignment) (*pb.TeachingAssignment, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	assignment, err := mapPbToModel(pbAssignment, func() *models.TeachingAssignment { return &models.TeachingAssignment{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error mapping assignment data")
+	}
+
+	err = checkReference(ctx, client, "teachers", "teacher_id", assignment.TeacherId, "Teacher not found")
+	if err != nil {
+		return nil, err
+	}
+	err = checkReference(ctx, client, "classes", "class_id", assignment.ClassId, "Class not found")
+	if err != nil {
+		return nil, err
+	}
+	err = checkReference(ctx, client, "subjects", "subject_id", assignment.SubjectId, "Subject not found")
+	if err != nil {
+		return nil, err
+	}
+
+	teacherObjID, _ := primitive.ObjectIDFromHex(assignment.TeacherId)
+	qualified, err := client.Database("school").Collection("teachers").CountDocuments(ctx,
+		bson.M{"_id": teacherObjID, "qualified_subject_ids": assignment.SubjectId})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error checking teacher qualifications")
+	}
+	if qualified == 0 {
+		return nil, utils.ConflictError(utils.ReasonNotQualified, "Teacher is not qualified to teach this subject")
+	}
+
+	coll := client.Database("school").Collection("teaching_assignments")
+	result, err := coll.InsertOne(ctx, assignment)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, utils.AlreadyExistsError("", utils.ReasonAlreadyExists, "Teacher already has this assignment")
+		}
+		return nil, utils.ErrorHandler(err, "Error adding assignment to database")
+	}
+
+	objectId, ok := result.InsertedID.(primitive.ObjectID)
+	if ok {
+		assignment.Id = objectId.Hex()
+		recordAuditChanges(ctx, nil, auditSnapshot(ctx, coll, bson.M{"_id": objectId}))
+	}
+
+	added, err := mapModelToPb(*assignment, func() *pb.TeachingAssignment { return &pb.TeachingAssignment{} })
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error mapping assignment data")
+	}
+	return added, nil
+}
+
+func UnassignTeacherDBHandler(ctx context.Context, assignmentId string) error {
+	objID, err := primitive.ObjectIDFromHex(assignmentId)
+	if err != nil {
+		return utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid assignment ID format")
+	}
+
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("teaching_assignments")
+	before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+	result, err := coll.DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
+		return utils.ErrorHandler(err, "Error deleting assignment from database")
+	}
+	recordAuditChanges(ctx, before, nil)
+
+	if result.DeletedCount == 0 {
+		return utils.NotFoundError(utils.ReasonNotFound, "Assignment not found")
+	}
+	return nil
+}
+
+func ListAssignmentsDBHandler(ctx context.Context, sortOptions primitive.D, filter primitive.M) ([]*pb.TeachingAssignment, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	findOptions := options.Find()
+	if len(sortOptions) > 0 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := client.Database("school").Collection("teaching_assignments").Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	defer cursor.Close(ctx)
+
+	assignments, err := DecodeEntities(ctx,
+		cursor,
+		func() *pb.TeachingAssignment { return &pb.TeachingAssignment{} },
+		func() *models.TeachingAssignment { return &models.TeachingAssignment{} })
+	if err != nil {
+		return nil, err
+	}
+	return assignments, nil
+}
+
+// assignedClassIds returns the classes teacherId is assigned to, in any term,
+// optionally only those where they teach subjectId.
+func assignedClassIds(ctx context.Context, client *mongo.Client, teacherId, subjectId string) ([]string, error) {
+	filter := bson.M{"teacher_id": teacherId}
+	if subjectId != "" {
+		filter["subject_id"] = subjectId
+	}
+
+	values, err := client.Database("school").Collection("teaching_assignments").Distinct(ctx, "class_id", filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error fetching teacher assignments")
+	}
+
+	classIds := make([]string, 0, len(values))
+	for _, value := range values {
+		if id, ok := value.(string); ok {
+			classIds = append(classIds, id)
+		}
+	}
+	return classIds, nil
+}
@@ -0,0 +1,74 @@
+package mongodb
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestResolveLegacySubject(t *testing.T) {
+	catalog := subjectCatalog([]models.Subject{
+		{Id: "math-id", Code: "MATH101", Name: "Mathematics"},
+		{Id: "phys-id", Code: "PHYS", Name: "Physics"},
+		// A name that reads like another subject's code loses to the code
+		{Id: "odd-id", Code: "ODD", Name: "math101"},
+		{Id: "nameless-id", Code: "ART"},
+	})
+
+	tests := []struct {
+		subject string
+		want    string
+	}{
+		{"MATH101", "math-id"},
+		{"math101", "math-id"},
+		{"Mathematics", "math-id"},
+		{"  physics ", "phys-id"},
+		{"art", "nameless-id"},
+		{"odd", "odd-id"},
+		{"Chemistry", "Chemistry"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := resolveLegacySubject(tt.subject, catalog); got != tt.want {
+			t.Errorf("resolveLegacySubject(%q) = %q, want %q", tt.subject, got, tt.want)
+		}
+	}
+}
+
+func TestAssignmentMapping(t *testing.T) {
+	assignment := &pb.TeachingAssignment{
+		Id:        "0123456789abcdef01234567",
+		TeacherId: "89abcdef0123456789abcdef",
+		ClassId:   "abcdef0123456789abcdef01",
+		SubjectId: "456789abcdef0123456789ab",
+		Term:      "2025-2026 T1",
+	}
+
+	model, err := mapPbToModel(assignment, func() *models.TeachingAssignment { return &models.TeachingAssignment{} })
+	if err != nil {
+		t.Fatalf("mapPbToModel() = %v", err)
+	}
+	back, err := mapModelToPb(*model, func() *pb.TeachingAssignment { return &pb.TeachingAssignment{} })
+	if err != nil {
+		t.Fatalf("mapModelToPb() = %v", err)
+	}
+	if !proto.Equal(back, assignment) {
+		t.Errorf("assignment mapped to %v and back to %v", model, back)
+	}
+}
+
+func TestAssignmentsAreLinkedToTeachers(t *testing.T) {
+	for _, lc := range linkedCollections {
+		if lc.Collection == "teaching_assignments" {
+			if lc.Field != "teacher_id" || !slices.Contains(lc.Kinds, "teacher") {
+				t.Errorf("teaching_assignments registered as %+v, want teacher_id of teachers", lc)
+			}
+			return
+		}
+	}
+	t.Error("teaching_assignments is not registered as a linked collection")
+}
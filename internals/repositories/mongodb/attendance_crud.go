@@ -0,0 +1,307 @@
+package mongodb
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EnsureAttendanceIndexesDBHandler allows one record per student, date and
+// period, and indexes the class and date for daily class lookups.
+func EnsureAttendanceIndexesDBHandler(ctx context.Context) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	_, err = client.Database("school").Collection("attendance").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "student_id", Value: 1}, {Key: "date", Value: 1}, {Key: "period", Value: 1}},
+			Options: options.Index().SetName("unique_attendance").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "class_id", Value: 1}, {Key: "date", Value: 1}},
+			Options: options.Index().SetName("class_date"),
+		},
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, "Error creating attendance indexes")
+	}
+	return nil
+}
+
+// MarkAttendanceDBHandler records the marks for classId on date and period in
+// one transaction. Every student must belong to the class.
+func MarkAttendanceDBHandler(ctx context.Context, actor Actor, classId, date string, period int32, marks []*pb.AttendanceMark) ([]*pb.AttendanceRecord, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	err = checkReference(ctx, client, "classes", "class_id", classId, "Class not found")
+	if err != nil {
+		return nil, err
+	}
+	err = checkClassAccess(ctx, client, actor, classId)
+	if err != nil {
+		return nil, err
+	}
+
+	studentIds := make([]string, 0, len(marks))
+	for _, mark := range marks {
+		studentIds = append(studentIds, mark.StudentId)
+	}
+	err = checkClassStudents(ctx, client, classId, studentIds, "marks")
+	if err != nil {
+		return nil, err
+	}
+
+	coll := client.Database("school").Collection("attendance")
+	recordsFilter := bson.M{"student_id": bson.M{"$in": studentIds}, "date": date, "period": period}
+	before := auditSnapshot(ctx, coll, recordsFilter)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	var records []*pb.AttendanceRecord
+	err = runInTransaction(ctx, client, func(sessCtx mongo.SessionContext) error {
+		records = records[:0]
+		for _, mark := range marks {
+			record, err := writeAttendance(sessCtx, coll,
+				bson.M{"student_id": mark.StudentId, "date": date, "period": period},
+				models.AttendanceRecord{
+					StudentId:  mark.StudentId,
+					ClassId:    classId,
+					Date:       date,
+					Period:     period,
+					Status:     attendanceStatusName(mark.Status),
+					Note:       mark.Note,
+					RecordedBy: actor.Id,
+					RecordedAt: now,
+				}, "")
+			if err != nil {
+				return err
+			}
+			records = append(records, mapModelAttendanceToPb(record))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error recording attendance")
+	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, recordsFilter))
+
+	return records, nil
+}
+
+// CorrectAttendanceDBHandler changes one attendance record, keeping its
+// previous state and reason in the history.
+func CorrectAttendanceDBHandler(ctx context.Context, actor Actor, id string, status pb.AttendanceStatus, note, reason string) (*pb.AttendanceRecord, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid attendance ID format")
+	}
+
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("attendance")
+	var current models.AttendanceRecord
+	err = coll.FindOne(ctx, bson.M{"_id": objID}).Decode(&current)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, utils.NotFoundError(utils.ReasonNotFound, "Attendance record not found")
+		}
+		return nil, utils.ErrorHandler(err, "Error fetching attendance record")
+	}
+	err = checkClassAccess(ctx, client, actor, current.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	next := current
+	next.Status = attendanceStatusName(status)
+	next.Note = note
+	next.RecordedBy = actor.Id
+	next.RecordedAt = time.Now().UTC().Format(time.RFC3339)
+
+	before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+	record, err := writeAttendance(ctx, coll, bson.M{"_id": objID}, next, reason)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error correcting attendance")
+	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bson.M{"_id": objID}))
+
+	return mapModelAttendanceToPb(record), nil
+}
+
+// GetStudentAttendanceDBHandler returns the student's records from fromDate
+// to toDate inclusive. Accounts limited to their teacher's classes only see
+// records taken in those classes.
+func GetStudentAttendanceDBHandler(ctx context.Context, actor Actor, studentId, fromDate, toDate string) ([]*pb.AttendanceRecord, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	filter := bson.M{"student_id": studentId, "date": bson.M{"$gte": fromDate, "$lte": toDate}}
+	if !actor.AnyClass {
+		classIds, err := actorClassIds(ctx, client, actor)
+		if err != nil {
+			return nil, err
+		}
+		filter["class_id"] = bson.M{"$in": classIds}
+	}
+
+	return findAttendance(ctx, client, filter, bson.D{{Key: "date", Value: 1}, {Key: "period", Value: 1}})
+}
+
+// GetClassAttendanceDBHandler returns every record taken in classId on date.
+func GetClassAttendanceDBHandler(ctx context.Context, actor Actor, classId, date string) ([]*pb.AttendanceRecord, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	err = checkClassAccess(ctx, client, actor, classId)
+	if err != nil {
+		return nil, err
+	}
+
+	return findAttendance(ctx, client, bson.M{"class_id": classId, "date": date},
+		bson.D{{Key: "period", Value: 1}, {Key: "student_id", Value: 1}})
+}
+
+func findAttendance(ctx context.Context, client *mongo.Client, filter bson.M, sort bson.D) ([]*pb.AttendanceRecord, error) {
+	cursor, err := client.Database("school").Collection("attendance").Find(ctx, filter, options.Find().SetSort(sort))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	defer cursor.Close(ctx)
+
+	var records []*pb.AttendanceRecord
+	for cursor.Next(ctx) {
+		var record models.AttendanceRecord
+		if err := cursor.Decode(&record); err != nil {
+			return nil, utils.ErrorHandler(err, "Error decoding attendance data")
+		}
+		records = append(records, mapModelAttendanceToPb(record))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	return records, nil
+}
+
+// writeAttendance stores next as the record matching filter. A new record is
+// inserted; an existing one that differs is updated and its previous state,
+// with reason, is appended to the history. An unchanged record is returned
+// as is.
+func writeAttendance(ctx context.Context, coll *mongo.Collection, filter bson.M, next models.AttendanceRecord, reason string) (models.AttendanceRecord, error) {
+	var current models.AttendanceRecord
+	err := coll.FindOne(ctx, filter).Decode(&current)
+	if err == mongo.ErrNoDocuments {
+		result, err := coll.InsertOne(ctx, next)
+		if err != nil {
+			return next, err
+		}
+		if objID, ok := result.InsertedID.(primitive.ObjectID); ok {
+			next.Id = objID.Hex()
+		}
+		return next, nil
+	}
+	if err != nil {
+		return next, err
+	}
+	updated, change := applyAttendance(current, next, reason)
+	if change == nil {
+		return current, nil
+	}
+
+	objID, err := primitive.ObjectIDFromHex(current.Id)
+	if err != nil {
+		return next, err
+	}
+	_, err = coll.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{
+		"$set": bson.M{
+			"status":      updated.Status,
+			"note":        updated.Note,
+			"recorded_by": updated.RecordedBy,
+			"recorded_at": updated.RecordedAt,
+		},
+		"$push": bson.M{"history": *change},
+	})
+	if err != nil {
+		return next, err
+	}
+	return updated, nil
+}
+
+// applyAttendance returns current with the status and note of next, and the
+// history entry keeping current's previous state with reason. When neither
+// status nor note changes, current is returned as is with no entry.
+func applyAttendance(current, next models.AttendanceRecord, reason string) (models.AttendanceRecord, *models.AttendanceChange) {
+	if current.Status == next.Status && current.Note == next.Note {
+		return current, nil
+	}
+
+	change := models.AttendanceChange{
+		Status:     current.Status,
+		Note:       current.Note,
+		RecordedBy: current.RecordedBy,
+		RecordedAt: current.RecordedAt,
+		Reason:     reason,
+	}
+	updated := current
+	updated.Status = next.Status
+	updated.Note = next.Note
+	updated.RecordedBy = next.RecordedBy
+	updated.RecordedAt = next.RecordedAt
+	updated.History = append(slices.Clip(current.History), change)
+	return updated, &change
+}
+
+// attendanceStatusName is the lower case status stored in the database, e.g.
+// "present".
+func attendanceStatusName(status pb.AttendanceStatus) string {
+	return strings.ToLower(status.String())
+}
+
+func mapModelAttendanceToPb(record models.AttendanceRecord) *pb.AttendanceRecord {
+	history := make([]*pb.AttendanceChange, 0, len(record.History))
+	for _, c := range record.History {
+		history = append(history, &pb.AttendanceChange{
+			Status:     pb.AttendanceStatus(pb.AttendanceStatus_value[strings.ToUpper(c.Status)]),
+			Note:       c.Note,
+			RecordedBy: c.RecordedBy,
+			RecordedAt: c.RecordedAt,
+			Reason:     c.Reason,
+		})
+	}
+	return &pb.AttendanceRecord{
+		Id:         record.Id,
+		StudentId:  record.StudentId,
+		ClassId:    record.ClassId,
+		Date:       record.Date,
+		Period:     record.Period,
+		Status:     pb.AttendanceStatus(pb.AttendanceStatus_value[strings.ToUpper(record.Status)]),
+		Note:       record.Note,
+		RecordedBy: record.RecordedBy,
+		RecordedAt: record.RecordedAt,
+		History:    history,
+	}
+}
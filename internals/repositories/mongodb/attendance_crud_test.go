@@ -0,0 +1,109 @@
+package mongodb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestApplyAttendance(t *testing.T) {
+	earlier := models.AttendanceChange{Status: "present", RecordedBy: "e0", RecordedAt: "2026-01-05T08:00:00Z", Reason: "marked late"}
+	current := models.AttendanceRecord{
+		Id: "r1", StudentId: "s1", ClassId: "c1", Date: "2026-01-05", Period: 1,
+		Status: "absent", Note: "", RecordedBy: "e1", RecordedAt: "2026-01-05T08:05:00Z",
+		History: []models.AttendanceChange{earlier},
+	}
+	mark := func(status, note string) models.AttendanceRecord {
+		return models.AttendanceRecord{
+			StudentId: "s1", ClassId: "c1", Date: "2026-01-05", Period: 1,
+			Status: status, Note: note, RecordedBy: "e2", RecordedAt: "2026-01-05T09:00:00Z",
+		}
+	}
+
+	tests := []struct {
+		name        string
+		next        models.AttendanceRecord
+		wantChange  bool
+		wantStatus  string
+		wantNote    string
+		wantHistory int
+	}{
+		{"unchanged", mark("absent", ""), false, "absent", "", 1},
+		{"status changed", mark("late", ""), true, "late", "", 2},
+		{"note changed", mark("absent", "doctor"), true, "absent", "doctor", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, change := applyAttendance(current, tt.next, "corrected")
+
+			if (change != nil) != tt.wantChange {
+				t.Fatalf("applyAttendance() change = %v, want a change: %v", change, tt.wantChange)
+			}
+			if updated.Status != tt.wantStatus || updated.Note != tt.wantNote || len(updated.History) != tt.wantHistory {
+				t.Errorf("applyAttendance() = %+v", updated)
+			}
+			if updated.Id != current.Id || updated.StudentId != current.StudentId || updated.Period != current.Period {
+				t.Errorf("applyAttendance() changed the record's identity: %+v", updated)
+			}
+			if !tt.wantChange {
+				if !reflect.DeepEqual(updated, current) {
+					t.Errorf("unchanged record = %+v, want %+v", updated, current)
+				}
+				return
+			}
+
+			// The entry keeps who recorded the previous state and when
+			want := models.AttendanceChange{Status: "absent", Note: "", RecordedBy: "e1", RecordedAt: "2026-01-05T08:05:00Z", Reason: "corrected"}
+			if *change != want {
+				t.Errorf("change = %+v, want %+v", *change, want)
+			}
+			if updated.History[0] != earlier || updated.History[1] != want {
+				t.Errorf("history = %+v, want the earlier entry then %+v", updated.History, want)
+			}
+			if updated.RecordedBy != "e2" || updated.RecordedAt != "2026-01-05T09:00:00Z" {
+				t.Errorf("updated record recorded by %s at %s, want the new mark's", updated.RecordedBy, updated.RecordedAt)
+			}
+			if len(current.History) != 1 {
+				t.Errorf("applyAttendance() modified current's history: %+v", current.History)
+			}
+		})
+	}
+}
+
+func TestMapModelAttendanceToPb(t *testing.T) {
+	record := models.AttendanceRecord{
+		Id: "r1", StudentId: "s1", ClassId: "c1", Date: "2026-01-05", Period: 0,
+		Status: attendanceStatusName(pb.AttendanceStatus_EXCUSED), Note: "trip", RecordedBy: "e1", RecordedAt: "t1",
+		History: []models.AttendanceChange{
+			{Status: attendanceStatusName(pb.AttendanceStatus_ABSENT), RecordedBy: "e0", RecordedAt: "t0", Reason: "permission slip"},
+		},
+	}
+	want := &pb.AttendanceRecord{
+		Id: "r1", StudentId: "s1", ClassId: "c1", Date: "2026-01-05", Period: 0,
+		Status: pb.AttendanceStatus_EXCUSED, Note: "trip", RecordedBy: "e1", RecordedAt: "t1",
+		History: []*pb.AttendanceChange{
+			{Status: pb.AttendanceStatus_ABSENT, RecordedBy: "e0", RecordedAt: "t0", Reason: "permission slip"},
+		},
+	}
+
+	if got := mapModelAttendanceToPb(record); !proto.Equal(got, want) {
+		t.Errorf("mapModelAttendanceToPb() = %v, want %v", got, want)
+	}
+}
+
+func TestAttendanceStatusName(t *testing.T) {
+	for status, want := range map[pb.AttendanceStatus]string{
+		pb.AttendanceStatus_PRESENT: "present",
+		pb.AttendanceStatus_ABSENT:  "absent",
+		pb.AttendanceStatus_LATE:    "late",
+		pb.AttendanceStatus_EXCUSED: "excused",
+	} {
+		if got := attendanceStatusName(status); got != want {
+			t.Errorf("attendanceStatusName(%v) = %q, want %q", status, got, want)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// The audit log is append-only: this file deliberately has no update or
+// delete handlers for the audit_log collection.
+
+func InsertAuditEntryDBHandler(ctx context.Context, entry *models.AuditEntry) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	_, err = client.Database("school").Collection("audit_log").InsertOne(ctx, entry)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error writing audit entry")
+	}
+	return nil
+}
+
+func EnsureAuditIndexesDBHandler(ctx context.Context) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "timestamp", Value: -1}}},
+		{Keys: bson.D{{Key: "actor_id", Value: 1}, {Key: "timestamp", Value: -1}}},
+		{Keys: bson.D{{Key: "entity", Value: 1}, {Key: "target_ids", Value: 1}}},
+	}
+	_, err = client.Database("school").Collection("audit_log").Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error creating audit indexes")
+	}
+	return nil
+}
+
+func QueryAuditLogDBHandler(ctx context.Context, filter primitive.M, pageNumber, pageSize uint32) ([]*pb.AuditEntry, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "timestamp", Value: -1}})
+	// In uint32 the product overflows for large page numbers
+	findOptions.SetSkip(int64(pageNumber-1) * int64(pageSize))
+	findOptions.SetLimit(int64(pageSize))
+
+	cursor, err := client.Database("school").Collection("audit_log").Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	defer cursor.Close(ctx)
+
+	var entries []*pb.AuditEntry
+	for cursor.Next(ctx) {
+		var entry models.AuditEntry
+		if err := cursor.Decode(&entry); err != nil {
+			return nil, utils.ErrorHandler(err, "Internal Error")
+		}
+		entries = append(entries, mapModelAuditEntryToPb(entry))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, utils.ErrorHandler(err, "internal error")
+	}
+	return entries, nil
+}
+
+// mapModelAuditEntryToPb is written out by hand because the nested changes
+// cannot go through the reflection based mapModelToPb.
+func mapModelAuditEntryToPb(entry models.AuditEntry) *pb.AuditEntry {
+	changes := make([]*pb.AuditChange, 0, len(entry.Changes))
+	for _, c := range entry.Changes {
+		changes = append(changes, &pb.AuditChange{
+			TargetId: c.TargetId,
+			Field:    c.Field,
+			Before:   c.Before,
+			After:    c.After,
+		})
+	}
+
+	return &pb.AuditEntry{
+		Id:            entry.Id,
+		Timestamp:     entry.Timestamp,
+		ActorId:       entry.ActorId,
+		ActorUsername: entry.ActorUsername,
+		Role:          entry.Role,
+		Method:        entry.Method,
+		Action:        entry.Action,
+		Entity:        entry.Entity,
+		TargetIds:     entry.TargetIds,
+		Changes:       changes,
+		ClientIp:      entry.ClientIp,
+		RequestId:     entry.RequestId,
+		StatusCode:    entry.StatusCode,
+	}
+}
@@ -0,0 +1,584 @@
+package mongodb
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// classReferences maps each collection that points at classes to the field
+// holding the class ID. A class cannot be deleted while any of them still
+// references it.
+var classReferences = map[string]string{
+	"students":             "class_id",
+	"teachers":             "class_id",
+	"teaching_assignments": "class_id",
+	"attendance":           "class_id",
+	"assessments":          "class_id",
+}
+
+// Actor is the account behind a request, for features scoped to the classes
+// a teacher teaches. AnyClass is set for roles that may work with every
+// class; other accounts act for the teacher linked through Exec.TeacherId.
+type Actor struct {
+	Id       string
+	AnyClass bool
+}
+
+// EnsureClassIndexesDBHandler makes grade level, section and academic year
+// unique together.
+func EnsureClassIndexesDBHandler(ctx context.Context) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	_, err = client.Database("school").Collection("classes").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "grade_level", Value: 1}, {Key: "section", Value: 1}, {Key: "academic_year", Value: 1}},
+		Options: options.Index().SetName("unique_class").SetUnique(true),
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, "Error creating class indexes")
+	}
+	return nil
+}
+
+func AddClassesDBHandler(ctx context.Context, classesFromReq []*pb.Class) ([]*pb.Class, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	newClasses := make([]*models.Class, len(classesFromReq))
+	for i, pbClass := range classesFromReq {
+		newClasses[i], err = mapPbClassToModelClass(pbClass)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error mapping class data")
+		}
+		newClasses[i].Section = strings.ToUpper(newClasses[i].Section)
+		err = checkReference(ctx, client, "teachers", "homeroom_teacher_id", newClasses[i].HomeroomTeacherId, "Homeroom teacher not found")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	coll := client.Database("school").Collection("classes")
+	var addedClasses []*pb.Class
+	for _, class := range newClasses {
+		result, err := coll.InsertOne(ctx, class)
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, duplicateClassError()
+			}
+			return nil, utils.ErrorHandler(err, "Error adding class to database")
+		}
+
+		objectId, ok := result.InsertedID.(primitive.ObjectID)
+		if ok {
+			class.Id = objectId.Hex()
+			recordAuditChanges(ctx, nil, auditSnapshot(ctx, coll, bson.M{"_id": objectId}))
+		}
+
+		pbClass, err := mapModelClassToPbClass(*class)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error mapping class data")
+		}
+		addedClasses = append(addedClasses, pbClass)
+	}
+	return addedClasses, nil
+}
+
+func GetClassesDBHandler(ctx context.Context, sortOptions primitive.D, filter primitive.M) ([]*pb.Class, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	if section, ok := filter["section"].(string); ok {
+		filter["section"] = strings.ToUpper(section)
+	}
+
+	findOptions := options.Find()
+	if len(sortOptions) > 0 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := client.Database("school").Collection("classes").Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	defer cursor.Close(ctx)
+
+	classes, err := DecodeEntities(ctx,
+		cursor,
+		func() *pb.Class { return &pb.Class{} },
+		func() *models.Class { return &models.Class{} })
+	if err != nil {
+		return nil, err
+	}
+	return classes, nil
+}
+
+func UpdateClassesDBHandler(ctx context.Context, pbClasses []*pb.Class) ([]*pb.Class, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("classes")
+	var updatedClasses []*pb.Class
+
+	for _, class := range pbClasses {
+		if class.Id == "" {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonMissingID, "Class ID is required for update")
+		}
+
+		modelClass, err := mapPbClassToModelClass(class)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error mapping class data")
+		}
+		modelClass.Section = strings.ToUpper(modelClass.Section)
+
+		objID, err := primitive.ObjectIDFromHex(class.Id)
+		if err != nil {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+		}
+
+		err = checkReference(ctx, client, "teachers", "homeroom_teacher_id", modelClass.HomeroomTeacherId, "Homeroom teacher not found")
+		if err != nil {
+			return nil, err
+		}
+
+		modelDoc, err := bson.Marshal(modelClass)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error preparing class data for update")
+		}
+
+		var updateDoc bson.M
+		if err := bson.Unmarshal(modelDoc, &updateDoc); err != nil {
+			return nil, utils.ErrorHandler(err, "Error preparing class data for update")
+		}
+
+		delete(updateDoc, "_id")
+
+		before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+		_, err = coll.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": updateDoc})
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, duplicateClassError()
+			}
+			return nil, utils.ErrorHandler(err, "Error updating class data")
+		}
+		recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bson.M{"_id": objID}))
+
+		updatedClass, err := mapModelClassToPbClass(*modelClass)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error mapping class data")
+		}
+
+		updatedClasses = append(updatedClasses, updatedClass)
+	}
+	return updatedClasses, nil
+}
+
+func DeleteClassesDBHandler(ctx context.Context, classIdsToDelete []string) ([]string, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	objectIds := make([]primitive.ObjectID, 0, len(classIdsToDelete))
+	for _, id := range classIdsToDelete {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid class ID format")
+		}
+		objectIds = append(objectIds, objID)
+	}
+
+	err = checkNotReferenced(ctx, client, classReferences, classIdsToDelete, "Class")
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": objectIds}}
+	coll := client.Database("school").Collection("classes")
+	before := auditSnapshot(ctx, coll, filter)
+	result, err := coll.DeleteMany(ctx, filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error deleting classes from database")
+	}
+	recordAuditChanges(ctx, before, nil)
+
+	if result.DeletedCount == 0 {
+		return nil, utils.NotFoundError(utils.ReasonNotFound, "No classes found to delete")
+	}
+
+	deletedIds := make([]string, 0, len(objectIds))
+	for _, objID := range objectIds {
+		deletedIds = append(deletedIds, objID.Hex())
+	}
+	return deletedIds, nil
+}
+
+func duplicateClassError() error {
+	return utils.AlreadyExistsError("", utils.ReasonAlreadyExists, "A class with this grade level, section and academic year already exists")
+}
+
+// checkReference returns an InvalidArgument error for field unless id names a
+// document in collection. An empty id is not a reference and passes.
+func checkReference(ctx context.Context, client *mongo.Client, collection, field, id, message string) error {
+	if id == "" {
+		return nil
+	}
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return utils.InvalidArgumentError(field, utils.ReasonInvalidID, "Invalid ID format")
+	}
+
+	err = client.Database("school").Collection(collection).FindOne(ctx, bson.M{"_id": objID},
+		options.FindOne().SetProjection(bson.M{"_id": 1})).Err()
+	if err == mongo.ErrNoDocuments {
+		return utils.InvalidArgumentError(field, utils.ReasonUnknownReference, message)
+	}
+	if err != nil {
+		return utils.ErrorHandler(err, "Error checking "+field)
+	}
+	return nil
+}
+
+// checkNotReferenced returns a Conflict error naming the first collection in
+// references that still points at one of ids. Merged records don't count.
+func checkNotReferenced(ctx context.Context, client *mongo.Client, references map[string]string, ids []string, entity string) error {
+	for collection, field := range references {
+		count, err := client.Database("school").Collection(collection).CountDocuments(ctx,
+			bson.M{field: bson.M{"$in": ids}, "merged_into": notMerged},
+			options.Count().SetLimit(1))
+		if err != nil {
+			return utils.ErrorHandler(err, "Error checking references to "+entity)
+		}
+		if count > 0 {
+			return utils.ConflictError(utils.ReasonInUse, entity+" is still referenced by "+collection)
+		}
+	}
+	return nil
+}
+
+// classIdsForTeacher returns the classes whose students teacher teaches: their
+// own class_id, every class naming them as homeroom teacher and every class
+// they are assigned to. When subjectId is set only the classes they are
+// assigned that subject in are returned.
+func classIdsForTeacher(ctx context.Context, client *mongo.Client, teacherId string, teacher models.Teacher, subjectId string) ([]string, error) {
+	assigned, err := assignedClassIds(ctx, client, teacherId, subjectId)
+	if err != nil {
+		return nil, err
+	}
+	if subjectId != "" {
+		return teacherClassIds("", nil, assigned), nil
+	}
+
+	cursor, err := client.Database("school").Collection("classes").Find(ctx,
+		bson.M{"homeroom_teacher_id": teacherId},
+		options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error fetching teacher classes")
+	}
+	defer cursor.Close(ctx)
+
+	var homerooms []string
+	for cursor.Next(ctx) {
+		var class models.Class
+		if err := cursor.Decode(&class); err != nil {
+			return nil, utils.ErrorHandler(err, "Error decoding class data")
+		}
+		homerooms = append(homerooms, class.Id)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, utils.ErrorHandler(err, "Error fetching teacher classes")
+	}
+	return teacherClassIds(teacher.ClassId, homerooms, assigned), nil
+}
+
+// teacherClassIds combines a teacher's own class, homeroom classes and
+// assigned classes into one sorted list without repeats or blanks.
+func teacherClassIds(ownClassId string, homerooms, assigned []string) []string {
+	classIds := make([]string, 0, 1+len(homerooms)+len(assigned))
+	if ownClassId != "" {
+		classIds = append(classIds, ownClassId)
+	}
+	for _, id := range slices.Concat(homerooms, assigned) {
+		if id != "" {
+			classIds = append(classIds, id)
+		}
+	}
+	slices.Sort(classIds)
+	return slices.Compact(classIds)
+}
+
+// actorTeacher returns the teacher actor's account is linked to. It fails
+// with PermissionDenied for accounts not linked to a teacher.
+func actorTeacher(ctx context.Context, client *mongo.Client, actor Actor) (models.Teacher, error) {
+	denied := utils.PermissionDeniedError(utils.ReasonPermissionDenied, "Account is not linked to a teacher")
+	execObjID, err := primitive.ObjectIDFromHex(actor.Id)
+	if err != nil {
+		return models.Teacher{}, denied
+	}
+
+	var exec models.Exec
+	err = client.Database("school").Collection("execs").FindOne(ctx, bson.M{"_id": execObjID},
+		options.FindOne().SetProjection(bson.M{"teacher_id": 1})).Decode(&exec)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return models.Teacher{}, utils.ErrorHandler(err, "Error fetching account data")
+	}
+	teacherObjID, err := primitive.ObjectIDFromHex(exec.TeacherId)
+	if err != nil {
+		return models.Teacher{}, denied
+	}
+
+	var teacher models.Teacher
+	err = client.Database("school").Collection("teachers").FindOne(ctx, bson.M{"_id": teacherObjID}).Decode(&teacher)
+	if err == mongo.ErrNoDocuments {
+		return models.Teacher{}, denied
+	}
+	if err != nil {
+		return models.Teacher{}, utils.ErrorHandler(err, "Error fetching teacher data")
+	}
+	return teacher, nil
+}
+
+// actorClassIds returns the classes actor may work with: those of the
+// teacher their account is linked to.
+func actorClassIds(ctx context.Context, client *mongo.Client, actor Actor) ([]string, error) {
+	teacher, err := actorTeacher(ctx, client, actor)
+	if err != nil {
+		return nil, err
+	}
+	return classIdsForTeacher(ctx, client, teacher.Id, teacher, "")
+}
+
+// checkClassAccess fails with PermissionDenied unless actor may work with
+// classId.
+func checkClassAccess(ctx context.Context, client *mongo.Client, actor Actor, classId string) error {
+	if actor.AnyClass {
+		return nil
+	}
+	classIds, err := actorClassIds(ctx, client, actor)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(classIds, classId) {
+		return utils.PermissionDeniedError(utils.ReasonPermissionDenied, "Only teachers of the class have access")
+	}
+	return nil
+}
+
+// checkClassStudents returns an InvalidArgument error for field unless every
+// one of studentIds is a live student of classId. The IDs must be distinct.
+func checkClassStudents(ctx context.Context, client *mongo.Client, classId string, studentIds []string, field string) error {
+	objectIds := make([]primitive.ObjectID, 0, len(studentIds))
+	for _, id := range studentIds {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return utils.InvalidArgumentError("student_id", utils.ReasonInvalidID, "Invalid student ID format")
+		}
+		objectIds = append(objectIds, objID)
+	}
+
+	inClass, err := client.Database("school").Collection("students").CountDocuments(ctx,
+		bson.M{"_id": bson.M{"$in": objectIds}, "class_id": classId, "merged_into": notMerged})
+	if err != nil {
+		return utils.ErrorHandler(err, "Error checking class students")
+	}
+	if int(inClass) != len(studentIds) {
+		return utils.InvalidArgumentError(field, utils.ReasonValidationFailed, "Every student must belong to the class")
+	}
+	return nil
+}
+
+// ClassMigrationReport summarises a MigrateClassStringsDBHandler run. In a dry
+// run the counts say what would have changed.
+type ClassMigrationReport struct {
+	ClassesCreated  int
+	StudentsUpdated int64
+	TeachersUpdated int64
+	HomeroomsSet    int
+	// Unparsed counts the records per class string that could not be read as
+	// a grade and section. They are left for manual fixing.
+	Unparsed map[string]int
+}
+
+var classStringPattern = regexp.MustCompile(`(?i)^\s*(?:grade|class)?\s*(\d{1,2})\s*[-/ ]?\s*([a-z]{1,4})\s*$`)
+
+// parseClassString reads a legacy free-text class such as "9A", "9 a",
+// "9-A" or "Grade 9 A" as a grade level and an upper case section.
+func parseClassString(class string) (int32, string, bool) {
+	match := classStringPattern.FindStringSubmatch(class)
+	if match == nil {
+		return 0, "", false
+	}
+	grade, err := strconv.Atoi(match[1])
+	if err != nil || grade < 1 || grade > 12 {
+		return 0, "", false
+	}
+	return int32(grade), strings.ToUpper(match[2]), true
+}
+
+// MigrateClassStringsDBHandler converts the legacy free-text class of every
+// student and teacher without a class_id into a reference to a class in
+// academicYear, creating the class when needed. A teacher's class also gets
+// them as homeroom teacher unless it already has one. The old strings stay in
+// place and records that already have a class_id are skipped, so the
+// migration can be run again safely.
+func MigrateClassStringsDBHandler(ctx context.Context, academicYear string, dryRun bool) (*ClassMigrationReport, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+	report := &ClassMigrationReport{Unparsed: map[string]int{}}
+	legacy := bson.M{"class": bson.M{"$type": "string", "$ne": ""}, "class_id": bson.M{"$exists": false}}
+
+	// resolve finds or creates the class for a legacy string, caching the
+	// result. In a dry run missing classes get an empty ID.
+	classIds := map[string]string{}
+	resolve := func(grade int32, section string) (string, error) {
+		key := strconv.Itoa(int(grade)) + section
+		if id, ok := classIds[key]; ok {
+			return id, nil
+		}
+		filter := bson.M{"grade_level": grade, "section": section, "academic_year": academicYear}
+
+		var class models.Class
+		err := db.Collection("classes").FindOne(ctx, filter).Decode(&class)
+		switch {
+		case err == nil:
+		case err != mongo.ErrNoDocuments:
+			return "", err
+		case dryRun:
+			report.ClassesCreated++
+		default:
+			result, err := db.Collection("classes").InsertOne(ctx, models.Class{GradeLevel: grade, Section: section, AcademicYear: academicYear})
+			if err != nil {
+				return "", err
+			}
+			class.Id = result.InsertedID.(primitive.ObjectID).Hex()
+			report.ClassesCreated++
+		}
+		classIds[key] = class.Id
+		return class.Id, nil
+	}
+
+	for _, collection := range []string{"students", "teachers"} {
+		values, err := db.Collection(collection).Distinct(ctx, "class", legacy)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error reading class names from "+collection)
+		}
+
+		for _, value := range values {
+			name, _ := value.(string)
+			filter := bson.M{"class": name, "class_id": bson.M{"$exists": false}}
+
+			grade, section, ok := parseClassString(name)
+			if !ok {
+				count, err := db.Collection(collection).CountDocuments(ctx, filter)
+				if err != nil {
+					return nil, utils.ErrorHandler(err, "Error counting unparsed classes")
+				}
+				report.Unparsed[name] += int(count)
+				continue
+			}
+
+			classId, err := resolve(grade, section)
+			if err != nil {
+				return nil, utils.ErrorHandler(err, "Error creating class")
+			}
+
+			var updated int64
+			if dryRun {
+				updated, err = db.Collection(collection).CountDocuments(ctx, filter)
+			} else {
+				var teacherIds []string
+				if collection == "teachers" {
+					teacherIds, err = legacyTeacherIds(ctx, db, filter)
+					if err != nil {
+						return nil, utils.ErrorHandler(err, "Error reading teachers")
+					}
+				}
+
+				var result *mongo.UpdateResult
+				result, err = db.Collection(collection).UpdateMany(ctx, filter, bson.M{"$set": bson.M{"class_id": classId}})
+				if err == nil {
+					updated = result.ModifiedCount
+				}
+
+				if err == nil && len(teacherIds) > 0 {
+					classObjID, _ := primitive.ObjectIDFromHex(classId)
+					result, err = db.Collection("classes").UpdateOne(ctx,
+						bson.M{"_id": classObjID, "homeroom_teacher_id": bson.M{"$exists": false}},
+						bson.M{"$set": bson.M{"homeroom_teacher_id": teacherIds[0]}})
+					if err == nil {
+						report.HomeroomsSet += int(result.ModifiedCount)
+					}
+				}
+			}
+			if err != nil {
+				return nil, utils.ErrorHandler(err, "Error updating "+collection)
+			}
+
+			if collection == "students" {
+				report.StudentsUpdated += updated
+			} else {
+				report.TeachersUpdated += updated
+			}
+		}
+	}
+	return report, nil
+}
+
+// legacyTeacherIds returns the IDs of the teachers matching filter, oldest
+// first, so the first teacher recorded for a class becomes its homeroom
+// teacher.
+func legacyTeacherIds(ctx context.Context, db *mongo.Database, filter bson.M) ([]string, error) {
+	cursor, err := db.Collection("teachers").Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var ids []string
+	for cursor.Next(ctx) {
+		var teacher models.Teacher
+		if err := cursor.Decode(&teacher); err != nil {
+			return nil, err
+		}
+		ids = append(ids, teacher.Id)
+	}
+	sortOldestFirst(ids)
+	return ids, cursor.Err()
+}
+
+// sortOldestFirst sorts hex ObjectIDs by creation time. An ObjectID starts
+// with its big-endian creation timestamp, so its lower case hex form sorts
+// chronologically as a string.
+func sortOldestFirst(ids []string) {
+	sort.Slice(ids, func(i, j int) bool {
+		return strings.ToLower(ids[i]) < strings.ToLower(ids[j])
+	})
+}
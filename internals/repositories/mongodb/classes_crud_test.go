@@ -0,0 +1,130 @@
+package mongodb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestParseClassString(t *testing.T) {
+	tests := []struct {
+		class       string
+		wantGrade   int32
+		wantSection string
+		wantOk      bool
+	}{
+		{"9A", 9, "A", true},
+		{"9 a", 9, "A", true},
+		{"9-A", 9, "A", true},
+		{"9/b", 9, "B", true},
+		{"Grade 9 A", 9, "A", true},
+		{"class 12 sci", 12, "SCI", true},
+		{"  10c  ", 10, "C", true},
+		{"1ABCD", 1, "ABCD", true},
+		{"0A", 0, "", false},
+		{"13A", 0, "", false},
+		{"9", 0, "", false},
+		{"A9", 0, "", false},
+		{"9ABCDE", 0, "", false},
+		{"Grade Nine A", 0, "", false},
+		{"", 0, "", false},
+	}
+
+	for _, tt := range tests {
+		grade, section, ok := parseClassString(tt.class)
+		if grade != tt.wantGrade || section != tt.wantSection || ok != tt.wantOk {
+			t.Errorf("parseClassString(%q) = %d, %q, %v, want %d, %q, %v",
+				tt.class, grade, section, ok, tt.wantGrade, tt.wantSection, tt.wantOk)
+		}
+	}
+}
+
+func TestSortOldestFirst(t *testing.T) {
+	at := func(day int) string {
+		return primitive.NewObjectIDFromTimestamp(time.Date(2020, 1, day, 0, 0, 0, 0, time.UTC)).Hex()
+	}
+	day1, day3, day11, day20 := at(1), at(3), at(11), at(20)
+	ids := []string{day20, day3, day11, day1}
+	sortOldestFirst(ids)
+
+	want := []string{day1, day3, day11, day20}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("sortOldestFirst() = %v, want %v", ids, want)
+	}
+}
+
+func TestTeacherClassIds(t *testing.T) {
+	tests := []struct {
+		name      string
+		own       string
+		homerooms []string
+		assigned  []string
+		want      []string
+	}{
+		{
+			name: "no classes",
+			want: []string{},
+		},
+		{
+			name: "own class only",
+			own:  "c1",
+			want: []string{"c1"},
+		},
+		{
+			name:      "own class and homerooms",
+			own:       "c2",
+			homerooms: []string{"c3", "c1"},
+			want:      []string{"c1", "c2", "c3"},
+		},
+		{
+			name:      "homeroom of their own class",
+			own:       "c1",
+			homerooms: []string{"c1"},
+			want:      []string{"c1"},
+		},
+		{
+			name:     "assigned classes",
+			own:      "c1",
+			assigned: []string{"c4", "c2"},
+			want:     []string{"c1", "c2", "c4"},
+		},
+		{
+			name:      "assigned to their homeroom class",
+			own:       "c1",
+			homerooms: []string{"c2"},
+			assigned:  []string{"c2", "c1"},
+			want:      []string{"c1", "c2"},
+		},
+		{
+			// GetStudentsByClassTeacher with a subject only counts assignments
+			name:     "assigned classes only",
+			assigned: []string{"c3"},
+			want:     []string{"c3"},
+		},
+		{
+			name:      "blank IDs are dropped",
+			homerooms: []string{"", "c1"},
+			want:      []string{"c1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := teacherClassIds(tt.own, tt.homerooms, tt.assigned)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("teacherClassIds(%q, %v, %v) = %v, want %v", tt.own, tt.homerooms, tt.assigned, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckClassAccessAnyClass(t *testing.T) {
+	// Admins and managers are never looked up, so no client is needed
+	err := checkClassAccess(context.Background(), nil, Actor{Id: "admin", AnyClass: true}, "any-class")
+	if err != nil {
+		t.Errorf("checkClassAccess() for AnyClass = %v, want nil", err)
+	}
+}
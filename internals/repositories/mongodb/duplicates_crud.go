@@ -0,0 +1,360 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// uniqueIndex is a field that must be unique within a collection.
+type uniqueIndex struct {
+	collection string
+	field      string
+	// caseInsensitive compares values ignoring case, as for email addresses
+	caseInsensitive bool
+}
+
+// name is "unique_<field>", with a "_ci" suffix for case-insensitive indexes
+// so they can be built next to an older case-sensitive one.
+func (u uniqueIndex) name() string {
+	if u.caseInsensitive {
+		return "unique_" + u.field + "_ci"
+	}
+	return "unique_" + u.field
+}
+
+var uniqueIndexes = []uniqueIndex{
+	{collection: "execs", field: "username"},
+	{collection: "execs", field: "email", caseInsensitive: true},
+	{collection: "students", field: "email", caseInsensitive: true},
+	{collection: "teachers", field: "email", caseInsensitive: true},
+	{collection: "subjects", field: "code"},
+}
+
+// caseInsensitive is the collation of case-insensitive unique indexes. Queries
+// that check such a field must use it too.
+var caseInsensitive = options.Collation{Locale: "en", Strength: 2}
+
+// Reasons reported by FindDuplicatesDBHandler.
+const (
+	duplicateSameNameAndDOB = "same_name_and_dob"
+	duplicateSameName       = "same_name"
+	duplicateSimilarEmail   = "similar_email"
+)
+
+// EnsureUniqueIndexesDBHandler creates the indexes in uniqueIndexes. They are
+// partial so records without the field, such as students with no email, do
+// not clash. Each index is created on its own, so one that fails, typically
+// because duplicates already exist, doesn't keep the others from being built.
+// The error lists every index that is missing; the duplicates can be found
+// with FindDuplicatesDBHandler.
+func EnsureUniqueIndexesDBHandler(ctx context.Context) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	var errs []error
+	for _, u := range uniqueIndexes {
+		err := ensureUniqueIndex(ctx, client.Database("school").Collection(u.collection), u)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s.%s: %w", u.collection, u.name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func ensureUniqueIndex(ctx context.Context, coll *mongo.Collection, u uniqueIndex) error {
+	opts := options.Index().
+		SetName(u.name()).
+		SetUnique(true).
+		SetPartialFilterExpression(bson.M{u.field: bson.M{"$type": "string"}})
+	if u.caseInsensitive {
+		opts.SetCollation(&caseInsensitive)
+	}
+	_, err := coll.Indexes().CreateOne(ctx, mongo.IndexModel{Keys: bson.D{{Key: u.field, Value: 1}}, Options: opts})
+	if err != nil {
+		return err
+	}
+
+	// The case-insensitive index replaces the case-sensitive one built by
+	// earlier versions
+	if u.caseInsensitive {
+		_, err = coll.Indexes().DropOne(ctx, "unique_"+u.field)
+		if err != nil && !isIndexNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// isIndexNotFound reports whether err is MongoDB's IndexNotFound.
+func isIndexNotFound(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && cmdErr.Code == 27
+}
+
+// isNamespaceNotFound reports whether err is MongoDB's NamespaceNotFound,
+// returned for example when dropping an index of a collection that doesn't
+// exist yet.
+func isNamespaceNotFound(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && cmdErr.Code == 26
+}
+
+// duplicateKeyError turns a duplicate key error from collection into an
+// AlreadyExists error naming the field that clashed. The case-sensitive index
+// an email index replaces counts too, since it stays until the new one is
+// built.
+func duplicateKeyError(err error, collection string) error {
+	for _, u := range uniqueIndexes {
+		if u.collection != collection {
+			continue
+		}
+		if strings.Contains(err.Error(), "index: "+u.name()+" ") || strings.Contains(err.Error(), "index: unique_"+u.field+" ") {
+			message := strings.ToUpper(u.field[:1]) + u.field[1:] + " is already in use"
+			return utils.AlreadyExistsError(u.field, utils.ReasonAlreadyExists, message)
+		}
+	}
+	return utils.AlreadyExistsError("", utils.ReasonAlreadyExists, "Record already exists")
+}
+
+type duplicateCandidate struct {
+	id    string
+	name  string
+	dob   string
+	email string
+}
+
+// FindDuplicatesDBHandler groups records of kind that probably describe the
+// same person: equal names (with equal dates of birth when both are known) or
+// emails that differ only by dots, a +tag or a typo. Erased and merged
+// records are ignored.
+func FindDuplicatesDBHandler(ctx context.Context, kind string) ([]*pb.DuplicateGroup, error) {
+	collection, ok := personCollections[kind]
+	if !ok {
+		return nil, utils.InvalidArgumentError("kind", utils.ReasonValidationFailed, "Unknown person kind")
+	}
+
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	projection := bson.M{"first_name": 1, "last_name": 1, "email": 1, "date_of_birth": 1}
+	cursor, err := client.Database("school").Collection(collection).Find(ctx,
+		bson.M{"erased_at": bson.M{"$exists": false}, "merged_into": notMerged},
+		options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	defer cursor.Close(ctx)
+
+	var candidates []duplicateCandidate
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, utils.ErrorHandler(err, "Error decoding person data")
+		}
+		objID, _ := doc["_id"].(primitive.ObjectID)
+		firstName, _ := doc["first_name"].(string)
+		lastName, _ := doc["last_name"].(string)
+		dob, _ := doc["date_of_birth"].(string)
+		email, _ := doc["email"].(string)
+		candidates = append(candidates, duplicateCandidate{
+			id:    objID.Hex(),
+			name:  normalizeName(firstName + lastName),
+			dob:   dob,
+			email: strings.ToLower(strings.TrimSpace(email)),
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+
+	return groupDuplicates(candidates), nil
+}
+
+// groupDuplicates links every matching pair and returns the connected groups.
+// Only records sharing a name or an email block (see emailBlocks) are
+// compared, so large schools do not compare every pair of addresses on the
+// school domain.
+func groupDuplicates(candidates []duplicateCandidate) []*pb.DuplicateGroup {
+	parent := make([]int, len(candidates))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	reasons := map[int]map[string]bool{}
+	link := func(i, j int, reason string) {
+		ri, rj := find(i), find(j)
+		if ri != rj {
+			parent[rj] = ri
+			for r := range reasons[rj] {
+				addReason(reasons, ri, r)
+			}
+			delete(reasons, rj)
+		}
+		addReason(reasons, ri, reason)
+	}
+
+	byName := map[string][]int{}
+	byEmailBlock := map[string][]int{}
+	for i, c := range candidates {
+		if c.name != "" {
+			byName[c.name] = append(byName[c.name], i)
+		}
+		for _, block := range emailBlocks(c.email) {
+			byEmailBlock[block] = append(byEmailBlock[block], i)
+		}
+	}
+
+	for _, members := range byName {
+		for x := 0; x < len(members); x++ {
+			for y := x + 1; y < len(members); y++ {
+				a, b := candidates[members[x]], candidates[members[y]]
+				switch {
+				case a.dob != "" && a.dob == b.dob:
+					link(members[x], members[y], duplicateSameNameAndDOB)
+				case a.dob == "" || b.dob == "":
+					link(members[x], members[y], duplicateSameName)
+				}
+			}
+		}
+	}
+	for _, members := range byEmailBlock {
+		for x := 0; x < len(members); x++ {
+			for y := x + 1; y < len(members); y++ {
+				if similarEmails(candidates[members[x]].email, candidates[members[y]].email) {
+					link(members[x], members[y], duplicateSimilarEmail)
+				}
+			}
+		}
+	}
+
+	members := map[int][]string{}
+	for i, c := range candidates {
+		root := find(i)
+		members[root] = append(members[root], c.id)
+	}
+	var groups []*pb.DuplicateGroup
+	for root, ids := range members {
+		if len(ids) < 2 {
+			continue
+		}
+		sort.Strings(ids)
+		var groupReasons []string
+		for r := range reasons[root] {
+			groupReasons = append(groupReasons, r)
+		}
+		sort.Strings(groupReasons)
+		groups = append(groups, &pb.DuplicateGroup{Ids: ids, Reasons: groupReasons})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Ids[0] < groups[j].Ids[0] })
+	return groups
+}
+
+func addReason(reasons map[int]map[string]bool, root int, reason string) {
+	if reasons[root] == nil {
+		reasons[root] = map[string]bool{}
+	}
+	reasons[root][reason] = true
+}
+
+// normalizeName lowercases name and drops everything but letters, so "Mary
+// Ann" and "Maryann" compare equal.
+func normalizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// emailBlockLength is how many letters of a normalized local part an email
+// block keeps.
+const emailBlockLength = 3
+
+// emailBlocks returns the buckets email is compared in: its domain with the
+// first letters of the normalized local part, and its domain with the last
+// ones. Similar addresses share at least one block unless typos touch both
+// ends of the local part.
+func emailBlocks(email string) []string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return nil
+	}
+	local, domain := []rune(normalizeEmailLocal(email[:at])), email[at+1:]
+	if len(local) <= emailBlockLength {
+		return []string{domain + "|" + string(local)}
+	}
+	return []string{
+		domain + "|" + string(local[:emailBlockLength]) + "*",
+		domain + "|*" + string(local[len(local)-emailBlockLength:]),
+	}
+}
+
+// similarEmails reports whether two addresses on the same domain have local
+// parts that match once dots and +tags are removed, or differ by a typo.
+func similarEmails(a, b string) bool {
+	localA := normalizeEmailLocal(a[:strings.LastIndex(a, "@")])
+	localB := normalizeEmailLocal(b[:strings.LastIndex(b, "@")])
+	if localA == localB {
+		return true
+	}
+	maxDistance := 1
+	if len(localA) >= 8 && len(localB) >= 8 {
+		maxDistance = 2
+	}
+	if len(localA) < 4 || len(localB) < 4 {
+		return false
+	}
+	return levenshtein(localA, localB) <= maxDistance
+}
+
+func normalizeEmailLocal(local string) string {
+	if plus := strings.Index(local, "+"); plus >= 0 {
+		local = local[:plus]
+	}
+	return strings.ReplaceAll(local, ".", "")
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
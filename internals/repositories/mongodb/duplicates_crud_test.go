@@ -0,0 +1,195 @@
+package mongodb
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+)
+
+func TestGroupDuplicates(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []duplicateCandidate
+		want       []*pb.DuplicateGroup
+	}{
+		{
+			name: "no duplicates",
+			candidates: []duplicateCandidate{
+				{id: "1", name: normalizeName("Ann Lee"), email: "ann@school.org"},
+				{id: "2", name: normalizeName("Bob Ray"), email: "bob@school.org"},
+			},
+		},
+		{
+			name: "same name and date of birth",
+			candidates: []duplicateCandidate{
+				{id: "1", name: normalizeName("Mary Ann Smith"), dob: "2010-04-01"},
+				{id: "2", name: normalizeName("Maryann Smith"), dob: "2010-04-01"},
+			},
+			want: []*pb.DuplicateGroup{{Ids: []string{"1", "2"}, Reasons: []string{duplicateSameNameAndDOB}}},
+		},
+		{
+			name: "same name with different dates of birth",
+			candidates: []duplicateCandidate{
+				{id: "1", name: normalizeName("Ann Lee"), dob: "2010-04-01"},
+				{id: "2", name: normalizeName("Ann Lee"), dob: "2011-09-12"},
+			},
+		},
+		{
+			name: "same name with one date of birth missing",
+			candidates: []duplicateCandidate{
+				{id: "1", name: normalizeName("Ann Lee"), dob: "2010-04-01"},
+				{id: "2", name: normalizeName("ann lee")},
+			},
+			want: []*pb.DuplicateGroup{{Ids: []string{"1", "2"}, Reasons: []string{duplicateSameName}}},
+		},
+		{
+			name: "emails differing by dots and tag",
+			candidates: []duplicateCandidate{
+				{id: "1", email: "ann.lee@school.org"},
+				{id: "2", email: "annlee+class9@school.org"},
+			},
+			want: []*pb.DuplicateGroup{{Ids: []string{"1", "2"}, Reasons: []string{duplicateSimilarEmail}}},
+		},
+		{
+			name: "email typo",
+			candidates: []duplicateCandidate{
+				{id: "1", email: "jonathan@school.org"},
+				{id: "2", email: "jonathon@school.org"},
+			},
+			want: []*pb.DuplicateGroup{{Ids: []string{"1", "2"}, Reasons: []string{duplicateSimilarEmail}}},
+		},
+		{
+			name: "typo in the first letters",
+			candidates: []duplicateCandidate{
+				{id: "1", email: "jonathan@school.org"},
+				{id: "2", email: "honathan@school.org"},
+			},
+			want: []*pb.DuplicateGroup{{Ids: []string{"1", "2"}, Reasons: []string{duplicateSimilarEmail}}},
+		},
+		{
+			name: "short local parts in one block",
+			candidates: []duplicateCandidate{
+				{id: "1", email: "a.nn@school.org"},
+				{id: "2", email: "ann+x@school.org"},
+			},
+			want: []*pb.DuplicateGroup{{Ids: []string{"1", "2"}, Reasons: []string{duplicateSimilarEmail}}},
+		},
+		{
+			name: "same local part on different domains",
+			candidates: []duplicateCandidate{
+				{id: "1", email: "ann@school.org"},
+				{id: "2", email: "ann@example.com"},
+			},
+		},
+		{
+			name: "short local parts are not typos",
+			candidates: []duplicateCandidate{
+				{id: "1", email: "al@school.org"},
+				{id: "2", email: "ali@school.org"},
+			},
+		},
+		{
+			name: "groups are joined transitively with all reasons",
+			candidates: []duplicateCandidate{
+				{id: "3", name: normalizeName("Ann Lee"), email: "ann.lee@school.org"},
+				{id: "1", name: normalizeName("Ann Lee"), email: "a.lee@other.org"},
+				{id: "2", name: normalizeName("Anne Leigh"), email: "annlee@school.org"},
+				{id: "4", name: normalizeName("Bob Ray"), email: "bob@school.org"},
+			},
+			want: []*pb.DuplicateGroup{{Ids: []string{"1", "2", "3"}, Reasons: []string{duplicateSameName, duplicateSimilarEmail}}},
+		},
+		{
+			name: "separate groups sorted by first ID",
+			candidates: []duplicateCandidate{
+				{id: "9", name: normalizeName("Bob Ray")},
+				{id: "5", name: normalizeName("Bob Ray")},
+				{id: "2", name: normalizeName("Ann Lee")},
+				{id: "7", name: normalizeName("Ann Lee")},
+			},
+			want: []*pb.DuplicateGroup{
+				{Ids: []string{"2", "7"}, Reasons: []string{duplicateSameName}},
+				{Ids: []string{"5", "9"}, Reasons: []string{duplicateSameName}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := groupDuplicates(tt.candidates)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d groups %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if !reflect.DeepEqual(got[i].Ids, tt.want[i].Ids) || !reflect.DeepEqual(got[i].Reasons, tt.want[i].Reasons) {
+					t.Errorf("group %d = %v %v, want %v %v", i, got[i].Ids, got[i].Reasons, tt.want[i].Ids, tt.want[i].Reasons)
+				}
+			}
+		})
+	}
+}
+
+func TestEmailBlocks(t *testing.T) {
+	tests := []struct {
+		email string
+		want  []string
+	}{
+		{"jonathan@school.org", []string{"school.org|jon*", "school.org|*han"}},
+		{"j.o.n+tag@school.org", []string{"school.org|jon"}},
+		{"jona@school.org", []string{"school.org|jon*", "school.org|*ona"}},
+		{"no-at-sign", nil},
+		{"@school.org", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := emailBlocks(tt.email); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("emailBlocks(%q) = %v, want %v", tt.email, got, tt.want)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"jonathan", "jonathon", 1},
+		{"müller", "muller", 1},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDuplicateKeyError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        string
+		collection string
+		wantField  string
+	}{
+		{"case-insensitive email", "E11000 duplicate key error collection: school.students index: unique_email_ci collation: {...} dup key: { email: \"a@b.c\" }", "students", "email"},
+		{"legacy email index", "E11000 duplicate key error collection: school.teachers index: unique_email dup key: { email: \"a@b.c\" }", "teachers", "email"},
+		{"username", "E11000 duplicate key error collection: school.execs index: unique_username dup key: { username: \"admin1\" }", "execs", "username"},
+		{"other index", "E11000 duplicate key error collection: school.scores index: unique_score dup key: {}", "scores", ""},
+		{"index of another collection", "E11000 duplicate key error collection: school.subjects index: unique_code dup key: {}", "execs", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := duplicateKeyError(errors.New(tt.err), tt.collection)
+			var domainErr *utils.Error
+			if !errors.As(err, &domainErr) {
+				t.Fatalf("got %T, want *utils.Error", err)
+			}
+			if domainErr.Field != tt.wantField {
+				t.Errorf("field = %q, want %q", domainErr.Field, tt.wantField)
+			}
+		})
+	}
+}
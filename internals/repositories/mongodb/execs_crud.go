@@ -2,14 +2,11 @@ package mongodb
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
-	"os"
-	"strconv"
+	"slices"
 	"time"
 
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/audit"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
 	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
@@ -17,10 +14,84 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
+// Account states of an exec. Pending accounts were added without a password
+// and become active once activated.
+const (
+	AccountPending = "pending"
+	AccountActive  = "active"
+)
+
+// execSecretFields hold credentials and the state of the password reset,
+// activation and email change flows. They are never read back to clients or
+// used as filters.
+var execSecretFields = []string{
+	"password",
+	"password_reset_token",
+	"password_token_expires",
+	"activation_token",
+	"activation_token_expires",
+	"pending_email",
+	"email_change_token",
+	"email_change_token_expires",
+}
+
+// execManagedFields describe the account's state and what it may access.
+// They only change through their own flows, so UpdateExecs leaves them alone,
+// apart from execAdminFields when an admin makes the call.
+var execManagedFields = []string{
+	"account_status",
+	"inactive_status",
+	"password_changed_at",
+	"user_created_at",
+	"role",
+	"teacher_id",
+}
+
+// execAdminFields are the managed fields an admin may set with UpdateExecs.
+// The role and the linked teacher decide which classes an exec can reach, so
+// nobody else may change them, not even for their own account.
+var execAdminFields = []string{"role", "teacher_id"}
+
+// execSecretProjection leaves execSecretFields out of query results.
+func execSecretProjection() bson.M {
+	projection := bson.M{}
+	for _, field := range execSecretFields {
+		projection[field] = 0
+	}
+	return projection
+}
+
+// stripExecUpdate removes from an UpdateExecs $set document every field the
+// caller may not change: the secrets other than the password, and the managed
+// fields, keeping execAdminFields for admins.
+func stripExecUpdate(updateDoc bson.M, admin bool) {
+	for _, field := range execSecretFields {
+		if field != "password" {
+			delete(updateDoc, field)
+		}
+	}
+	for _, field := range execManagedFields {
+		if admin && slices.Contains(execAdminFields, field) {
+			continue
+		}
+		delete(updateDoc, field)
+	}
+}
+
+// clearExecSecrets blanks the secret fields of an exec about to be returned.
+func clearExecSecrets(exec *pb.Exec) {
+	exec.Password = ""
+	exec.PasswordResetToken = ""
+	exec.PasswordTokenExpires = ""
+	exec.ActivationToken = ""
+	exec.ActivationTokenExpires = ""
+	exec.PendingEmail = ""
+	exec.EmailChangeToken = ""
+	exec.EmailChangeTokenExpires = ""
+}
+
 func AddExecsDBHandler(ctx context.Context, execsFromReq []*pb.Exec) ([]*pb.Exec, error) {
 	client, err := CreateMongoClient(ctx)
 	if err != nil {
@@ -37,34 +108,84 @@ func AddExecsDBHandler(ctx context.Context, execsFromReq []*pb.Exec) ([]*pb.Exec
 		if newExecs[i] == nil {
 			return nil, utils.ErrorHandler(nil, "Mapped Exec is nil")
 		}
-		hashedPassword, err := utils.HashPassword(newExecs[i].Password)
+		err = checkReference(ctx, client, "teachers", "teacher_id", newExecs[i].TeacherId, "Teacher not found")
 		if err != nil {
-			return nil, utils.ErrorHandler(err, "Error hashing password")
+			return nil, err
 		}
-		newExecs[i].Password = hashedPassword
 		currentTime := time.Now().Format(time.RFC3339)
 		newExecs[i].UserCreatedAt = currentTime
 		newExecs[i].InactiveStatus = false
+		newExecs[i].ActivationToken = ""
+		newExecs[i].ActivationTokenExpires = ""
+		newExecs[i].PendingEmail = ""
+		newExecs[i].EmailChangeToken = ""
+		newExecs[i].EmailChangeTokenExpires = ""
+
+		// Without a password the exec sets their own through ActivateAccount
+		if newExecs[i].Password == "" {
+			newExecs[i].AccountStatus = AccountPending
+			continue
+		}
+
+		hashedPassword, err := utils.HashPassword(newExecs[i].Password)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error hashing password")
+		}
+		newExecs[i].Password = hashedPassword
+		newExecs[i].AccountStatus = AccountActive
 	}
 
 	// fmt.Println(newExecs)
 
 	var addedExecs []*pb.Exec
 	for _, exec := range newExecs {
-		result, err := client.Database("school").Collection("execs").InsertOne(ctx, exec)
+		var activationToken string
+		if exec.AccountStatus == AccountPending {
+			token, hashedToken, expiry, err := newActivationToken()
+			if err != nil {
+				return nil, utils.ErrorHandler(err, "Error generating activation token")
+			}
+			activationToken = token
+			exec.ActivationToken = hashedToken
+			exec.ActivationTokenExpires = expiry
+		}
+
+		// The account and its welcome email are written together, so a crash
+		// can neither lose the email nor send one for an account that never existed.
+		err := runInTransaction(ctx, client, func(sessCtx mongo.SessionContext) error {
+			result, err := client.Database("school").Collection("execs").InsertOne(sessCtx, exec)
+			if err != nil {
+				return err
+			}
+
+			objectId, ok := result.InsertedID.(primitive.ObjectID)
+			if ok {
+				exec.Id = objectId.Hex()
+			}
+
+			if exec.AccountStatus == AccountPending {
+				return enqueueActivationEmail(sessCtx, client, exec, activationToken)
+			}
+
+			message := fmt.Sprintf("Welcome %s,\nAn account has been created for you with the username: %s\nPlease log in and change your password.", exec.FirstName, exec.Username)
+			return enqueueNotification(sessCtx, client, "welcome:"+exec.Id, exec.Email, "Welcome to the school portal", message)
+		})
 		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, duplicateKeyError(err, "execs")
+			}
 			return nil, utils.ErrorHandler(err, "Error adding exec to database")
 		}
-
-		objectId, ok := result.InsertedID.(primitive.ObjectID)
-		if ok {
-			exec.Id = objectId.Hex()
+		if objID, err := primitive.ObjectIDFromHex(exec.Id); err == nil {
+			recordAuditChanges(ctx, nil, auditSnapshot(ctx, client.Database("school").Collection("execs"), bson.M{"_id": objID}))
 		}
 
 		pbExec, err := mapModelExecToPbExec(*exec)
 		if err != nil {
 			return nil, utils.ErrorHandler(err, "Error mapping exec data")
 		}
+		// Only hashes are stored; never hand them back to the caller
+		clearExecSecrets(pbExec)
 		addedExecs = append(addedExecs, pbExec)
 	}
 	return addedExecs, nil
@@ -77,13 +198,16 @@ func GetExecsDBHandler(ctx context.Context, sortOptions primitive.D, filter prim
 	}
 	defer client.Disconnect(ctx)
 
+	for _, field := range execSecretFields {
+		delete(filter, field)
+	}
+
 	coll := client.Database("school").Collection("execs")
-	var cursor *mongo.Cursor
-	if len(sortOptions) < 1 {
-		cursor, err = coll.Find(ctx, filter)
-	} else {
-		cursor, err = coll.Find(ctx, filter, options.Find().SetSort(sortOptions))
+	opts := options.Find().SetProjection(execSecretProjection())
+	if len(sortOptions) > 0 {
+		opts.SetSort(sortOptions)
 	}
+	cursor, err := coll.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Internal Error")
 	}
@@ -107,10 +231,11 @@ func UpdateExecsDBHandler(ctx context.Context, pbExecs []*pb.Exec) ([]*pb.Exec,
 	defer client.Disconnect(ctx)
 
 	var updatedExecs []*pb.Exec
+	admin := utils.AuthorizeUser(ctx, "admin") == nil
 
 	for _, exec := range pbExecs {
 		if exec.Id == "" {
-			return nil, utils.ErrorHandler(nil, "Exec ID is required for update")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonMissingID, "Exec ID is required for update")
 		}
 
 		modelExec, err := mapPbExecToModelExec(exec)
@@ -120,7 +245,12 @@ func UpdateExecsDBHandler(ctx context.Context, pbExecs []*pb.Exec) ([]*pb.Exec,
 
 		objID, err := primitive.ObjectIDFromHex(exec.Id)
 		if err != nil {
-			return nil, utils.ErrorHandler(err, "Invalid ID format")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+		}
+
+		err = checkReference(ctx, client, "teachers", "teacher_id", modelExec.TeacherId, "Teacher not found")
+		if err != nil {
+			return nil, err
 		}
 
 		// Hash password if provided
@@ -150,21 +280,39 @@ func UpdateExecsDBHandler(ctx context.Context, pbExecs []*pb.Exec) ([]*pb.Exec,
 		if exec.Password == "" {
 			delete(updateDoc, "password")
 		}
+		// Only the password may be changed here; tokens, pending email and
+		// account state have their own flows
+		stripExecUpdate(updateDoc, admin)
 
-		_, err = client.Database("school").Collection("execs").UpdateOne(
+		coll := client.Database("school").Collection("execs")
+		before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+		_, err = coll.UpdateOne(
 			ctx,
 			bson.M{"_id": objID},
 			bson.M{"$set": updateDoc},
 		)
 		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, duplicateKeyError(err, "execs")
+			}
 			return nil, utils.ErrorHandler(err, "Error updating exec data")
 		}
+		recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bson.M{"_id": objID}))
 
 		updatedExec, err := mapModelExecToPbExec(*modelExec)
 		if err != nil {
 			return nil, utils.ErrorHandler(err, "Error mapping exec data")
 		}
 		updatedExec.Id = exec.Id
+		clearExecSecrets(updatedExec)
+		updatedExec.AccountStatus = ""
+		updatedExec.InactiveStatus = false
+		updatedExec.PasswordChangedAt = ""
+		updatedExec.UserCreatedAt = ""
+		if !admin {
+			updatedExec.Role = ""
+			updatedExec.TeacherId = ""
+		}
 
 		updatedExecs = append(updatedExecs, updatedExec)
 	}
@@ -180,23 +328,26 @@ func DeleteExecsDBHandler(ctx context.Context, execIdsToDelete []string) ([]stri
 	objectIds := make([]primitive.ObjectID, len(execIdsToDelete))
 	for i, id := range execIdsToDelete {
 		if id == "" {
-			return nil, utils.ErrorHandler(nil, "Exec ID is required for deletion")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonMissingID, "Exec ID is required for deletion")
 		}
 		objID, err := primitive.ObjectIDFromHex(id)
 		if err != nil {
-			return nil, utils.ErrorHandler(err, "Invalid exec ID format")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid exec ID format")
 		}
 		objectIds[i] = objID
 	}
 
 	filter := bson.M{"_id": bson.M{"$in": objectIds}}
-	result, err := client.Database("school").Collection("execs").DeleteMany(ctx, filter)
+	coll := client.Database("school").Collection("execs")
+	before := auditSnapshot(ctx, coll, filter)
+	result, err := coll.DeleteMany(ctx, filter)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error deleting execs from database")
 	}
+	recordAuditChanges(ctx, before, nil)
 
 	if result.DeletedCount == 0 {
-		return nil, utils.ErrorHandler(nil, "No execs found to delete")
+		return nil, utils.NotFoundError(utils.ReasonNotFound, "No execs found to delete")
 	}
 
 	return execIdsToDelete, nil
@@ -214,7 +365,7 @@ func LoginExecDBHandler(ctx context.Context, req *pb.ExecLoginRequest) (*models.
 	err = client.Database("school").Collection("execs").FindOne(ctx, filter).Decode(&exec)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, utils.ErrorHandler(err, "Exec not found")
+			return nil, utils.NotFoundError(utils.ReasonNotFound, "Exec not found")
 		}
 		return nil, utils.ErrorHandler(err, "Error fetching exec data")
 	}
@@ -231,7 +382,7 @@ func UpdatePasswordExecDBHandler(ctx context.Context, req *pb.UpdatePasswordRequ
 
 	objId, err := primitive.ObjectIDFromHex(req.GetId())
 	if err != nil {
-		return "", utils.ErrorHandler(err, "Invalid ID format")
+		return "", utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
 	}
 
 	filter := bson.M{"_id": objId}
@@ -239,18 +390,18 @@ func UpdatePasswordExecDBHandler(ctx context.Context, req *pb.UpdatePasswordRequ
 	err = client.Database("school").Collection("execs").FindOne(ctx, filter).Decode(&exec)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return "", utils.ErrorHandler(err, "Exec not found")
+			return "", utils.NotFoundError(utils.ReasonNotFound, "Exec not found")
 		}
 		return "", utils.ErrorHandler(err, "Error fetching exec data")
 	}
 
 	if exec.InactiveStatus {
-		return "", status.Error(codes.Unauthenticated, "Account is inactive")
+		return "", utils.PermissionDeniedError(utils.ReasonAccountInactive, "Account is inactive")
 	}
 
 	err = utils.VerifyPassword(req.GetCurrentPassword(), exec.Password)
 	if err != nil {
-		return "", utils.ErrorHandler(err, "Incorrect old password")
+		return "", utils.InvalidArgumentError("current_password", utils.ReasonIncorrectPassword, "Incorrect old password")
 	}
 
 	hashedNewPassword, err := utils.HashPassword(req.GetNewPassword())
@@ -265,10 +416,13 @@ func UpdatePasswordExecDBHandler(ctx context.Context, req *pb.UpdatePasswordRequ
 		},
 	}
 
-	_, err = client.Database("school").Collection("execs").UpdateOne(ctx, filter, update)
+	coll := client.Database("school").Collection("execs")
+	before := auditSnapshot(ctx, coll, filter)
+	_, err = coll.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return "", utils.ErrorHandler(err, "Error updating password")
 	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, filter))
 
 	token, err := utils.SignToken(exec.Id, exec.Username, exec.Role)
 	if err != nil {
@@ -288,21 +442,24 @@ func DeactivateUserDBHandler(ctx context.Context, execIdsToDeactivate []string)
 	var objectIds []primitive.ObjectID
 	for _, id := range execIdsToDeactivate {
 		if id == "" {
-			return nil, utils.ErrorHandler(nil, "Exec ID is required for deactivation")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonMissingID, "Exec ID is required for deactivation")
 		}
 		objID, err := primitive.ObjectIDFromHex(id)
 		if err != nil {
-			return nil, utils.ErrorHandler(err, "Invalid exec ID format")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid exec ID format")
 		}
 		objectIds = append(objectIds, objID)
 	}
 
 	filter := bson.M{"_id": bson.M{"$in": objectIds}}
 	update := bson.M{"$set": bson.M{"inactive_status": true}}
-	res, err := client.Database("school").Collection("execs").UpdateMany(ctx, filter, update)
+	coll := client.Database("school").Collection("execs")
+	before := auditSnapshot(ctx, coll, filter)
+	res, err := coll.UpdateMany(ctx, filter, update)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error deactivating execs")
 	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, filter))
 
 	return res, nil
 }
@@ -320,28 +477,23 @@ func ForgotPasswordExecDBHandler(ctx context.Context, email string) (string, err
 	err = client.Database("school").Collection("execs").FindOne(ctx, bson.M{"email": email}).Decode(&exec)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return "", utils.ErrorHandler(err, "Exec not found")
+			return "", utils.NotFoundError(utils.ReasonNotFound, "Exec not found")
 		}
 		return "", utils.ErrorHandler(err, "Error fetching exec data")
 	}
 
-	tokenBytes := make([]byte, 32)
+	audit.AddTarget(ctx, exec.Id)
 
-	_, err = rand.Read(tokenBytes)
-	if err != nil {
-		return "", utils.ErrorHandler(err, "Error generating reset token")
+	if err := checkNotPending(exec); err != nil {
+		return "", err
 	}
 
-	token := hex.EncodeToString(tokenBytes)
-	hashedToken := sha256.Sum256(tokenBytes)
-	hashedTokenString := hex.EncodeToString(hashedToken[:])
-
-	duration, err := strconv.Atoi(os.Getenv("RESET_TOKEN_EXP_DURATION"))
+	token, hashedTokenString, err := generateHashedToken()
 	if err != nil {
-		return "", utils.ErrorHandler(err, "Failed to send password reset email")
+		return "", utils.ErrorHandler(err, "Error generating reset token")
 	}
-	mins := time.Duration(duration)
-	expiry := time.Now().Add(mins * time.Minute).Format(time.RFC3339)
+
+	expiry := time.Now().Add(settings.Auth.ResetTokenTTL).Format(time.RFC3339)
 
 	update := bson.M{
 		"$set": bson.M{
@@ -349,34 +501,33 @@ func ForgotPasswordExecDBHandler(ctx context.Context, email string) (string, err
 			"password_token_expires": expiry,
 		},
 	}
-	_, err = client.Database("school").Collection("execs").UpdateOne(ctx, bson.M{"email": email}, update)
-	if err != nil {
-		return "", utils.ErrorHandler(err, "internal error")
-	}
 
 	resetUrl := fmt.Sprintf("https://localhost:50051/execs/resetpassword/reset/%s", token)
-	message := fmt.Sprintf("Forgot your password? Reset your passsword using the following link: \n%s\nPlease use the reset code:: %s along with your request to change password.\nIf you didn't request a password reset, please ignore this email.\nThis link is only valid for %v minutes.", resetUrl, token, mins)
-	// subject := "Your password reset link"
+	message := fmt.Sprintf("Forgot your password? Reset your passsword using the following link: \n%s\nPlease use the reset code:: %s along with your request to change password.\nIf you didn't request a password reset, please ignore this email.\nThis link is only valid for %v minutes.", resetUrl, token, settings.Auth.ResetTokenTTL.Minutes())
+	subject := "Your password reset link"
 
-	// m := mail.NewMessage()
-	// m.SetHeader("From", "schooladmin@school.com")
-	// m.SetHeader("To", email)
-	// m.SetHeader("Subject", subject)
-	// m.SetBody("text/plain", message)
-
-	// d := mail.NewDialer("localhost", 1025, "", "")
-	// err = d.DialAndSend(m)
-	if err != nil {
-		cleanup := bson.M{
-			"$set": bson.M{
-				"password_reset_token":   nil,
-				"password_token_expires": nil,
-			},
+	// The email is delivered by the outbox worker, so a slow mail server
+	// does not hold up this request.
+	err = runInTransaction(ctx, client, func(sessCtx mongo.SessionContext) error {
+		_, err := client.Database("school").Collection("execs").UpdateOne(sessCtx, bson.M{"email": email}, update)
+		if err != nil {
+			return err
 		}
-		_, _ = client.Database("school").Collection("execs").UpdateOne(ctx, bson.M{"email": email}, cleanup)
+		return enqueueNotification(sessCtx, client, "password-reset:"+hashedTokenString, email, subject, message)
+	})
+	if err != nil {
 		return "", utils.ErrorHandler(err, "Could not send password reset email. Please try again")
 	}
-	return message, nil
+	return fmt.Sprintf("Password reset link sent to %s", email), nil
+}
+
+// checkNotPending rejects password resets for accounts that were never
+// activated; they set their first password through ActivateAccount.
+func checkNotPending(exec models.Exec) error {
+	if exec.AccountStatus == AccountPending {
+		return utils.ConflictError(utils.ReasonAccountPending, "Account has not been activated. Use the activation link instead")
+	}
+	return nil
 }
 
 func ResetPasswordDBHandler(ctx context.Context, tokenInDb string, newPassword string) error {
@@ -395,7 +546,13 @@ func ResetPasswordDBHandler(ctx context.Context, tokenInDb string, newPassword s
 	}
 	err = client.Database("school").Collection("execs").FindOne(ctx, filter).Decode(&exec)
 	if err != nil {
-		return utils.ErrorHandler(err, "Invalid or expired token")
+		if err == mongo.ErrNoDocuments {
+			return utils.InvalidArgumentError("reset_code", utils.ReasonInvalidToken, "Invalid or expired token")
+		}
+		return utils.ErrorHandler(err, "Error fetching exec data")
+	}
+	if err := checkNotPending(exec); err != nil {
+		return err
 	}
 
 	hashedPassword, err := utils.HashPassword(newPassword)
@@ -411,9 +568,289 @@ func ResetPasswordDBHandler(ctx context.Context, tokenInDb string, newPassword s
 			"password_changed_at":    time.Now().Format(time.RFC3339),
 		},
 	}
-	_, err = client.Database("school").Collection("execs").UpdateOne(ctx, filter, update)
+	objID, err := primitive.ObjectIDFromHex(exec.Id)
+	if err != nil {
+		return utils.ErrorHandler(err, "internal error")
+	}
+	coll := client.Database("school").Collection("execs")
+	idFilter := bson.M{"_id": objID}
+	before := auditSnapshot(ctx, coll, idFilter)
+	_, err = coll.UpdateOne(ctx, filter, update)
 	if err != nil {
 		return utils.ErrorHandler(err, "Failed to update the password")
 	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, idFilter))
 	return nil
-}
\ No newline at end of file
+}
+func ActivateAccountDBHandler(ctx context.Context, tokenInDb string, newPassword string) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	var exec models.Exec
+	filter := bson.M{
+		"account_status":   AccountPending,
+		"activation_token": tokenInDb,
+		"activation_token_expires": bson.M{
+			"$gt": time.Now().Format(time.RFC3339),
+		},
+	}
+	err = client.Database("school").Collection("execs").FindOne(ctx, filter).Decode(&exec)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return utils.InvalidArgumentError("activation_code", utils.ReasonInvalidToken, "Invalid or expired activation code")
+		}
+		return utils.ErrorHandler(err, "Error fetching exec data")
+	}
+
+	hashedPassword, err := utils.HashPassword(newPassword)
+	if err != nil {
+		return utils.ErrorHandler(err, "internal error")
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"password":            hashedPassword,
+			"account_status":      AccountActive,
+			"password_changed_at": time.Now().Format(time.RFC3339),
+		},
+		"$unset": bson.M{
+			"activation_token":         "",
+			"activation_token_expires": "",
+		},
+	}
+	objID, err := primitive.ObjectIDFromHex(exec.Id)
+	if err != nil {
+		return utils.ErrorHandler(err, "internal error")
+	}
+	coll := client.Database("school").Collection("execs")
+	idFilter := bson.M{"_id": objID}
+	before := auditSnapshot(ctx, coll, idFilter)
+	_, err = coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return utils.ErrorHandler(err, "Failed to activate the account")
+	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, idFilter))
+	return nil
+}
+
+// ResendActivationDBHandler issues a fresh activation token to every pending
+// exec in the list, invalidating any invite sent earlier. Execs that are
+// already active are skipped.
+func ResendActivationDBHandler(ctx context.Context, execIds []string) ([]string, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("execs")
+
+	var resentIds []string
+	for _, id := range execIds {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid exec ID format")
+		}
+
+		filter := bson.M{"_id": objID, "account_status": AccountPending}
+		var exec models.Exec
+		err = coll.FindOne(ctx, filter).Decode(&exec)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				continue
+			}
+			return nil, utils.ErrorHandler(err, "Error fetching exec data")
+		}
+
+		token, hashedToken, expiry, err := newActivationToken()
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error generating activation token")
+		}
+		exec.ActivationToken = hashedToken
+		exec.ActivationTokenExpires = expiry
+
+		update := bson.M{
+			"$set": bson.M{
+				"activation_token":         hashedToken,
+				"activation_token_expires": expiry,
+			},
+		}
+		err = runInTransaction(ctx, client, func(sessCtx mongo.SessionContext) error {
+			_, err := coll.UpdateOne(sessCtx, filter, update)
+			if err != nil {
+				return err
+			}
+			return enqueueActivationEmail(sessCtx, client, &exec, token)
+		})
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error resending activation email")
+		}
+		audit.AddTarget(ctx, id)
+		resentIds = append(resentIds, id)
+	}
+	return resentIds, nil
+}
+
+// newActivationToken returns the plain token for the invite email together with
+// the hash and expiry to store.
+func newActivationToken() (string, string, string, error) {
+	token, hashedToken, err := generateHashedToken()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	expiry := time.Now().Add(settings.Auth.ActivationTokenTTL).Format(time.RFC3339)
+	return token, hashedToken, expiry, nil
+}
+
+func enqueueActivationEmail(ctx context.Context, client *mongo.Client, exec *models.Exec, token string) error {
+	activationUrl := fmt.Sprintf("https://localhost:50051/execs/activate/%s", token)
+	message := fmt.Sprintf("Welcome %s,\nAn account has been created for you with the username: %s\nActivate it and choose your password using the following link: \n%s\nPlease use the activation code:: %s along with your request to activate your account.\nThis link expires on %s.", exec.FirstName, exec.Username, activationUrl, token, exec.ActivationTokenExpires)
+	return enqueueNotification(ctx, client, "activation:"+exec.ActivationToken, exec.Email, "Activate your school portal account", message)
+}
+
+// RequestEmailChangeDBHandler stores newEmail as pending and queues a
+// verification link to it together with a notice to the current address.
+// The email itself only changes once ConfirmEmailChangeDBHandler succeeds.
+func RequestEmailChangeDBHandler(ctx context.Context, execId string, newEmail string) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	objID, err := primitive.ObjectIDFromHex(execId)
+	if err != nil {
+		return utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+	}
+
+	coll := client.Database("school").Collection("execs")
+
+	var exec models.Exec
+	err = coll.FindOne(ctx, bson.M{"_id": objID}).Decode(&exec)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return utils.NotFoundError(utils.ReasonNotFound, "Exec not found")
+		}
+		return utils.ErrorHandler(err, "Error fetching exec data")
+	}
+
+	if exec.Email == newEmail {
+		return utils.InvalidArgumentError("new_email", utils.ReasonEmailUnchanged, "New email is the same as the current email")
+	}
+
+	err = ensureEmailAvailable(ctx, coll, newEmail, objID)
+	if err != nil {
+		return err
+	}
+
+	expiry := time.Now().Add(settings.Auth.EmailChangeTokenTTL).Format(time.RFC3339)
+
+	token, hashedToken, err := generateHashedToken()
+	if err != nil {
+		return utils.ErrorHandler(err, "Error generating verification token")
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"pending_email":              newEmail,
+			"email_change_token":         hashedToken,
+			"email_change_token_expires": expiry,
+		},
+	}
+
+	verifyUrl := fmt.Sprintf("https://localhost:50051/execs/email/confirm/%s", token)
+	verifyMessage := fmt.Sprintf("Hi %s,\nPlease confirm this address for your school portal account using the following link: \n%s\nPlease use the verification code:: %s along with your request to confirm the change.\nThis link is only valid for %v minutes.", exec.FirstName, verifyUrl, token, settings.Auth.EmailChangeTokenTTL.Minutes())
+	noticeMessage := fmt.Sprintf("Hi %s,\nA request was made to change the email on your school portal account to %s.\nIf you didn't request this change, please contact an administrator.", exec.FirstName, newEmail)
+
+	before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+	err = runInTransaction(ctx, client, func(sessCtx mongo.SessionContext) error {
+		_, err := coll.UpdateOne(sessCtx, bson.M{"_id": objID}, update)
+		if err != nil {
+			return err
+		}
+		err = enqueueNotification(sessCtx, client, "email-change:"+hashedToken, newEmail, "Confirm your new email address", verifyMessage)
+		if err != nil {
+			return err
+		}
+		return enqueueNotification(sessCtx, client, "email-change-notice:"+hashedToken, exec.Email, "Email change requested", noticeMessage)
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, "Could not send verification email. Please try again")
+	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bson.M{"_id": objID}))
+	return nil
+}
+
+func ConfirmEmailChangeDBHandler(ctx context.Context, execId string, tokenInDb string) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "internal error")
+	}
+	defer client.Disconnect(ctx)
+
+	objID, err := primitive.ObjectIDFromHex(execId)
+	if err != nil {
+		return utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+	}
+
+	coll := client.Database("school").Collection("execs")
+
+	var exec models.Exec
+	filter := bson.M{
+		"_id":                objID,
+		"email_change_token": tokenInDb,
+		"email_change_token_expires": bson.M{
+			"$gt": time.Now().Format(time.RFC3339),
+		},
+	}
+	err = coll.FindOne(ctx, filter).Decode(&exec)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return utils.InvalidArgumentError("verification_code", utils.ReasonInvalidToken, "Invalid or expired token")
+		}
+		return utils.ErrorHandler(err, "Error fetching exec data")
+	}
+
+	// Someone may have claimed the address since the change was requested
+	err = ensureEmailAvailable(ctx, coll, exec.PendingEmail, objID)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"email": exec.PendingEmail,
+		},
+		"$unset": bson.M{
+			"pending_email":              "",
+			"email_change_token":         "",
+			"email_change_token_expires": "",
+		},
+	}
+	before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+	_, err = coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return duplicateKeyError(err, "execs")
+		}
+		return utils.ErrorHandler(err, "Failed to update the email")
+	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bson.M{"_id": objID}))
+	return nil
+}
+
+func ensureEmailAvailable(ctx context.Context, coll *mongo.Collection, email string, selfId primitive.ObjectID) error {
+	count, err := coll.CountDocuments(ctx, bson.M{"email": email, "_id": bson.M{"$ne": selfId}},
+		options.Count().SetCollation(&caseInsensitive))
+	if err != nil {
+		return utils.ErrorHandler(err, "Error checking email")
+	}
+	if count > 0 {
+		return utils.AlreadyExistsError("email", utils.ReasonAlreadyExists, "Email is already in use")
+	}
+	return nil
+}
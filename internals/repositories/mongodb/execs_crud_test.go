@@ -0,0 +1,109 @@
+package mongodb
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestExecSecretFields(t *testing.T) {
+	// Every secret field must be a stored exec field, be projected out of
+	// reads and be blanked in responses
+	modelType := reflect.TypeOf(models.Exec{})
+	stored := map[string]string{}
+	for i := 0; i < modelType.NumField(); i++ {
+		tag := strings.TrimSuffix(modelType.Field(i).Tag.Get("bson"), ",omitempty")
+		stored[tag] = modelType.Field(i).Name
+	}
+
+	projection := execSecretProjection()
+	for _, field := range execSecretFields {
+		t.Run(field, func(t *testing.T) {
+			name, ok := stored[field]
+			if !ok {
+				t.Fatalf("%s is not a field of models.Exec", field)
+			}
+			if projection[field] != 0 {
+				t.Errorf("%s is not projected out", field)
+			}
+
+			exec := &pb.Exec{}
+			reflect.ValueOf(exec).Elem().FieldByName(name).SetString("secret")
+			clearExecSecrets(exec)
+			if got := reflect.ValueOf(exec).Elem().FieldByName(name).String(); got != "" {
+				t.Errorf("clearExecSecrets left %s = %q", name, got)
+			}
+		})
+	}
+}
+
+func TestStripExecUpdate(t *testing.T) {
+	tests := []struct {
+		name  string
+		admin bool
+		want  []string
+	}{
+		{
+			name: "non-admin",
+			want: []string{"first_name", "password"},
+		},
+		{
+			name:  "admin",
+			admin: true,
+			want:  []string{"first_name", "password", "role", "teacher_id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updateDoc := bson.M{
+				"first_name":       "Ada",
+				"password":         "hashed",
+				"activation_token": "token",
+				"pending_email":    "new@example.com",
+				"account_status":   "active",
+				"role":             "admin",
+				"teacher_id":       "0123456789abcdef01234567",
+			}
+			stripExecUpdate(updateDoc, tt.admin)
+
+			got := make([]string, 0, len(updateDoc))
+			for field := range updateDoc {
+				got = append(got, field)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("stripExecUpdate() kept %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckNotPending(t *testing.T) {
+	tests := []struct {
+		status  string
+		wantErr bool
+	}{
+		{AccountPending, true},
+		{AccountActive, false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		err := checkNotPending(models.Exec{AccountStatus: tt.status})
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("checkNotPending(%q) = %v, wantErr %v", tt.status, err, tt.wantErr)
+		}
+		var domainErr *utils.Error
+		if err != nil && (!errors.As(err, &domainErr) || domainErr.Kind != utils.KindConflict || domainErr.Reason != utils.ReasonAccountPending) {
+			t.Errorf("checkNotPending(%q) = %#v, want a conflict with reason %s", tt.status, err, utils.ReasonAccountPending)
+		}
+	}
+}
@@ -0,0 +1,661 @@
+package mongodb
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EnsureGradeIndexesDBHandler indexes assessments by class, subject and term,
+// and allows one score per student and assessment.
+func EnsureGradeIndexesDBHandler(ctx context.Context) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+	_, err = db.Collection("assessments").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "class_id", Value: 1}, {Key: "subject_id", Value: 1}, {Key: "term", Value: 1}},
+		Options: options.Index().SetName("class_subject_term"),
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, "Error creating assessment indexes")
+	}
+	_, err = db.Collection("scores").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "assessment_id", Value: 1}, {Key: "student_id", Value: 1}},
+			Options: options.Index().SetName("unique_score").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "student_id", Value: 1}},
+			Options: options.Index().SetName("student_id"),
+		},
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, "Error creating score indexes")
+	}
+	return nil
+}
+
+func GetAssessmentsDBHandler(ctx context.Context, actor Actor, classId, subjectId, term string) ([]*pb.Assessment, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	filter := bson.M{}
+	if classId != "" {
+		err = checkClassAccess(ctx, client, actor, classId)
+		if err != nil {
+			return nil, err
+		}
+		filter["class_id"] = classId
+	} else if !actor.AnyClass {
+		classIds, err := actorClassIds(ctx, client, actor)
+		if err != nil {
+			return nil, err
+		}
+		filter["class_id"] = bson.M{"$in": classIds}
+	}
+	if subjectId != "" {
+		filter["subject_id"] = subjectId
+	}
+	if term != "" {
+		filter["term"] = term
+	}
+
+	assessments, err := findAssessments(ctx, client, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pbAssessments := make([]*pb.Assessment, 0, len(assessments))
+	for _, assessment := range assessments {
+		pbAssessments = append(pbAssessments, mapModelAssessmentToPb(assessment))
+	}
+	return pbAssessments, nil
+}
+
+func AddAssessmentsDBHandler(ctx context.Context, actor Actor, pbAssessments []*pb.Assessment) ([]*pb.Assessment, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("assessments")
+	addedAssessments := make([]*pb.Assessment, 0, len(pbAssessments))
+
+	for _, pbAssessment := range pbAssessments {
+		assessment := mapPbAssessmentToModel(pbAssessment)
+		assessment.CreatedBy = actor.Id
+
+		err = checkReference(ctx, client, "classes", "class_id", assessment.ClassId, "Class not found")
+		if err != nil {
+			return nil, err
+		}
+		err = checkReference(ctx, client, "subjects", "subject_id", assessment.SubjectId, "Subject not found")
+		if err != nil {
+			return nil, err
+		}
+		err = checkTeachingAccess(ctx, client, actor, assessment.ClassId, assessment.SubjectId, assessment.Term)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := coll.InsertOne(ctx, assessment)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error adding assessment to database")
+		}
+
+		objectId, ok := result.InsertedID.(primitive.ObjectID)
+		if ok {
+			assessment.Id = objectId.Hex()
+			recordAuditChanges(ctx, nil, auditSnapshot(ctx, coll, bson.M{"_id": objectId}))
+		}
+
+		addedAssessments = append(addedAssessments, mapModelAssessmentToPb(assessment))
+	}
+	return addedAssessments, nil
+}
+
+func UpdateAssessmentsDBHandler(ctx context.Context, actor Actor, pbAssessments []*pb.Assessment) ([]*pb.Assessment, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("assessments")
+	var updatedAssessments []*pb.Assessment
+
+	for _, pbAssessment := range pbAssessments {
+		if pbAssessment.Id == "" {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonMissingID, "Assessment ID is required for update")
+		}
+
+		current, err := findAssessment(ctx, client, pbAssessment.Id)
+		if err != nil {
+			return nil, err
+		}
+		err = checkTeachingAccess(ctx, client, actor, current.ClassId, current.SubjectId, current.Term)
+		if err != nil {
+			return nil, err
+		}
+
+		assessment := mapPbAssessmentToModel(pbAssessment)
+		if (assessment.ClassId != "" && assessment.ClassId != current.ClassId) ||
+			(assessment.SubjectId != "" && assessment.SubjectId != current.SubjectId) {
+			return nil, utils.InvalidArgumentError("class_id", utils.ReasonValidationFailed, "An assessment can't be moved to another class or subject")
+		}
+		if assessment.Term != "" && assessment.Term != current.Term {
+			err = checkTeachingAccess(ctx, client, actor, current.ClassId, current.SubjectId, assessment.Term)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if assessment.MaxScore != 0 && assessment.MaxScore < current.MaxScore {
+			err = checkScoresWithin(ctx, client, current.Id, assessment.MaxScore)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		objID, err := primitive.ObjectIDFromHex(current.Id)
+		if err != nil {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+		}
+
+		modelDoc, err := bson.Marshal(assessment)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error preparing assessment data for update")
+		}
+
+		var updateDoc bson.M
+		if err := bson.Unmarshal(modelDoc, &updateDoc); err != nil {
+			return nil, utils.ErrorHandler(err, "Error preparing assessment data for update")
+		}
+
+		delete(updateDoc, "_id")
+
+		before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+		var updated models.Assessment
+		err = coll.FindOneAndUpdate(ctx, bson.M{"_id": objID}, bson.M{"$set": updateDoc},
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&updated)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error updating assessment data")
+		}
+		recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bson.M{"_id": objID}))
+
+		updatedAssessments = append(updatedAssessments, mapModelAssessmentToPb(updated))
+	}
+	return updatedAssessments, nil
+}
+
+// DeleteAssessmentsDBHandler deletes the assessments and their scores in one
+// transaction.
+func DeleteAssessmentsDBHandler(ctx context.Context, actor Actor, assessmentIdsToDelete []string) ([]string, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	objectIds := make([]primitive.ObjectID, 0, len(assessmentIdsToDelete))
+	for _, id := range assessmentIdsToDelete {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid assessment ID format")
+		}
+		objectIds = append(objectIds, objID)
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": objectIds}}
+	assessments, err := findAssessments(ctx, client, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(assessments) == 0 {
+		return nil, utils.NotFoundError(utils.ReasonNotFound, "No assessments found to delete")
+	}
+
+	deletedIds := make([]string, 0, len(assessments))
+	for _, assessment := range assessments {
+		err = checkTeachingAccess(ctx, client, actor, assessment.ClassId, assessment.SubjectId, assessment.Term)
+		if err != nil {
+			return nil, err
+		}
+		deletedIds = append(deletedIds, assessment.Id)
+	}
+
+	db := client.Database("school")
+	scoresFilter := bson.M{"assessment_id": bson.M{"$in": deletedIds}}
+	assessmentsBefore := auditSnapshot(ctx, db.Collection("assessments"), filter)
+	scoresBefore := auditSnapshot(ctx, db.Collection("scores"), scoresFilter)
+
+	err = runInTransaction(ctx, client, func(sessCtx mongo.SessionContext) error {
+		if _, err := db.Collection("scores").DeleteMany(sessCtx, scoresFilter); err != nil {
+			return err
+		}
+		_, err := db.Collection("assessments").DeleteMany(sessCtx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error deleting assessments from database")
+	}
+	recordAuditChanges(ctx, scoresBefore, nil)
+	recordAuditChanges(ctx, assessmentsBefore, nil)
+
+	return deletedIds, nil
+}
+
+// RecordScoresDBHandler records or replaces the scores of several students
+// for one assessment in one transaction. Every student must belong to the
+// assessment's class.
+func RecordScoresDBHandler(ctx context.Context, actor Actor, assessmentId string, entries []*pb.ScoreEntry) ([]*pb.Score, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	assessment, err := findAssessment(ctx, client, assessmentId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkTeachingAccess(ctx, client, actor, assessment.ClassId, assessment.SubjectId, assessment.Term)
+	if err != nil {
+		return nil, err
+	}
+
+	studentIds := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Score > assessment.MaxScore {
+			return nil, utils.InvalidArgumentError("score", utils.ReasonValidationFailed, "Score can't be higher than the assessment's max score")
+		}
+		studentIds = append(studentIds, entry.StudentId)
+	}
+	err = checkClassStudents(ctx, client, assessment.ClassId, studentIds, "scores")
+	if err != nil {
+		return nil, err
+	}
+
+	coll := client.Database("school").Collection("scores")
+	scoresFilter := bson.M{"assessment_id": assessment.Id, "student_id": bson.M{"$in": studentIds}}
+	before := auditSnapshot(ctx, coll, scoresFilter)
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	err = runInTransaction(ctx, client, func(sessCtx mongo.SessionContext) error {
+		for _, entry := range entries {
+			_, err := coll.UpdateOne(sessCtx,
+				bson.M{"assessment_id": assessment.Id, "student_id": entry.StudentId},
+				bson.M{"$set": bson.M{
+					"score":       entry.Score,
+					"excused":     entry.Excused,
+					"recorded_by": actor.Id,
+					"recorded_at": now,
+				}},
+				options.Update().SetUpsert(true))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error recording scores")
+	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, scoresFilter))
+
+	scores, err := findScores(ctx, client, scoresFilter)
+	if err != nil {
+		return nil, err
+	}
+	return mapModelScoresToPb(scores), nil
+}
+
+func GetScoresDBHandler(ctx context.Context, actor Actor, assessmentId string) ([]*pb.Score, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	assessment, err := findAssessment(ctx, client, assessmentId)
+	if err != nil {
+		return nil, err
+	}
+	err = checkClassAccess(ctx, client, actor, assessment.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := findScores(ctx, client, bson.M{"assessment_id": assessment.Id})
+	if err != nil {
+		return nil, err
+	}
+	return mapModelScoresToPb(scores), nil
+}
+
+// GetStudentGradesDBHandler returns the student's grade for every class,
+// subject and term they have scores in, optionally limited to one term.
+// Accounts limited to their teacher's classes only see grades of those
+// classes.
+func GetStudentGradesDBHandler(ctx context.Context, actor Actor, studentId, term string) ([]*pb.Grade, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	scores, err := findScores(ctx, client, bson.M{"student_id": studentId})
+	if err != nil {
+		return nil, err
+	}
+
+	assessmentIds := make([]primitive.ObjectID, 0, len(scores))
+	for _, score := range scores {
+		objID, err := primitive.ObjectIDFromHex(score.AssessmentId)
+		if err != nil {
+			continue
+		}
+		assessmentIds = append(assessmentIds, objID)
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": assessmentIds}}
+	if term != "" {
+		filter["term"] = term
+	}
+	if !actor.AnyClass {
+		classIds, err := actorClassIds(ctx, client, actor)
+		if err != nil {
+			return nil, err
+		}
+		filter["class_id"] = bson.M{"$in": classIds}
+	}
+
+	assessments, err := findAssessments(ctx, client, filter)
+	if err != nil {
+		return nil, err
+	}
+	return computeGrades(assessments, scores), nil
+}
+
+// GetClassGradesDBHandler returns the grade of every student with scores in
+// classId, optionally limited to one subject and term.
+func GetClassGradesDBHandler(ctx context.Context, actor Actor, classId, subjectId, term string) ([]*pb.Grade, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	err = checkClassAccess(ctx, client, actor, classId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"class_id": classId}
+	if subjectId != "" {
+		filter["subject_id"] = subjectId
+	}
+	if term != "" {
+		filter["term"] = term
+	}
+	assessments, err := findAssessments(ctx, client, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	assessmentIds := make([]string, 0, len(assessments))
+	for _, assessment := range assessments {
+		assessmentIds = append(assessmentIds, assessment.Id)
+	}
+	scores, err := findScores(ctx, client, bson.M{"assessment_id": bson.M{"$in": assessmentIds}})
+	if err != nil {
+		return nil, err
+	}
+	return computeGrades(assessments, scores), nil
+}
+
+// GradingScale returns the configured grading scale, highest grade first.
+func GradingScale() []*pb.GradeBoundary {
+	boundaries := make([]*pb.GradeBoundary, 0, len(settings.Grades.Scale))
+	for _, boundary := range settings.Grades.Scale {
+		boundaries = append(boundaries, &pb.GradeBoundary{Letter: boundary.Letter, MinPercentage: float32(boundary.Min)})
+	}
+	return boundaries
+}
+
+// checkTeachingAccess fails with PermissionDenied unless actor may change the
+// assessments and scores of subjectId in classId during term, which takes a
+// teaching assignment for all three.
+func checkTeachingAccess(ctx context.Context, client *mongo.Client, actor Actor, classId, subjectId, term string) error {
+	if actor.AnyClass {
+		return nil
+	}
+	teacher, err := actorTeacher(ctx, client, actor)
+	if err != nil {
+		return err
+	}
+
+	count, err := client.Database("school").Collection("teaching_assignments").CountDocuments(ctx,
+		teachingAccessFilter(teacher.Id, classId, subjectId, term), options.Count().SetLimit(1))
+	if err != nil {
+		return utils.ErrorHandler(err, "Error fetching teacher assignments")
+	}
+	if count == 0 {
+		return utils.PermissionDeniedError(utils.ReasonPermissionDenied, "Only teachers assigned to the class and subject for the term can change its grades")
+	}
+	return nil
+}
+
+// teachingAccessFilter matches the teaching assignment that lets teacherId
+// change grades of subjectId in classId during term. Assignments are per
+// term, so one term's assignment grants nothing in another. Without a term
+// only an assignment without one matches; the field is omitted when empty.
+func teachingAccessFilter(teacherId, classId, subjectId, term string) bson.M {
+	filter := bson.M{"teacher_id": teacherId, "class_id": classId, "subject_id": subjectId, "term": term}
+	if term == "" {
+		filter["term"] = nil
+	}
+	return filter
+}
+
+// checkScoresWithin returns a FailedPrecondition error if a score recorded for
+// assessmentId is higher than maxScore.
+func checkScoresWithin(ctx context.Context, client *mongo.Client, assessmentId string, maxScore float32) error {
+	count, err := client.Database("school").Collection("scores").CountDocuments(ctx,
+		bson.M{"assessment_id": assessmentId, "score": bson.M{"$gt": maxScore}}, options.Count().SetLimit(1))
+	if err != nil {
+		return utils.ErrorHandler(err, "Error checking scores")
+	}
+	if count > 0 {
+		return utils.ConflictError(utils.ReasonValidationFailed, "Recorded scores are higher than the new max score")
+	}
+	return nil
+}
+
+func findAssessment(ctx context.Context, client *mongo.Client, id string) (models.Assessment, error) {
+	var assessment models.Assessment
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return assessment, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid assessment ID format")
+	}
+
+	err = client.Database("school").Collection("assessments").FindOne(ctx, bson.M{"_id": objID}).Decode(&assessment)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return assessment, utils.NotFoundError(utils.ReasonNotFound, "Assessment not found")
+		}
+		return assessment, utils.ErrorHandler(err, "Error fetching assessment data")
+	}
+	return assessment, nil
+}
+
+func findAssessments(ctx context.Context, client *mongo.Client, filter bson.M) ([]models.Assessment, error) {
+	cursor, err := client.Database("school").Collection("assessments").Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "title", Value: 1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	defer cursor.Close(ctx)
+
+	var assessments []models.Assessment
+	if err := cursor.All(ctx, &assessments); err != nil {
+		return nil, utils.ErrorHandler(err, "Error decoding assessment data")
+	}
+	return assessments, nil
+}
+
+func findScores(ctx context.Context, client *mongo.Client, filter bson.M) ([]models.Score, error) {
+	cursor, err := client.Database("school").Collection("scores").Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "student_id", Value: 1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	defer cursor.Close(ctx)
+
+	var scores []models.Score
+	if err := cursor.All(ctx, &scores); err != nil {
+		return nil, utils.ErrorHandler(err, "Error decoding score data")
+	}
+	return scores, nil
+}
+
+// computeGrades averages each student's scores per class, subject and term,
+// weighting each score as a fraction of its assessment's max score by the
+// assessment's weight. Excused scores, and scores of assessments not in
+// assessments, are left out.
+func computeGrades(assessments []models.Assessment, scores []models.Score) []*pb.Grade {
+	byId := make(map[string]models.Assessment, len(assessments))
+	for _, assessment := range assessments {
+		byId[assessment.Id] = assessment
+	}
+
+	type gradeKey struct{ studentId, classId, subjectId, term string }
+	type gradeSums struct {
+		weighted, weights float64
+		graded            int32
+	}
+	sums := map[gradeKey]*gradeSums{}
+	for _, score := range scores {
+		assessment, ok := byId[score.AssessmentId]
+		if !ok || score.Excused || assessment.MaxScore <= 0 || assessment.Weight <= 0 {
+			continue
+		}
+		key := gradeKey{score.StudentId, assessment.ClassId, assessment.SubjectId, assessment.Term}
+		if sums[key] == nil {
+			sums[key] = &gradeSums{}
+		}
+		weight := float64(assessment.Weight)
+		sums[key].weighted += weight * float64(score.Score) / float64(assessment.MaxScore)
+		sums[key].weights += weight
+		sums[key].graded++
+	}
+
+	grades := make([]*pb.Grade, 0, len(sums))
+	for key, sum := range sums {
+		percentage := math.Round(sum.weighted/sum.weights*100*100) / 100
+		grades = append(grades, &pb.Grade{
+			StudentId:         key.studentId,
+			ClassId:           key.classId,
+			SubjectId:         key.subjectId,
+			Term:              key.term,
+			Percentage:        float32(percentage),
+			Letter:            letterGrade(percentage),
+			GradedAssessments: sum.graded,
+		})
+	}
+	sort.Slice(grades, func(i, j int) bool {
+		a, b := grades[i], grades[j]
+		if a.StudentId != b.StudentId {
+			return a.StudentId < b.StudentId
+		}
+		if a.ClassId != b.ClassId {
+			return a.ClassId < b.ClassId
+		}
+		if a.SubjectId != b.SubjectId {
+			return a.SubjectId < b.SubjectId
+		}
+		return a.Term < b.Term
+	})
+	return grades
+}
+
+// letterGrade looks percentage up in the configured grading scale.
+func letterGrade(percentage float64) string {
+	for _, boundary := range settings.Grades.Scale {
+		if percentage >= boundary.Min {
+			return boundary.Letter
+		}
+	}
+	return ""
+}
+
+// assessmentTypeName is the lower case type stored in the database, e.g.
+// "quiz".
+func assessmentTypeName(assessmentType pb.AssessmentType) string {
+	if assessmentType == pb.AssessmentType_ASSESSMENT_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(assessmentType.String())
+}
+
+func mapPbAssessmentToModel(assessment *pb.Assessment) models.Assessment {
+	return models.Assessment{
+		Id:        assessment.Id,
+		ClassId:   assessment.ClassId,
+		SubjectId: assessment.SubjectId,
+		Term:      assessment.Term,
+		Title:     assessment.Title,
+		Type:      assessmentTypeName(assessment.Type),
+		MaxScore:  assessment.MaxScore,
+		Weight:    assessment.Weight,
+		Date:      assessment.Date,
+	}
+}
+
+func mapModelAssessmentToPb(assessment models.Assessment) *pb.Assessment {
+	return &pb.Assessment{
+		Id:        assessment.Id,
+		ClassId:   assessment.ClassId,
+		SubjectId: assessment.SubjectId,
+		Term:      assessment.Term,
+		Title:     assessment.Title,
+		Type:      pb.AssessmentType(pb.AssessmentType_value[strings.ToUpper(assessment.Type)]),
+		MaxScore:  assessment.MaxScore,
+		Weight:    assessment.Weight,
+		Date:      assessment.Date,
+		CreatedBy: assessment.CreatedBy,
+	}
+}
+
+func mapModelScoresToPb(scores []models.Score) []*pb.Score {
+	pbScores := make([]*pb.Score, 0, len(scores))
+	for _, score := range scores {
+		pbScores = append(pbScores, &pb.Score{
+			Id:           score.Id,
+			AssessmentId: score.AssessmentId,
+			StudentId:    score.StudentId,
+			Score:        score.Score,
+			Excused:      score.Excused,
+			RecordedBy:   score.RecordedBy,
+			RecordedAt:   score.RecordedAt,
+		})
+	}
+	return pbScores
+}
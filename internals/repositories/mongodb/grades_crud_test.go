@@ -0,0 +1,184 @@
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLetterGrade(t *testing.T) {
+	saved := settings
+	defer func() { settings = saved }()
+	settings = config.Default()
+
+	tests := []struct {
+		percentage float64
+		want       string
+	}{
+		{100, "A"},
+		{90, "A"},
+		{89.99, "B"},
+		{80, "B"},
+		{75, "C"},
+		{60, "D"},
+		{59.99, "F"},
+		{0, "F"},
+		{-1, ""},
+	}
+
+	for _, tt := range tests {
+		if got := letterGrade(tt.percentage); got != tt.want {
+			t.Errorf("letterGrade(%v) = %q, want %q", tt.percentage, got, tt.want)
+		}
+	}
+
+	settings.Grades.Scale = []config.GradeBoundary{{Letter: "P", Min: 50}, {Letter: "U", Min: 0}}
+	if got := letterGrade(50); got != "P" {
+		t.Errorf("letterGrade(50) with a pass/fail scale = %q, want %q", got, "P")
+	}
+}
+
+func TestComputeGrades(t *testing.T) {
+	saved := settings
+	defer func() { settings = saved }()
+	settings = config.Default()
+
+	assessments := []models.Assessment{
+		{Id: "quiz", ClassId: "c1", SubjectId: "math", Term: "t1", MaxScore: 10, Weight: 1},
+		{Id: "exam", ClassId: "c1", SubjectId: "math", Term: "t1", MaxScore: 100, Weight: 3},
+		{Id: "essay", ClassId: "c1", SubjectId: "english", Term: "t1", MaxScore: 20, Weight: 1},
+		{Id: "spring", ClassId: "c1", SubjectId: "math", Term: "t2", MaxScore: 50, Weight: 1},
+		{Id: "unweighted", ClassId: "c1", SubjectId: "math", Term: "t1", MaxScore: 10, Weight: 0},
+		{Id: "no-max", ClassId: "c1", SubjectId: "math", Term: "t1", MaxScore: 0, Weight: 1},
+	}
+
+	tests := []struct {
+		name   string
+		scores []models.Score
+		want   []*pb.Grade
+	}{
+		{
+			name:   "no scores",
+			scores: nil,
+			want:   []*pb.Grade{},
+		},
+		{
+			name: "weighted average",
+			scores: []models.Score{
+				{AssessmentId: "quiz", StudentId: "s1", Score: 6},
+				{AssessmentId: "exam", StudentId: "s1", Score: 90},
+			},
+			// (1*0.6 + 3*0.9) / 4 = 82.5%
+			want: []*pb.Grade{
+				{StudentId: "s1", ClassId: "c1", SubjectId: "math", Term: "t1", Percentage: 82.5, Letter: "B", GradedAssessments: 2},
+			},
+		},
+		{
+			name: "excused and unusable assessments are skipped",
+			scores: []models.Score{
+				{AssessmentId: "quiz", StudentId: "s1", Score: 9},
+				{AssessmentId: "exam", StudentId: "s1", Score: 0, Excused: true},
+				{AssessmentId: "unweighted", StudentId: "s1", Score: 0},
+				{AssessmentId: "no-max", StudentId: "s1", Score: 0},
+				{AssessmentId: "deleted", StudentId: "s1", Score: 0},
+			},
+			want: []*pb.Grade{
+				{StudentId: "s1", ClassId: "c1", SubjectId: "math", Term: "t1", Percentage: 90, Letter: "A", GradedAssessments: 1},
+			},
+		},
+		{
+			name: "only excused scores",
+			scores: []models.Score{
+				{AssessmentId: "quiz", StudentId: "s1", Score: 0, Excused: true},
+			},
+			want: []*pb.Grade{},
+		},
+		{
+			name: "grouped by student, subject and term",
+			scores: []models.Score{
+				{AssessmentId: "spring", StudentId: "s2", Score: 25},
+				{AssessmentId: "essay", StudentId: "s1", Score: 11},
+				{AssessmentId: "quiz", StudentId: "s2", Score: 7},
+				{AssessmentId: "quiz", StudentId: "s1", Score: 10},
+			},
+			want: []*pb.Grade{
+				{StudentId: "s1", ClassId: "c1", SubjectId: "english", Term: "t1", Percentage: 55, Letter: "F", GradedAssessments: 1},
+				{StudentId: "s1", ClassId: "c1", SubjectId: "math", Term: "t1", Percentage: 100, Letter: "A", GradedAssessments: 1},
+				{StudentId: "s2", ClassId: "c1", SubjectId: "math", Term: "t1", Percentage: 70, Letter: "C", GradedAssessments: 1},
+				{StudentId: "s2", ClassId: "c1", SubjectId: "math", Term: "t2", Percentage: 50, Letter: "F", GradedAssessments: 1},
+			},
+		},
+		{
+			name: "rounded to two decimals",
+			scores: []models.Score{
+				{AssessmentId: "quiz", StudentId: "s1", Score: 2},
+				{AssessmentId: "essay", StudentId: "s1", Score: 0},
+				{AssessmentId: "exam", StudentId: "s1", Score: 66.666},
+			},
+			// math: (1*0.2 + 3*0.66666) / 4 = 54.9995% rounds to 55
+			want: []*pb.Grade{
+				{StudentId: "s1", ClassId: "c1", SubjectId: "english", Term: "t1", Percentage: 0, Letter: "F", GradedAssessments: 1},
+				{StudentId: "s1", ClassId: "c1", SubjectId: "math", Term: "t1", Percentage: 55, Letter: "F", GradedAssessments: 2},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := computeGrades(assessments, tt.scores)
+			if len(got) != len(tt.want) {
+				t.Fatalf("computeGrades() returned %d grades, want %d: %v", len(got), len(tt.want), got)
+			}
+			for i := range got {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("computeGrades()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTeachingAccessFilter(t *testing.T) {
+	termly := bson.M{"teacher_id": "t1", "class_id": "c1", "subject_id": "math", "term": "2025-2026 T1"}
+	termless := bson.M{"teacher_id": "t1", "class_id": "c1", "subject_id": "math"}
+
+	// matches mimics MongoDB equality, where null also matches a missing field
+	matches := func(assignment, filter bson.M) bool {
+		for field, value := range filter {
+			if assignment[field] != value {
+				return false
+			}
+		}
+		return true
+	}
+
+	tests := []struct {
+		name                                string
+		assignment                          bson.M
+		teacherId, classId, subjectId, term string
+		want                                bool
+	}{
+		{"assigned class, subject and term", termly, "t1", "c1", "math", "2025-2026 T1", true},
+		{"another term", termly, "t1", "c1", "math", "2025-2026 T2", false},
+		{"no term", termly, "t1", "c1", "math", "", false},
+		{"another subject", termly, "t1", "c1", "english", "2025-2026 T1", false},
+		{"another class", termly, "t1", "c2", "math", "2025-2026 T1", false},
+		{"another teacher", termly, "t2", "c1", "math", "2025-2026 T1", false},
+		{"termless assignment, no term", termless, "t1", "c1", "math", "", true},
+		{"termless assignment, a term", termless, "t1", "c1", "math", "2025-2026 T1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := teachingAccessFilter(tt.teacherId, tt.classId, tt.subjectId, tt.term)
+			if got := matches(tt.assignment, filter); got != tt.want {
+				t.Errorf("teachingAccessFilter(%q, %q, %q, %q) matches %v = %v, want %v",
+					tt.teacherId, tt.classId, tt.subjectId, tt.term, tt.assignment, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,12 +2,18 @@ package mongodb
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"reflect"
 
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/audit"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
 	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -82,6 +88,14 @@ func mapModelExecToPbExec(execModel models.Exec) (*pb.Exec, error) {
 	return mapModelToPb(execModel, func() *pb.Exec { return &pb.Exec{} })
 }
 
+func mapModelClassToPbClass(classModel models.Class) (*pb.Class, error) {
+	return mapModelToPb(classModel, func() *pb.Class { return &pb.Class{} })
+}
+
+func mapModelSubjectToPbSubject(subjectModel models.Subject) (*pb.Subject, error) {
+	return mapModelToPb(subjectModel, func() *pb.Subject { return &pb.Subject{} })
+}
+
 func mapPbToModel[P any, M any](pbStruct P, newModel func() *M) (*M, error) {
 
 	modelStruct := newModel()
@@ -113,6 +127,14 @@ func mapPbExecToModelExec(pbExec *pb.Exec) (*models.Exec, error) {
 	return mapPbToModel(pbExec, func() *models.Exec { return &models.Exec{} })
 }
 
+func mapPbClassToModelClass(pbClass *pb.Class) (*models.Class, error) {
+	return mapPbToModel(pbClass, func() *models.Class { return &models.Class{} })
+}
+
+func mapPbSubjectToModelSubject(pbSubject *pb.Subject) (*models.Subject, error) {
+	return mapPbToModel(pbSubject, func() *models.Subject { return &models.Subject{} })
+}
+
 
 func DecodeEntities[T any, M any](ctx context.Context, cursor *mongo.Cursor, newEntity func() *T, newModel func() *M) ([]*T, error) {
 	var entities []*T
@@ -144,3 +166,79 @@ func DecodeEntities[T any, M any](ctx context.Context, cursor *mongo.Cursor, new
 	}
 	return entities, nil
 }
+
+// runInTransaction executes fn inside a MongoDB transaction so that several
+// writes (e.g. a state change and its outbox message) commit or abort together.
+func runInTransaction(ctx context.Context, client *mongo.Client, fn func(sessCtx mongo.SessionContext) error) error {
+	session, err := client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	return err
+}
+
+// generateHashedToken returns a random hex token to hand to the user and its
+// SHA-256 hash to store, so a leaked database does not leak usable tokens.
+func generateHashedToken() (string, string, error) {
+	tokenBytes := make([]byte, 32)
+
+	_, err := rand.Read(tokenBytes)
+	if err != nil {
+		return "", "", err
+	}
+
+	token := hex.EncodeToString(tokenBytes)
+	hashedToken := sha256.Sum256(tokenBytes)
+	return token, hex.EncodeToString(hashedToken[:]), nil
+}
+
+// auditSnapshot loads the documents matching filter, keyed by hex ID, so that
+// writes can report before/after state to the audit log. It does nothing when
+// the current call is not being audited.
+func auditSnapshot(ctx context.Context, coll *mongo.Collection, filter interface{}) map[string]bson.M {
+	if audit.FromContext(ctx) == nil {
+		return nil
+	}
+
+	cursor, err := coll.Find(ctx, filter)
+	if err != nil {
+		utils.ErrorHandler(err, "Error loading audit snapshot")
+		return nil
+	}
+	defer cursor.Close(ctx)
+
+	docs := map[string]bson.M{}
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			utils.ErrorHandler(err, "Error loading audit snapshot")
+			return nil
+		}
+		if id, ok := doc["_id"].(primitive.ObjectID); ok {
+			docs[id.Hex()] = doc
+		}
+	}
+	return docs
+}
+
+// recordAuditChanges hands the difference between two snapshots to the audit
+// hook. IDs missing from after were deleted; IDs missing from before were created.
+func recordAuditChanges(ctx context.Context, before, after map[string]bson.M) {
+	if audit.FromContext(ctx) == nil {
+		return
+	}
+
+	for id, doc := range before {
+		audit.RecordChange(ctx, id, doc, after[id])
+	}
+	for id, doc := range after {
+		if _, ok := before[id]; !ok {
+			audit.RecordChange(ctx, id, nil, doc)
+		}
+	}
+}
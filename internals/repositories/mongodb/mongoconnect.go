@@ -2,16 +2,27 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+	"sync"
 
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/metrics"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/tracing"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// settings holds the configuration used by the repository layer. It is set once
+// by Configure before the server starts.
+var settings = config.Default()
+
+func Configure(cfg *config.Config) {
+	settings = *cfg
+}
+
 func CreateMongoClient(ctx context.Context) (*mongo.Client, error) {
-	// client, err := mongo.Connect(ctx, options.Client().ApplyURI("username:password@mongodb://localhost:27017"))
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI("mongodb://localhost:27017"))
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(settings.Mongo.URI).SetMonitor(commandMonitor()))
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error connecting to MongoDB")
 	}
@@ -21,6 +32,72 @@ func CreateMongoClient(ctx context.Context) (*mongo.Client, error) {
 		return nil, utils.ErrorHandler(err, "Error pinging MongoDB")
 	}
 
-	fmt.Println("Connected to MongoDB!")
+	utils.Logger.Debug("connected to MongoDB")
 	return client, nil
 }
+
+// shared is a client kept open for calls made on every request, such as rate
+// limit checks, where connecting each time would cost more than the call.
+var shared struct {
+	sync.Mutex
+	client *mongo.Client
+}
+
+// sharedClient returns the shared client, connecting on first use. A failed
+// connection is retried by the next call.
+func sharedClient(ctx context.Context) (*mongo.Client, error) {
+	shared.Lock()
+	defer shared.Unlock()
+
+	if shared.client != nil {
+		return shared.client, nil
+	}
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	shared.client = client
+	return client, nil
+}
+
+// DisconnectSharedClient closes the shared client, if it was ever opened.
+func DisconnectSharedClient(ctx context.Context) error {
+	shared.Lock()
+	defer shared.Unlock()
+
+	if shared.client == nil {
+		return nil
+	}
+	err := shared.client.Disconnect(ctx)
+	shared.client = nil
+	return err
+}
+
+// commandMonitor feeds driver command events to both the metrics and the
+// tracing monitors, since a client accepts only one.
+func commandMonitor() *event.CommandMonitor {
+	monitors := []*event.CommandMonitor{metrics.NewMongoMonitor(), tracing.NewMongoMonitor()}
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			for _, m := range monitors {
+				if m.Started != nil {
+					m.Started(ctx, e)
+				}
+			}
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			for _, m := range monitors {
+				if m.Succeeded != nil {
+					m.Succeeded(ctx, e)
+				}
+			}
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			for _, m := range monitors {
+				if m.Failed != nil {
+					m.Failed(ctx, e)
+				}
+			}
+		},
+	}
+}
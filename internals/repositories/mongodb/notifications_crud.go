@@ -0,0 +1,290 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Outbox statuses. A message moves pending -> processing -> sent, or back to
+// pending with a later next_attempt_at when delivery fails. Once it runs out of
+// attempts it is parked as dead until an admin retries it.
+//
+// next_attempt_at and locked_until are BSON dates so the claim query compares
+// instants rather than strings; the other timestamps are UTC RFC 3339 strings
+// like elsewhere.
+const (
+	notificationPending    = "pending"
+	notificationProcessing = "processing"
+	notificationSent       = "sent"
+	notificationDead       = "dead"
+)
+
+// notificationLockDuration is how long a claimed message stays invisible to
+// other workers. A worker that crashes mid-delivery releases it implicitly.
+const notificationLockDuration = 5 * time.Minute
+
+// enqueueNotification writes a message to the outbox. It is meant to be called
+// inside the same transaction as the state change that triggered it, so the
+// message exists if and only if the change was committed.
+func enqueueNotification(ctx context.Context, client *mongo.Client, idempotencyKey, recipient, subject, body string) error {
+	now := time.Now().UTC()
+	notification := models.Notification{
+		IdempotencyKey: idempotencyKey,
+		Recipient:      recipient,
+		Subject:        subject,
+		Body:           body,
+		Status:         notificationPending,
+		Attempts:       0,
+		NextAttemptAt:  now,
+		CreatedAt:      now.Format(time.RFC3339),
+	}
+
+	_, err := client.Database("school").Collection("notifications").InsertOne(ctx, notification)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			// Same key means the same logical message was already queued.
+			return nil
+		}
+		return utils.ErrorHandler(err, "Error queueing notification")
+	}
+	return nil
+}
+
+func EnsureNotificationIndexesDBHandler(ctx context.Context) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "idempotency_key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+		},
+	}
+	coll := client.Database("school").Collection("notifications")
+	_, err = coll.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error creating notification indexes")
+	}
+
+	// Messages queued before the schedule was stored as dates still hold
+	// RFC 3339 strings, which the claim query would never match
+	for _, field := range []string{"next_attempt_at", "locked_until"} {
+		_, err = coll.UpdateMany(ctx, bson.M{field: bson.M{"$type": "string"}}, mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{field: bson.M{"$toDate": "$" + field}}}},
+		})
+		if err != nil {
+			return utils.ErrorHandler(err, "Error converting notification schedule")
+		}
+	}
+	return nil
+}
+
+// ClaimNotificationsDBHandler atomically locks up to limit messages that are due
+// for delivery, including ones whose previous claim has expired. Every claim
+// counts as an attempt, so a message whose worker keeps crashing before it can
+// record the outcome still runs out of attempts.
+func ClaimNotificationsDBHandler(ctx context.Context, limit int) ([]*models.Notification, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("notifications")
+	now := time.Now().UTC()
+
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"status": notificationPending, "next_attempt_at": bson.M{"$lte": now}},
+			bson.M{"status": notificationProcessing, "locked_until": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":       notificationProcessing,
+			"locked_until": now.Add(notificationLockDuration),
+		},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var claimed []*models.Notification
+	for len(claimed) < limit {
+		var notification models.Notification
+		err := coll.FindOneAndUpdate(ctx, filter, update, opts).Decode(&notification)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				break
+			}
+			return nil, utils.ErrorHandler(err, "Error claiming notifications")
+		}
+		claimed = append(claimed, &notification)
+	}
+	return claimed, nil
+}
+
+func MarkNotificationSentDBHandler(ctx context.Context, id string) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"status":  notificationSent,
+			"sent_at": time.Now().UTC().Format(time.RFC3339),
+		},
+		"$unset": bson.M{"locked_until": "", "last_error": ""},
+	}
+	_, err = client.Database("school").Collection("notifications").UpdateOne(ctx, bson.M{"_id": objID}, update)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error updating notification")
+	}
+	return nil
+}
+
+// MarkNotificationFailedDBHandler records a failed delivery. The message is
+// rescheduled for nextAttemptAt, or dead-lettered when dead is set. The
+// attempt was already counted when the message was claimed.
+func MarkNotificationFailedDBHandler(ctx context.Context, id string, deliveryErr string, nextAttemptAt time.Time, dead bool) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+	}
+
+	newStatus := notificationPending
+	if dead {
+		newStatus = notificationDead
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"status":          newStatus,
+			"last_error":      deliveryErr,
+			"next_attempt_at": nextAttemptAt.UTC(),
+		},
+		"$unset": bson.M{"locked_until": ""},
+	}
+	_, err = client.Database("school").Collection("notifications").UpdateOne(ctx, bson.M{"_id": objID}, update)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error updating notification")
+	}
+	return nil
+}
+
+func GetFailedNotificationsDBHandler(ctx context.Context, recipient string) ([]*pb.Notification, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	filter := bson.M{"status": notificationDead}
+	if recipient != "" {
+		filter["recipient"] = recipient
+	}
+
+	cursor, err := client.Database("school").Collection("notifications").Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	defer cursor.Close(ctx)
+
+	var notifications []*pb.Notification
+	for cursor.Next(ctx) {
+		var notification models.Notification
+		if err := cursor.Decode(&notification); err != nil {
+			return nil, utils.ErrorHandler(err, "Error decoding notification data")
+		}
+		notifications = append(notifications, mapModelNotificationToPb(notification))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	return notifications, nil
+}
+
+// RetryNotificationsDBHandler moves dead-lettered messages back to pending with
+// a fresh attempt budget. Messages in any other status are left untouched.
+func RetryNotificationsDBHandler(ctx context.Context, ids []string) ([]string, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("notifications")
+	update := bson.M{
+		"$set": bson.M{
+			"status":          notificationPending,
+			"attempts":        0,
+			"next_attempt_at": time.Now().UTC(),
+		},
+	}
+
+	var retriedIds []string
+	for _, id := range ids {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+		}
+
+		res, err := coll.UpdateOne(ctx, bson.M{"_id": objID, "status": notificationDead}, update)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error retrying notification")
+		}
+		if res.ModifiedCount > 0 {
+			retriedIds = append(retriedIds, id)
+		}
+	}
+	return retriedIds, nil
+}
+
+// mapModelNotificationToPb leaves out the body, which may carry one-time
+// tokens.
+func mapModelNotificationToPb(n models.Notification) *pb.Notification {
+	notification := &pb.Notification{
+		Id:             n.Id,
+		IdempotencyKey: n.IdempotencyKey,
+		Recipient:      n.Recipient,
+		Subject:        n.Subject,
+		Status:         n.Status,
+		Attempts:       n.Attempts,
+		LastError:      n.LastError,
+		CreatedAt:      n.CreatedAt,
+		SentAt:         n.SentAt,
+	}
+	if !n.NextAttemptAt.IsZero() {
+		notification.NextAttemptAt = n.NextAttemptAt.UTC().Format(time.RFC3339)
+	}
+	return notification
+}
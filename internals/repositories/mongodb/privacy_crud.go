@@ -0,0 +1,412 @@
+package mongodb
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/audit"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	erasureDelete    = "delete"
+	erasureAnonymize = "anonymize"
+)
+
+// personCollections maps a person kind to the collection holding their main record.
+var personCollections = map[string]string{
+	"student": "students",
+	"teacher": "teachers",
+	"exec":    "execs",
+}
+
+// LinkedCollection describes data about a person that lives outside their main
+// record. Features that store such data register it so export and erasure
+// stay complete.
+type LinkedCollection struct {
+	Collection string
+	// Kinds lists the person kinds the collection can reference
+	Kinds []string
+	// Field is matched against the person's ID, or their email if MatchEmail is
+	// set. An ID field must hold a single ID so MergeStudentsDBHandler can
+	// re-point it to the surviving record.
+	Field      string
+	MatchEmail bool
+	// UniqueWith lists the other fields of a unique index on Field, e.g. the
+	// date and period of an attendance record. When a merge would make two
+	// records clash, the survivor's is kept and the duplicate's deleted.
+	UniqueWith []string
+	// History marks a record of past events, such as the audit log, which a
+	// merge must not rewrite.
+	History bool
+}
+
+// Name identifies the linked data in privacy.retain and in receipts.
+func (lc LinkedCollection) Name() string {
+	return lc.Collection + "." + lc.Field
+}
+
+var linkedCollections []LinkedCollection
+
+func RegisterLinkedCollection(lc LinkedCollection) {
+	linkedCollections = append(linkedCollections, lc)
+}
+
+func init() {
+	RegisterLinkedCollection(LinkedCollection{Collection: "notifications", Kinds: []string{"student", "teacher", "exec"}, Field: "recipient", MatchEmail: true})
+	RegisterLinkedCollection(LinkedCollection{Collection: "audit_log", Kinds: []string{"exec"}, Field: "actor_id", History: true})
+	RegisterLinkedCollection(LinkedCollection{Collection: "audit_log", Kinds: []string{"student", "teacher", "exec"}, Field: "target_ids", History: true})
+	RegisterLinkedCollection(LinkedCollection{Collection: "teaching_assignments", Kinds: []string{"teacher"}, Field: "teacher_id"})
+	RegisterLinkedCollection(LinkedCollection{Collection: "attendance", Kinds: []string{"student"}, Field: "student_id", UniqueWith: []string{"date", "period"}})
+	RegisterLinkedCollection(LinkedCollection{Collection: "attendance", Kinds: []string{"exec"}, Field: "recorded_by"})
+	RegisterLinkedCollection(LinkedCollection{Collection: "scores", Kinds: []string{"student"}, Field: "student_id", UniqueWith: []string{"assessment_id"}})
+	RegisterLinkedCollection(LinkedCollection{Collection: "scores", Kinds: []string{"exec"}, Field: "recorded_by"})
+	RegisterLinkedCollection(LinkedCollection{Collection: "assessments", Kinds: []string{"exec"}, Field: "created_by"})
+}
+
+// ErasurePolicy holds the configurable rules applied by ErasePersonDataDBHandler.
+type ErasurePolicy struct {
+	// Modes maps a person kind to erasureDelete or erasureAnonymize
+	Modes map[string]string
+	// LegalHolds contains person IDs that must not be erased
+	LegalHolds map[string]bool
+	// Retain contains the names of linked collections kept on erasure
+	Retain map[string]bool
+}
+
+// ConfiguredErasurePolicy builds the policy from the privacy settings. Kinds
+// without an explicit mode are anonymized.
+func ConfiguredErasurePolicy() ErasurePolicy {
+	policy := ErasurePolicy{
+		Modes:      map[string]string{},
+		LegalHolds: map[string]bool{},
+		Retain:     map[string]bool{},
+	}
+	for kind := range personCollections {
+		mode := settings.Privacy.ErasureModes[kind]
+		if mode != erasureDelete {
+			mode = erasureAnonymize
+		}
+		policy.Modes[kind] = mode
+	}
+	for _, id := range settings.Privacy.LegalHoldIDs {
+		policy.LegalHolds[id] = true
+	}
+	for _, name := range settings.Privacy.Retain {
+		policy.Retain[name] = true
+	}
+	return policy
+}
+
+// UnknownRetainedCollections lists privacy.retain entries that match no
+// registered linked collection, most likely typos that would otherwise let
+// erasure delete data meant to be kept.
+func UnknownRetainedCollections() []string {
+	known := map[string]bool{}
+	for _, lc := range linkedCollections {
+		known[lc.Name()] = true
+	}
+	var unknown []string
+	for _, name := range settings.Privacy.Retain {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+// ExportPersonDataDBHandler gathers every record held about a person into a
+// JSON archive. Secret fields such as password hashes are left out.
+func ExportPersonDataDBHandler(ctx context.Context, kind, id string) ([]byte, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	person, err := findPerson(ctx, client, kind, id)
+	if err != nil {
+		return nil, err
+	}
+
+	records := bson.M{personCollections[kind]: []bson.M{stripSecrets(person)}}
+	for _, lc := range linkedCollectionsFor(kind) {
+		filter, ok := linkedFilter(lc, id, person)
+		if !ok {
+			continue
+		}
+
+		cursor, err := client.Database("school").Collection(lc.Collection).Find(ctx, filter)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error exporting "+lc.Collection)
+		}
+		var docs []bson.M
+		err = cursor.All(ctx, &docs)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error exporting "+lc.Collection)
+		}
+		for i := range docs {
+			docs[i] = stripSecrets(docs[i])
+		}
+		records[lc.Name()] = docs
+	}
+
+	archive := bson.M{
+		"person_kind": kind,
+		"person_id":   id,
+		"exported_at": time.Now().UTC().Format(time.RFC3339),
+		"records":     records,
+	}
+	data, err := bson.MarshalExtJSONIndent(archive, false, false, "", "  ")
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error building export archive")
+	}
+	return data, nil
+}
+
+// ErasePersonDataDBHandler deletes or anonymises a person's main record
+// according to policy, removes their data from linked collections that are not
+// retained, scrubs their field values from the audit log, and stores a signed
+// receipt of what was done.
+func ErasePersonDataDBHandler(ctx context.Context, kind, id, requestedBy, reason string, policy ErasurePolicy) (*models.ErasureReceipt, error) {
+	if policy.LegalHolds[id] {
+		return nil, utils.ConflictError(utils.ReasonLegalHold, "Person is under legal hold and cannot be erased")
+	}
+
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	person, err := findPerson(ctx, client, kind, id)
+	if err != nil {
+		return nil, err
+	}
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+	}
+
+	mode := policy.Modes[kind]
+	receipt := &models.ErasureReceipt{
+		Id:          primitive.NewObjectID().Hex(),
+		PersonKind:  kind,
+		PersonId:    id,
+		Mode:        mode,
+		RequestedBy: requestedBy,
+		Reason:      reason,
+		ErasedAt:    time.Now().UTC().Format(time.RFC3339),
+	}
+
+	coll := client.Database("school").Collection(personCollections[kind])
+
+	err = runInTransaction(ctx, client, func(sessCtx mongo.SessionContext) error {
+		receipt.Affected = nil
+		receipt.Retained = nil
+
+		for _, lc := range linkedCollectionsFor(kind) {
+			filter, ok := linkedFilter(lc, id, person)
+			if !ok {
+				continue
+			}
+			name := lc.Name()
+			if policy.Retain[name] {
+				receipt.Retained = append(receipt.Retained, name)
+				continue
+			}
+			res, err := client.Database("school").Collection(lc.Collection).DeleteMany(sessCtx, filter)
+			if err != nil {
+				return err
+			}
+			receipt.Affected = append(receipt.Affected, fmt.Sprintf("%s:%d", name, res.DeletedCount))
+		}
+
+		if mode == erasureDelete {
+			_, err := coll.DeleteOne(sessCtx, bson.M{"_id": objID})
+			if err != nil {
+				return err
+			}
+		} else {
+			_, err := coll.UpdateOne(sessCtx, bson.M{"_id": objID}, anonymizeUpdate(kind, id))
+			if err != nil {
+				return err
+			}
+		}
+		receipt.Affected = append(receipt.Affected, fmt.Sprintf("%s:1", personCollections[kind]))
+
+		scrubbed, err := scrubAuditLog(sessCtx, client, id)
+		if err != nil {
+			return err
+		}
+		receipt.Affected = append(receipt.Affected, fmt.Sprintf("audit_log.changes:%d", scrubbed))
+
+		signature, err := signReceipt(receipt)
+		if err != nil {
+			return err
+		}
+		receipt.Signature = signature
+
+		_, err = client.Database("school").Collection("erasure_receipts").InsertOne(sessCtx, receipt)
+		return err
+	})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error erasing person data")
+	}
+	// Only the ID is audited; recording a diff would copy the erased data
+	// into the audit log.
+	audit.AddTarget(ctx, id)
+
+	return receipt, nil
+}
+
+// ReceiptPayload is the exact JSON covered by a receipt's signature.
+func ReceiptPayload(receipt *models.ErasureReceipt) ([]byte, error) {
+	return json.Marshal(receipt)
+}
+
+func signReceipt(receipt *models.ErasureReceipt) (string, error) {
+	payload, err := ReceiptPayload(receipt)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, []byte(settings.Privacy.ReceiptSecret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func findPerson(ctx context.Context, client *mongo.Client, kind, id string) (bson.M, error) {
+	collection, ok := personCollections[kind]
+	if !ok {
+		return nil, utils.InvalidArgumentError("kind", utils.ReasonValidationFailed, "Unknown person kind")
+	}
+
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+	}
+
+	var person bson.M
+	err = client.Database("school").Collection(collection).FindOne(ctx, bson.M{"_id": objID}).Decode(&person)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, utils.NotFoundError(utils.ReasonNotFound, "Person not found")
+		}
+		return nil, utils.ErrorHandler(err, "Error fetching person data")
+	}
+	return person, nil
+}
+
+func linkedCollectionsFor(kind string) []LinkedCollection {
+	var matches []LinkedCollection
+	for _, lc := range linkedCollections {
+		for _, k := range lc.Kinds {
+			if k == kind {
+				matches = append(matches, lc)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+func linkedFilter(lc LinkedCollection, id string, person bson.M) (bson.M, bool) {
+	if !lc.MatchEmail {
+		return bson.M{lc.Field: id}, true
+	}
+	email, _ := person["email"].(string)
+	if email == "" {
+		return nil, false
+	}
+	return bson.M{lc.Field: email}, true
+}
+
+func stripSecrets(doc bson.M) bson.M {
+	for field := range doc {
+		if audit.IsSecretField(field) {
+			delete(doc, field)
+		}
+	}
+	return doc
+}
+
+// anonymizedFields lists, per person kind, the personal fields removed when a
+// record is anonymised. Names and email are replaced with placeholders
+// instead, and keys other records point at, such as _id and merged_into, are
+// kept so references stay valid.
+var anonymizedFields = map[string][]string{
+	"student": {"date_of_birth", "class", "class_id"},
+	"teacher": {"class", "class_id", "subject", "qualified_subject_ids"},
+	"exec":    append([]string{"teacher_id"}, execSecretFields...),
+}
+
+// anonymizeUpdate blanks every identifying field while keeping the record, so
+// that references from other collections remain valid.
+func anonymizeUpdate(kind, id string) bson.M {
+	set := bson.M{
+		"first_name": "Erased",
+		"last_name":  "Person",
+		"email":      fmt.Sprintf("erased-%s@invalid.local", id),
+		"erased_at":  time.Now().UTC().Format(time.RFC3339),
+	}
+	if kind == "exec" {
+		set["username"] = "erased-" + id
+		set["inactive_status"] = true
+	}
+
+	unset := bson.M{}
+	for _, field := range anonymizedFields[kind] {
+		unset[field] = ""
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	return update
+}
+
+// erasedValue replaces a scrubbed value in the audit log.
+const erasedValue = "[ERASED]"
+
+// scrubAuditLog replaces the before and after values of every audit change
+// to the person's record, and clears the username and address of calls they
+// made. The entries themselves stay, so the log still shows who changed which
+// fields and when. It returns the number of entries touched.
+func scrubAuditLog(ctx context.Context, client *mongo.Client, id string) (int64, error) {
+	coll := client.Database("school").Collection("audit_log")
+
+	res, err := coll.UpdateMany(ctx,
+		bson.M{"changes.target_id": id},
+		bson.M{"$set": bson.M{
+			"changes.$[before].before": erasedValue,
+			"changes.$[after].after":   erasedValue,
+		}},
+		options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{
+			bson.M{"before.target_id": id, "before.before": bson.M{"$exists": true}},
+			bson.M{"after.target_id": id, "after.after": bson.M{"$exists": true}},
+		}}))
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = coll.UpdateMany(ctx,
+		bson.M{"actor_id": id},
+		bson.M{"$unset": bson.M{"actor_username": "", "client_ip": ""}})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
@@ -0,0 +1,86 @@
+package mongodb
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestAnonymizeUpdateCoversEveryField(t *testing.T) {
+	// Fields that may survive anonymisation because they identify nobody
+	kept := map[string][]string{
+		"student": {"_id"},
+		"teacher": {"_id"},
+		"exec":    {"_id", "role", "account_status", "password_changed_at", "user_created_at"},
+	}
+	tests := []struct {
+		kind  string
+		model interface{}
+	}{
+		{"student", models.Student{}},
+		{"teacher", models.Teacher{}},
+		{"exec", models.Exec{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			update := anonymizeUpdate(tt.kind, "507f1f77bcf86cd799439011")
+			set, _ := update["$set"].(bson.M)
+			unset, _ := update["$unset"].(bson.M)
+
+			modelType := reflect.TypeOf(tt.model)
+			for i := 0; i < modelType.NumField(); i++ {
+				field := strings.TrimSuffix(modelType.Field(i).Tag.Get("bson"), ",omitempty")
+				_, isSet := set[field]
+				_, isUnset := unset[field]
+				if !isSet && !isUnset && !slices.Contains(kept[tt.kind], field) {
+					t.Errorf("%s.%s is neither replaced nor removed", tt.kind, field)
+				}
+			}
+		})
+	}
+}
+
+func TestAnonymizeUpdatePlaceholders(t *testing.T) {
+	id := "507f1f77bcf86cd799439011"
+	tests := []struct {
+		kind string
+		want bson.M
+	}{
+		{"student", bson.M{"first_name": "Erased", "last_name": "Person", "email": "erased-" + id + "@invalid.local"}},
+		{"teacher", bson.M{"first_name": "Erased", "last_name": "Person", "email": "erased-" + id + "@invalid.local"}},
+		{"exec", bson.M{"first_name": "Erased", "username": "erased-" + id, "inactive_status": true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			set := anonymizeUpdate(tt.kind, id)["$set"].(bson.M)
+			for field, want := range tt.want {
+				if set[field] != want {
+					t.Errorf("%s = %v, want %v", field, set[field], want)
+				}
+			}
+			if _, ok := set["erased_at"]; !ok {
+				t.Error("erased_at not set")
+			}
+		})
+	}
+}
+
+func TestDefaultRetainIsRegistered(t *testing.T) {
+	saved := settings
+	defer func() { settings = saved }()
+
+	settings = config.Default()
+	if unknown := UnknownRetainedCollections(); len(unknown) > 0 {
+		t.Errorf("default privacy.retain has unregistered collections %v", unknown)
+	}
+
+	settings.Privacy.Retain = []string{"audit_log.actor_id", "audit_log.actorid"}
+	if got := UnknownRetainedCollections(); !reflect.DeepEqual(got, []string{"audit_log.actorid"}) {
+		t.Errorf("UnknownRetainedCollections() = %v, want [audit_log.actorid]", got)
+	}
+}
@@ -0,0 +1,111 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// rateLimitBucket is a token bucket shared by every replica.
+type rateLimitBucket struct {
+	Tokens  float64 `bson:"tokens"`
+	Allowed bool    `bson:"allowed"`
+}
+
+func EnsureRateLimitIndexesDBHandler(ctx context.Context) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	// Idle buckets are full again by the time they expire, so dropping them
+	// loses nothing
+	index := mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}
+	_, err = client.Database("school").Collection("rate_limits").Indexes().CreateOne(ctx, index)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error creating rate limit indexes")
+	}
+	return nil
+}
+
+// TakeRateLimitTokenDBHandler refills the bucket for key at rate tokens per
+// second up to capacity and takes one token, in a single atomic update. When
+// no token is available it returns how long until one will be. It runs on
+// every call, so it uses the shared client.
+func TakeRateLimitTokenDBHandler(ctx context.Context, key string, rate float64, capacity int) (bool, time.Duration, error) {
+	client, err := sharedClient(ctx)
+	if err != nil {
+		return false, 0, utils.ErrorHandler(err, "Database connection error")
+	}
+
+	idleTTL := time.Duration(float64(capacity)/rate*float64(time.Second)) + time.Minute
+
+	elapsedSeconds := bson.M{"$divide": bson.A{
+		bson.M{"$subtract": bson.A{"$$NOW", bson.M{"$ifNull": bson.A{"$updated_at", "$$NOW"}}}},
+		1000,
+	}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"refilled": bson.M{"$min": bson.A{
+				float64(capacity),
+				bson.M{"$add": bson.A{
+					bson.M{"$ifNull": bson.A{"$tokens", float64(capacity)}},
+					bson.M{"$multiply": bson.A{elapsedSeconds, rate}},
+				}},
+			}},
+		}}},
+		{{Key: "$set", Value: bson.M{
+			"allowed": bson.M{"$gte": bson.A{"$refilled", 1}},
+			"tokens": bson.M{"$cond": bson.A{
+				bson.M{"$gte": bson.A{"$refilled", 1}},
+				bson.M{"$subtract": bson.A{"$refilled", 1}},
+				"$refilled",
+			}},
+			"updated_at": "$$NOW",
+			"expires_at": bson.M{"$add": bson.A{"$$NOW", idleTTL.Milliseconds()}},
+		}}},
+		{{Key: "$unset", Value: "refilled"}},
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var bucket rateLimitBucket
+	err = client.Database("school").Collection("rate_limits").FindOneAndUpdate(ctx, bson.M{"_id": key}, pipeline, opts).Decode(&bucket)
+	if err != nil {
+		return false, 0, utils.ErrorHandler(err, "Error updating rate limit bucket")
+	}
+
+	if bucket.Allowed {
+		return true, 0, nil
+	}
+	wait := time.Duration((1 - bucket.Tokens) / rate * float64(time.Second))
+	return false, wait, nil
+}
+
+// RefundRateLimitTokenDBHandler gives back one token taken from the bucket for
+// key, never filling it past capacity. A bucket that has expired meanwhile is
+// full anyway, so it is not recreated.
+func RefundRateLimitTokenDBHandler(ctx context.Context, key string, capacity int) error {
+	client, err := sharedClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"tokens": bson.M{"$min": bson.A{float64(capacity), bson.M{"$add": bson.A{"$tokens", 1}}}},
+		}}},
+	}
+	_, err = client.Database("school").Collection("rate_limits").UpdateOne(ctx, bson.M{"_id": key}, pipeline)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error updating rate limit bucket")
+	}
+	return nil
+}
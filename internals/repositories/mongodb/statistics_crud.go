@@ -0,0 +1,250 @@
+package mongodb
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+)
+
+// statisticsCache holds the last computed statistics until expires.
+var statisticsCache struct {
+	sync.Mutex
+	stats   *pb.SchoolStatistics
+	expires time.Time
+}
+
+type classStatisticsRow struct {
+	Id           string   `bson:"_id"`
+	GradeLevel   int32    `bson:"grade_level"`
+	Section      string   `bson:"section"`
+	AcademicYear string   `bson:"academic_year"`
+	StudentCount int32    `bson:"student_count"`
+	TeacherIds   []string `bson:"teacher_ids"`
+}
+
+type subjectStatisticsRow struct {
+	Id                string `bson:"_id"`
+	Code              string `bson:"code"`
+	Name              string `bson:"name"`
+	QualifiedTeachers int32  `bson:"qualified_teachers"`
+	AssignedTeachers  int32  `bson:"assigned_teachers"`
+}
+
+type execCountRow struct {
+	Id struct {
+		Role   string `bson:"role"`
+		Status string `bson:"status"`
+	} `bson:"_id"`
+	Count int32 `bson:"count"`
+}
+
+// GetStatisticsDBHandler returns the dashboard statistics, computing them at
+// most once per statistics.cache_ttl.
+func GetStatisticsDBHandler(ctx context.Context) (*pb.SchoolStatistics, error) {
+	statisticsCache.Lock()
+	if statisticsCache.stats != nil && time.Now().Before(statisticsCache.expires) {
+		stats := proto.Clone(statisticsCache.stats).(*pb.SchoolStatistics)
+		statisticsCache.Unlock()
+		return stats, nil
+	}
+	statisticsCache.Unlock()
+
+	stats, err := computeStatistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statisticsCache.Lock()
+	statisticsCache.stats = stats
+	statisticsCache.expires = time.Now().Add(settings.Statistics.CacheTTL)
+	statisticsCache.Unlock()
+
+	return proto.Clone(stats).(*pb.SchoolStatistics), nil
+}
+
+func computeStatistics(ctx context.Context) (*pb.SchoolStatistics, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database("school")
+	stats := &pb.SchoolStatistics{GeneratedAt: time.Now().UTC().Format(time.RFC3339)}
+
+	totalStudents, err := db.Collection("students").CountDocuments(ctx, bson.M{"merged_into": notMerged})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error counting students")
+	}
+	totalTeachers, err := db.Collection("teachers").CountDocuments(ctx, bson.M{})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error counting teachers")
+	}
+	stats.TotalStudents = int32(totalStudents)
+	stats.TotalTeachers = int32(totalTeachers)
+
+	var classRows []classStatisticsRow
+	err = aggregateStatistics(ctx, db.Collection("classes"), classStatisticsPipeline, &classRows)
+	if err != nil {
+		return nil, err
+	}
+
+	var subjectRows []subjectStatisticsRow
+	err = aggregateStatistics(ctx, db.Collection("subjects"), subjectStatisticsPipeline, &subjectRows)
+	if err != nil {
+		return nil, err
+	}
+
+	var execRows []execCountRow
+	err = aggregateStatistics(ctx, db.Collection("execs"), execCountPipeline, &execRows)
+	if err != nil {
+		return nil, err
+	}
+
+	addStatisticsRows(stats, classRows, subjectRows, execRows)
+
+	return stats, nil
+}
+
+// addStatisticsRows fills stats from the aggregated class, subject and exec
+// rows. Classes without a teacher are also listed in ClassesWithoutTeacher.
+func addStatisticsRows(stats *pb.SchoolStatistics, classRows []classStatisticsRow, subjectRows []subjectStatisticsRow, execRows []execCountRow) {
+	for _, row := range classRows {
+		class := &pb.ClassStatistics{
+			ClassId:      row.Id,
+			GradeLevel:   row.GradeLevel,
+			Section:      row.Section,
+			AcademicYear: row.AcademicYear,
+			StudentCount: row.StudentCount,
+			TeacherCount: int32(len(row.TeacherIds)),
+		}
+		if class.TeacherCount == 0 {
+			stats.ClassesWithoutTeacher = append(stats.ClassesWithoutTeacher, row.Id)
+		} else {
+			class.StudentsPerTeacher = float32(class.StudentCount) / float32(class.TeacherCount)
+		}
+		stats.Classes = append(stats.Classes, class)
+	}
+	for _, row := range subjectRows {
+		stats.Subjects = append(stats.Subjects, &pb.SubjectStatistics{
+			SubjectId:         row.Id,
+			Code:              row.Code,
+			Name:              row.Name,
+			QualifiedTeachers: row.QualifiedTeachers,
+			AssignedTeachers:  row.AssignedTeachers,
+		})
+	}
+	for _, row := range execRows {
+		stats.Execs = append(stats.Execs, &pb.ExecCount{Role: row.Id.Role, Status: row.Id.Status, Count: row.Count})
+	}
+}
+
+func aggregateStatistics(ctx context.Context, coll *mongo.Collection, pipeline mongo.Pipeline, results interface{}) error {
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return utils.ErrorHandler(err, "Error computing statistics")
+	}
+	defer cursor.Close(ctx)
+
+	if err := cursor.All(ctx, results); err != nil {
+		return utils.ErrorHandler(err, "Error decoding statistics")
+	}
+	return nil
+}
+
+// classStatisticsPipeline counts the live students of each class and collects
+// its teachers: those whose class_id is the class, its homeroom teacher, and
+// those with a teaching assignment in it.
+var classStatisticsPipeline = mongo.Pipeline{
+	{{Key: "$addFields", Value: bson.M{"_id": bson.M{"$toString": "$_id"}}}},
+	{{Key: "$lookup", Value: bson.M{
+		"from": "students",
+		"let":  bson.M{"class_id": "$_id"},
+		"pipeline": bson.A{
+			bson.M{"$match": bson.M{"$expr": bson.M{"$eq": bson.A{"$class_id", "$$class_id"}}, "merged_into": notMerged}},
+			bson.M{"$count": "count"},
+		},
+		"as": "students",
+	}}},
+	{{Key: "$lookup", Value: bson.M{
+		"from": "teachers",
+		"let":  bson.M{"class_id": "$_id"},
+		"pipeline": bson.A{
+			bson.M{"$match": bson.M{"$expr": bson.M{"$eq": bson.A{"$class_id", "$$class_id"}}}},
+			bson.M{"$project": bson.M{"_id": bson.M{"$toString": "$_id"}}},
+		},
+		"as": "own_teachers",
+	}}},
+	{{Key: "$lookup", Value: bson.M{
+		"from":         "teaching_assignments",
+		"localField":   "_id",
+		"foreignField": "class_id",
+		"as":           "assignments",
+	}}},
+	{{Key: "$project", Value: bson.M{
+		"grade_level":   1,
+		"section":       1,
+		"academic_year": 1,
+		"student_count": bson.M{"$ifNull": bson.A{bson.M{"$first": "$students.count"}, 0}},
+		"teacher_ids": bson.M{"$setUnion": bson.A{
+			"$own_teachers._id",
+			"$assignments.teacher_id",
+			bson.M{"$cond": bson.A{bson.M{"$gt": bson.A{"$homeroom_teacher_id", ""}}, bson.A{"$homeroom_teacher_id"}, bson.A{}}},
+		}},
+	}}},
+	{{Key: "$sort", Value: bson.D{{Key: "academic_year", Value: 1}, {Key: "grade_level", Value: 1}, {Key: "section", Value: 1}}}},
+}
+
+// subjectStatisticsPipeline counts the teachers qualified for each subject
+// and the distinct teachers assigned to teach it.
+var subjectStatisticsPipeline = mongo.Pipeline{
+	{{Key: "$addFields", Value: bson.M{"_id": bson.M{"$toString": "$_id"}}}},
+	{{Key: "$lookup", Value: bson.M{
+		"from": "teachers",
+		"let":  bson.M{"subject_id": "$_id"},
+		"pipeline": bson.A{
+			bson.M{"$match": bson.M{"$expr": bson.M{"$in": bson.A{"$$subject_id", bson.M{"$ifNull": bson.A{"$qualified_subject_ids", bson.A{}}}}}}},
+			bson.M{"$count": "count"},
+		},
+		"as": "qualified",
+	}}},
+	{{Key: "$lookup", Value: bson.M{
+		"from":         "teaching_assignments",
+		"localField":   "_id",
+		"foreignField": "subject_id",
+		"as":           "assignments",
+	}}},
+	{{Key: "$project", Value: bson.M{
+		"code":               1,
+		"name":               1,
+		"qualified_teachers": bson.M{"$ifNull": bson.A{bson.M{"$first": "$qualified.count"}, 0}},
+		"assigned_teachers":  bson.M{"$size": bson.M{"$setUnion": bson.A{"$assignments.teacher_id"}}},
+	}}},
+	{{Key: "$sort", Value: bson.D{{Key: "code", Value: 1}}}},
+}
+
+// execCountPipeline counts execs by role and status. Deactivated accounts
+// count as inactive whatever their account status; accounts created before
+// activation existed have no status and count as active.
+var execCountPipeline = mongo.Pipeline{
+	{{Key: "$group", Value: bson.M{
+		"_id": bson.M{
+			"role": "$role",
+			"status": bson.M{"$switch": bson.M{
+				"branches": bson.A{
+					bson.M{"case": bson.M{"$eq": bson.A{"$inactive_status", true}}, "then": "inactive"},
+					bson.M{"case": bson.M{"$eq": bson.A{"$account_status", AccountPending}}, "then": AccountPending},
+				},
+				"default": AccountActive,
+			}},
+		},
+		"count": bson.M{"$sum": 1},
+	}}},
+	{{Key: "$sort", Value: bson.D{{Key: "_id.role", Value: 1}, {Key: "_id.status", Value: 1}}}},
+}
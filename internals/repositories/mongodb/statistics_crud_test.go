@@ -0,0 +1,84 @@
+package mongodb
+
+import (
+	"testing"
+
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAddStatisticsRows(t *testing.T) {
+	admin := execCountRow{Count: 2}
+	admin.Id.Role, admin.Id.Status = "admin", "active"
+	pending := execCountRow{Count: 1}
+	pending.Id.Role, pending.Id.Status = "exec", "pending"
+
+	tests := []struct {
+		name        string
+		classRows   []classStatisticsRow
+		subjectRows []subjectStatisticsRow
+		execRows    []execCountRow
+		want        *pb.SchoolStatistics
+	}{
+		{
+			name: "no rows",
+			want: &pb.SchoolStatistics{TotalStudents: 10},
+		},
+		{
+			name: "students per teacher",
+			classRows: []classStatisticsRow{
+				{Id: "c1", GradeLevel: 9, Section: "A", AcademicYear: "2025-2026", StudentCount: 25, TeacherIds: []string{"t1", "t2"}},
+				{Id: "c2", GradeLevel: 9, Section: "B", AcademicYear: "2025-2026", StudentCount: 0, TeacherIds: []string{"t1"}},
+			},
+			want: &pb.SchoolStatistics{
+				TotalStudents: 10,
+				Classes: []*pb.ClassStatistics{
+					{ClassId: "c1", GradeLevel: 9, Section: "A", AcademicYear: "2025-2026", StudentCount: 25, TeacherCount: 2, StudentsPerTeacher: 12.5},
+					{ClassId: "c2", GradeLevel: 9, Section: "B", AcademicYear: "2025-2026", TeacherCount: 1},
+				},
+			},
+		},
+		{
+			name: "classes without a teacher",
+			classRows: []classStatisticsRow{
+				{Id: "c1", GradeLevel: 10, Section: "A", StudentCount: 30},
+				{Id: "c2", GradeLevel: 10, Section: "B", StudentCount: 12, TeacherIds: []string{}},
+			},
+			want: &pb.SchoolStatistics{
+				TotalStudents: 10,
+				Classes: []*pb.ClassStatistics{
+					{ClassId: "c1", GradeLevel: 10, Section: "A", StudentCount: 30},
+					{ClassId: "c2", GradeLevel: 10, Section: "B", StudentCount: 12},
+				},
+				ClassesWithoutTeacher: []string{"c1", "c2"},
+			},
+		},
+		{
+			name: "subjects and execs",
+			subjectRows: []subjectStatisticsRow{
+				{Id: "s1", Code: "MATH", Name: "Mathematics", QualifiedTeachers: 3, AssignedTeachers: 2},
+			},
+			execRows: []execCountRow{admin, pending},
+			want: &pb.SchoolStatistics{
+				TotalStudents: 10,
+				Subjects: []*pb.SubjectStatistics{
+					{SubjectId: "s1", Code: "MATH", Name: "Mathematics", QualifiedTeachers: 3, AssignedTeachers: 2},
+				},
+				Execs: []*pb.ExecCount{
+					{Role: "admin", Status: "active", Count: 2},
+					{Role: "exec", Status: "pending", Count: 1},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := &pb.SchoolStatistics{TotalStudents: 10}
+			addStatisticsRows(stats, tt.classRows, tt.subjectRows, tt.execRows)
+			if !proto.Equal(stats, tt.want) {
+				t.Errorf("addStatisticsRows() = %v, want %v", stats, tt.want)
+			}
+		})
+	}
+}
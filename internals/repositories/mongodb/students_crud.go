@@ -2,6 +2,11 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
 	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
@@ -10,8 +15,6 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func AddStudentsDBHandler(ctx context.Context, studentsFromReq []*pb.Student) ([]*pb.Student, error) {
@@ -27,18 +30,26 @@ func AddStudentsDBHandler(ctx context.Context, studentsFromReq []*pb.Student) ([
 		if err != nil {
 			return nil, utils.ErrorHandler(err, "Error mapping student data")
 		}
+		err = checkReference(ctx, client, "classes", "class_id", pbStudent.ClassId, "Class not found")
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	var addedStudents []*pb.Student
 	for _, student := range newStudents {
 		result, err := client.Database("school").Collection("students").InsertOne(ctx, student)
 		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, duplicateKeyError(err, "students")
+			}
 			return nil, utils.ErrorHandler(err, "Error adding student to database")
 		}
 
 		objectId, ok := result.InsertedID.(primitive.ObjectID)
 		if ok {
 			student.Id = objectId.Hex()
+			recordAuditChanges(ctx, nil, auditSnapshot(ctx, client.Database("school").Collection("students"), bson.M{"_id": objectId}))
 		}
 
 		pbStudent, err := mapModelStudentToPbStudent(*student)
@@ -69,6 +80,7 @@ func GetStudentsDBHandler(ctx context.Context, sortOptions primitive.D, filter p
 		findOptions.SetSort(sortOptions)
 	}
 
+	filter["merged_into"] = notMerged
 	cursor, err = coll.Find(ctx, filter, findOptions)
 
 	if err != nil {
@@ -104,7 +116,12 @@ func UpdateStudentsDBHandler(ctx context.Context, pbStudents []*pb.Student) ([]*
 
 		objID, err := primitive.ObjectIDFromHex(student.Id)
 		if err != nil {
-			return nil, utils.ErrorHandler(err, "Invalid ID format")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+		}
+
+		err = checkReference(ctx, client, "classes", "class_id", modelStudent.ClassId, "Class not found")
+		if err != nil {
+			return nil, err
 		}
 
 		modelDoc, err := bson.Marshal(modelStudent)
@@ -119,10 +136,16 @@ func UpdateStudentsDBHandler(ctx context.Context, pbStudents []*pb.Student) ([]*
 
 		delete(updateDoc, "_id")
 
-		_, err = client.Database("school").Collection("students").UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": updateDoc})
+		coll := client.Database("school").Collection("students")
+		before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+		_, err = coll.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": updateDoc})
 		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, duplicateKeyError(err, "students")
+			}
 			return nil, utils.ErrorHandler(err, "Error updating student data")
 		}
+		recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bson.M{"_id": objID}))
 
 		updatedStudent, err := mapModelStudentToPbStudent(*modelStudent)
 		if err != nil {
@@ -143,23 +166,26 @@ func DeleteStudentsDBHandler(ctx context.Context, studentIdsToDelete []string) (
 	objectIds := make([]primitive.ObjectID, 0, len(studentIdsToDelete))
 	for _, id := range studentIdsToDelete {
 		if id == "" {
-			return nil, utils.ErrorHandler(err, "Student ID is required for deletion")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonMissingID, "Student ID is required for deletion")
 		}
 		objID, err := primitive.ObjectIDFromHex(id)
 		if err != nil {
-			return nil, utils.ErrorHandler(err, "Invalid student ID format")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid student ID format")
 		}
 		objectIds = append(objectIds, objID)
 	}
 
 	filter := bson.M{"_id": bson.M{"$in": objectIds}}
-	result, err := client.Database("school").Collection("students").DeleteMany(ctx, filter)
+	coll := client.Database("school").Collection("students")
+	before := auditSnapshot(ctx, coll, filter)
+	result, err := coll.DeleteMany(ctx, filter)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error deleting students from database")
 	}
+	recordAuditChanges(ctx, before, nil)
 
 	if result.DeletedCount == 0 {
-		return nil, status.Error(codes.NotFound, "No students found to delete")
+		return nil, utils.NotFoundError(utils.ReasonNotFound, "No students found to delete")
 	}
 
 	deletedIds := make([]string, 0, len(objectIds))
@@ -170,7 +196,7 @@ func DeleteStudentsDBHandler(ctx context.Context, studentIdsToDelete []string) (
 	return deletedIds, nil
 }
 
-func GetStudentsByClassTeacherDBHandler(ctx context.Context, teacherId string) ([]*pb.Student, error) {
+func GetStudentsByClassTeacherDBHandler(ctx context.Context, teacherId, subjectId string) ([]*pb.Student, error) {
 	client, err := CreateMongoClient(ctx)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Database connection error")
@@ -179,19 +205,24 @@ func GetStudentsByClassTeacherDBHandler(ctx context.Context, teacherId string) (
 
 	objID, err := primitive.ObjectIDFromHex(teacherId)
 	if err != nil {
-		return nil, utils.ErrorHandler(err, "Invalid teacher ID format")
+		return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid teacher ID format")
 	}
 
 	var teacher models.Teacher
 	err = client.Database("school").Collection("teachers").FindOne(ctx, bson.M{"_id": objID}).Decode(&teacher)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, utils.ErrorHandler(err, "Teacher not found")
+			return nil, utils.NotFoundError(utils.ReasonNotFound, "Teacher not found")
 		}
 		return nil, utils.ErrorHandler(err, "Error fetching teacher data")
 	}
 
-	cursor, err := client.Database("school").Collection("students").Find(ctx, bson.M{"class": teacher.Class})
+	classIds, err := classIdsForTeacher(ctx, client, teacherId, teacher, subjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := client.Database("school").Collection("students").Find(ctx, bson.M{"class_id": bson.M{"$in": classIds}, "merged_into": notMerged})
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error fetching students by class")
 	}
@@ -208,7 +239,7 @@ func GetStudentsByClassTeacherDBHandler(ctx context.Context, teacherId string) (
 	return students, nil
 }
 
-func GetStudentCountByClassTeacherDBHandler(ctx context.Context, teacherId string) (int32, error) {
+func GetStudentCountByClassTeacherDBHandler(ctx context.Context, teacherId, subjectId string) (int32, error) {
 	client, err := CreateMongoClient(ctx)
 	if err != nil {
 		return 0, utils.ErrorHandler(err, "Database connection error")
@@ -217,22 +248,242 @@ func GetStudentCountByClassTeacherDBHandler(ctx context.Context, teacherId strin
 
 	objID, err := primitive.ObjectIDFromHex(teacherId)
 	if err != nil {
-		return 0, utils.ErrorHandler(err, "Invalid teacher ID format")
+		return 0, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid teacher ID format")
 	}
 
 	var teacher models.Teacher
 	err = client.Database("school").Collection("teachers").FindOne(ctx, bson.M{"_id": objID}).Decode(&teacher)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return 0, utils.ErrorHandler(err, "Teacher not found")
+			return 0, utils.NotFoundError(utils.ReasonNotFound, "Teacher not found")
 		}
 		return 0, utils.ErrorHandler(err, "Error fetching teacher data")
 	}
 
-	count, err := client.Database("school").Collection("students").CountDocuments(ctx, bson.M{"class": teacher.Class})
+	classIds, err := classIdsForTeacher(ctx, client, teacherId, teacher, subjectId)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := client.Database("school").Collection("students").CountDocuments(ctx, bson.M{"class_id": bson.M{"$in": classIds}, "merged_into": notMerged})
 	if err != nil {
 		return 0, utils.ErrorHandler(err, "Error counting students")
 	}
 
 	return int32(count), nil
-}
\ No newline at end of file
+}
+// notMerged matches a merged_into field on records that are still live, i.e.
+// have not been merged into another record.
+var notMerged = bson.M{"$exists": false}
+
+// MergeStudentsDBHandler folds the duplicate student into the survivor in one
+// transaction: fields listed in fieldsFromDuplicate are copied over, records
+// registered as linked to the duplicate are re-pointed, and the duplicate is
+// soft-deleted with a merged_into pointer. Both records' before and after
+// states go to the audit log, as do the linked records that were re-pointed
+// or dropped.
+func MergeStudentsDBHandler(ctx context.Context, survivorId, duplicateId string, fieldsFromDuplicate []string) (*pb.Student, error) {
+	survivorObjID, err := primitive.ObjectIDFromHex(survivorId)
+	if err != nil {
+		return nil, utils.InvalidArgumentError("survivor_id", utils.ReasonInvalidID, "Invalid student ID format")
+	}
+	duplicateObjID, err := primitive.ObjectIDFromHex(duplicateId)
+	if err != nil {
+		return nil, utils.InvalidArgumentError("duplicate_id", utils.ReasonInvalidID, "Invalid student ID format")
+	}
+
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("students")
+	bothFilter := bson.M{"_id": bson.M{"$in": bson.A{survivorObjID, duplicateObjID}}}
+	before := auditSnapshot(ctx, coll, bothFilter)
+
+	linked := mergedLinkedCollections()
+	linkedBefore := make([]map[string]bson.M, len(linked))
+	for i, lc := range linked {
+		linkedBefore[i] = auditSnapshot(ctx, client.Database("school").Collection(lc.Collection), bson.M{lc.Field: duplicateId})
+	}
+
+	var merged models.Student
+	err = runInTransaction(ctx, client, func(sessCtx mongo.SessionContext) error {
+		var survivor, duplicate bson.M
+		err := coll.FindOne(sessCtx, bson.M{"_id": survivorObjID, "merged_into": notMerged}).Decode(&survivor)
+		if err == mongo.ErrNoDocuments {
+			return utils.NotFoundError(utils.ReasonNotFound, "Surviving student not found")
+		}
+		if err != nil {
+			return err
+		}
+		err = coll.FindOne(sessCtx, bson.M{"_id": duplicateObjID, "merged_into": notMerged}).Decode(&duplicate)
+		if err == mongo.ErrNoDocuments {
+			return utils.NotFoundError(utils.ReasonNotFound, "Duplicate student not found")
+		}
+		if err != nil {
+			return err
+		}
+
+		// The duplicate gives up its email first so the survivor may take it
+		// without tripping the unique index. The audit entry keeps the old value.
+		_, err = coll.UpdateOne(sessCtx, bson.M{"_id": duplicateObjID}, bson.M{
+			"$set": bson.M{
+				"merged_into": survivorId,
+				"merged_at":   time.Now().Format(time.RFC3339),
+			},
+			"$unset": bson.M{"email": ""},
+		})
+		if err != nil {
+			return err
+		}
+
+		set := bson.M{}
+		unset := bson.M{}
+		for _, field := range fieldsFromDuplicate {
+			if value, ok := duplicate[field]; ok {
+				set[field] = value
+			} else {
+				unset[field] = ""
+			}
+		}
+		update := bson.M{"$addToSet": bson.M{"merged_from": duplicateId}}
+		if len(set) > 0 {
+			update["$set"] = set
+		}
+		if len(unset) > 0 {
+			update["$unset"] = unset
+		}
+		_, err = coll.UpdateOne(sessCtx, bson.M{"_id": survivorObjID}, update)
+		if err != nil {
+			return err
+		}
+
+		for _, lc := range linked {
+			err = repointLinkedRecords(sessCtx, client.Database("school").Collection(lc.Collection), lc, survivorId, duplicateId)
+			if err != nil {
+				return err
+			}
+		}
+
+		return coll.FindOne(sessCtx, bson.M{"_id": survivorObjID}).Decode(&merged)
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, duplicateKeyError(err, "students")
+		}
+		return nil, utils.ErrorHandler(err, "Error merging students")
+	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bothFilter))
+	for i, lc := range linked {
+		if len(linkedBefore[i]) == 0 {
+			continue
+		}
+		ids := make(bson.A, 0, len(linkedBefore[i]))
+		for _, doc := range linkedBefore[i] {
+			ids = append(ids, doc["_id"])
+		}
+		linkedAfter := auditSnapshot(ctx, client.Database("school").Collection(lc.Collection), bson.M{"_id": bson.M{"$in": ids}})
+		recordAuditChanges(ctx, linkedBefore[i], linkedAfter)
+	}
+
+	pbStudent, err := mapModelStudentToPbStudent(merged)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error mapping student data")
+	}
+	return pbStudent, nil
+}
+
+// mergedLinkedCollections lists the linked collections whose student records
+// a merge re-points. Records matched by email and history such as the audit
+// log are left alone.
+func mergedLinkedCollections() []LinkedCollection {
+	var merged []LinkedCollection
+	for _, lc := range linkedCollections {
+		if lc.MatchEmail || lc.History || !slices.Contains(lc.Kinds, "student") {
+			continue
+		}
+		merged = append(merged, lc)
+	}
+	return merged
+}
+
+// repointLinkedRecords moves the duplicate's records in lc to the survivor.
+// Records that would then clash with one of the survivor's under lc's unique
+// index, e.g. attendance for the same date and period, are deleted first so
+// the survivor's record wins.
+func repointLinkedRecords(ctx context.Context, coll *mongo.Collection, lc LinkedCollection, survivorId, duplicateId string) error {
+	if len(lc.UniqueWith) > 0 {
+		survivorKeys, err := linkedRecordKeys(ctx, coll, lc, survivorId)
+		if err != nil {
+			return err
+		}
+		duplicateKeys, err := linkedRecordKeys(ctx, coll, lc, duplicateId)
+		if err != nil {
+			return err
+		}
+		clashing := clashingRecords(survivorKeys, duplicateKeys)
+		if len(clashing) > 0 {
+			_, err = coll.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": clashing}})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := coll.UpdateMany(ctx, bson.M{lc.Field: duplicateId}, bson.M{"$set": bson.M{lc.Field: survivorId}})
+	return err
+}
+
+// linkedRecordKeys maps the lc.UniqueWith values of each of the person's
+// records in lc to the record's ID.
+func linkedRecordKeys(ctx context.Context, coll *mongo.Collection, lc LinkedCollection, personId string) (map[string]interface{}, error) {
+	projection := bson.M{}
+	for _, field := range lc.UniqueWith {
+		projection[field] = 1
+	}
+	cursor, err := coll.Find(ctx, bson.M{lc.Field: personId}, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	keys := map[string]interface{}{}
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		keys[uniqueKey(doc, lc.UniqueWith)] = doc["_id"]
+	}
+	return keys, cursor.Err()
+}
+
+// uniqueKey joins the values of fields in doc into one comparable string. A
+// missing field compares equal to another missing one, as in a unique index.
+func uniqueKey(doc bson.M, fields []string) string {
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		values[i] = fmt.Sprint(doc[field])
+	}
+	return strings.Join(values, "\x00")
+}
+
+// clashingRecords returns the IDs of the duplicate's records whose key the
+// survivor also has, sorted so the result is stable.
+func clashingRecords(survivorKeys, duplicateKeys map[string]interface{}) bson.A {
+	keys := make([]string, 0, len(duplicateKeys))
+	for key := range duplicateKeys {
+		if _, ok := survivorKeys[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	clashing := make(bson.A, 0, len(keys))
+	for _, key := range keys {
+		clashing = append(clashing, duplicateKeys[key])
+	}
+	return clashing
+}
@@ -0,0 +1,98 @@
+package mongodb
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestClashingRecords(t *testing.T) {
+	attendance := []string{"date", "period"}
+	keys := func(docs ...bson.M) map[string]interface{} {
+		m := map[string]interface{}{}
+		for _, doc := range docs {
+			m[uniqueKey(doc, attendance)] = doc["_id"]
+		}
+		return m
+	}
+
+	tests := []struct {
+		name      string
+		survivor  map[string]interface{}
+		duplicate map[string]interface{}
+		want      bson.A
+	}{
+		{
+			name:      "no overlap",
+			survivor:  keys(bson.M{"_id": "s1", "date": "2026-01-05", "period": int32(1)}),
+			duplicate: keys(bson.M{"_id": "d1", "date": "2026-01-05", "period": int32(2)}),
+			want:      bson.A{},
+		},
+		{
+			name: "same date and period",
+			survivor: keys(
+				bson.M{"_id": "s1", "date": "2026-01-05", "period": int32(1)},
+				bson.M{"_id": "s2", "date": "2026-01-06", "period": int32(1)},
+			),
+			duplicate: keys(
+				bson.M{"_id": "d1", "date": "2026-01-06", "period": int32(1)},
+				bson.M{"_id": "d2", "date": "2026-01-07", "period": int32(1)},
+				bson.M{"_id": "d3", "date": "2026-01-05", "period": int32(1)},
+			),
+			want: bson.A{"d3", "d1"},
+		},
+		{
+			name:      "missing fields clash like nulls",
+			survivor:  keys(bson.M{"_id": "s1", "date": "2026-01-05"}),
+			duplicate: keys(bson.M{"_id": "d1", "date": "2026-01-05"}),
+			want:      bson.A{"d1"},
+		},
+		{
+			name:      "survivor has no records",
+			survivor:  keys(),
+			duplicate: keys(bson.M{"_id": "d1", "date": "2026-01-05", "period": int32(1)}),
+			want:      bson.A{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := clashingRecords(tt.survivor, tt.duplicate)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("clashingRecords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueKeyKeepsFieldsApart(t *testing.T) {
+	fields := []string{"a", "b"}
+	if uniqueKey(bson.M{"a": "x y", "b": "z"}, fields) == uniqueKey(bson.M{"a": "x", "b": "y z"}, fields) {
+		t.Error("uniqueKey() gave the same key for different field values")
+	}
+}
+
+func TestMergedLinkedCollections(t *testing.T) {
+	merged := map[string]LinkedCollection{}
+	for _, lc := range mergedLinkedCollections() {
+		merged[lc.Name()] = lc
+		if lc.History || lc.MatchEmail {
+			t.Errorf("merge re-points %s, which is history or matched by email", lc.Name())
+		}
+	}
+
+	for name, uniqueWith := range map[string][]string{
+		"attendance.student_id": {"date", "period"},
+		"scores.student_id":     {"assessment_id"},
+	} {
+		lc, ok := merged[name]
+		if !ok {
+			t.Errorf("merge does not re-point %s", name)
+			continue
+		}
+		if !reflect.DeepEqual(lc.UniqueWith, uniqueWith) {
+			t.Errorf("%s UniqueWith = %v, want %v", name, lc.UniqueWith, uniqueWith)
+		}
+	}
+}
@@ -0,0 +1,416 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/models"
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// subjectReferences maps each collection that points at subjects to the
+// field holding the subject IDs. A subject cannot be deleted while any of
+// them still references it.
+var subjectReferences = map[string]string{
+	"curricula":            "subject_ids",
+	"teachers":             "qualified_subject_ids",
+	"teaching_assignments": "subject_id",
+	"assessments":          "subject_id",
+}
+
+// EnsureCurriculumIndexesDBHandler keeps one curriculum per grade level.
+func EnsureCurriculumIndexesDBHandler(ctx context.Context) error {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	_, err = client.Database("school").Collection("curricula").Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "grade_level", Value: 1}},
+		Options: options.Index().SetName("unique_grade_level").SetUnique(true),
+	})
+	if err != nil {
+		return utils.ErrorHandler(err, "Error creating curriculum indexes")
+	}
+	return nil
+}
+
+func AddSubjectsDBHandler(ctx context.Context, subjectsFromReq []*pb.Subject) ([]*pb.Subject, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("subjects")
+	var addedSubjects []*pb.Subject
+	for _, pbSubject := range subjectsFromReq {
+		subject, err := mapPbSubjectToModelSubject(pbSubject)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error mapping subject data")
+		}
+		subject.Code = strings.ToUpper(subject.Code)
+
+		result, err := coll.InsertOne(ctx, subject)
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, duplicateKeyError(err, "subjects")
+			}
+			return nil, utils.ErrorHandler(err, "Error adding subject to database")
+		}
+
+		objectId, ok := result.InsertedID.(primitive.ObjectID)
+		if ok {
+			subject.Id = objectId.Hex()
+			recordAuditChanges(ctx, nil, auditSnapshot(ctx, coll, bson.M{"_id": objectId}))
+		}
+
+		added, err := mapModelSubjectToPbSubject(*subject)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error mapping subject data")
+		}
+		addedSubjects = append(addedSubjects, added)
+	}
+	return addedSubjects, nil
+}
+
+func GetSubjectsDBHandler(ctx context.Context, sortOptions primitive.D, filter primitive.M) ([]*pb.Subject, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	if code, ok := filter["code"].(string); ok {
+		filter["code"] = strings.ToUpper(code)
+	}
+	if gradeLevels, ok := filter["grade_levels"].([]int32); ok {
+		filter["grade_levels"] = bson.M{"$all": gradeLevels}
+	}
+
+	findOptions := options.Find()
+	if len(sortOptions) > 0 {
+		findOptions.SetSort(sortOptions)
+	}
+	cursor, err := client.Database("school").Collection("subjects").Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Internal Error")
+	}
+	defer cursor.Close(ctx)
+
+	subjects, err := DecodeEntities(ctx,
+		cursor,
+		func() *pb.Subject { return &pb.Subject{} },
+		func() *models.Subject { return &models.Subject{} })
+	if err != nil {
+		return nil, err
+	}
+	return subjects, nil
+}
+
+func UpdateSubjectsDBHandler(ctx context.Context, pbSubjects []*pb.Subject) ([]*pb.Subject, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	coll := client.Database("school").Collection("subjects")
+	var updatedSubjects []*pb.Subject
+
+	for _, pbSubject := range pbSubjects {
+		if pbSubject.Id == "" {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonMissingID, "Subject ID is required for update")
+		}
+
+		subject, err := mapPbSubjectToModelSubject(pbSubject)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error mapping subject data")
+		}
+		subject.Code = strings.ToUpper(subject.Code)
+
+		objID, err := primitive.ObjectIDFromHex(pbSubject.Id)
+		if err != nil {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+		}
+
+		modelDoc, err := bson.Marshal(subject)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error preparing subject data for update")
+		}
+
+		var updateDoc bson.M
+		if err := bson.Unmarshal(modelDoc, &updateDoc); err != nil {
+			return nil, utils.ErrorHandler(err, "Error preparing subject data for update")
+		}
+
+		delete(updateDoc, "_id")
+
+		before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+		_, err = coll.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": updateDoc})
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, duplicateKeyError(err, "subjects")
+			}
+			return nil, utils.ErrorHandler(err, "Error updating subject data")
+		}
+		recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bson.M{"_id": objID}))
+
+		updated, err := mapModelSubjectToPbSubject(*subject)
+		if err != nil {
+			return nil, utils.ErrorHandler(err, "Error mapping subject data")
+		}
+		updatedSubjects = append(updatedSubjects, updated)
+	}
+	return updatedSubjects, nil
+}
+
+func DeleteSubjectsDBHandler(ctx context.Context, subjectIdsToDelete []string) ([]string, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	objectIds := make([]primitive.ObjectID, 0, len(subjectIdsToDelete))
+	for _, id := range subjectIdsToDelete {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid subject ID format")
+		}
+		objectIds = append(objectIds, objID)
+	}
+
+	err = checkNotReferenced(ctx, client, subjectReferences, subjectIdsToDelete, "Subject")
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": objectIds}}
+	coll := client.Database("school").Collection("subjects")
+	before := auditSnapshot(ctx, coll, filter)
+	result, err := coll.DeleteMany(ctx, filter)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error deleting subjects from database")
+	}
+	recordAuditChanges(ctx, before, nil)
+
+	if result.DeletedCount == 0 {
+		return nil, utils.NotFoundError(utils.ReasonNotFound, "No subjects found to delete")
+	}
+
+	deletedIds := make([]string, 0, len(objectIds))
+	for _, objID := range objectIds {
+		deletedIds = append(deletedIds, objID.Hex())
+	}
+	return deletedIds, nil
+}
+
+// GetCurriculumDBHandler returns the curriculum of gradeLevel with its
+// subjects filled in. A grade without a curriculum gets an empty one.
+func GetCurriculumDBHandler(ctx context.Context, gradeLevel int32) (*pb.Curriculum, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	var curriculum models.Curriculum
+	err = client.Database("school").Collection("curricula").FindOne(ctx, bson.M{"grade_level": gradeLevel}).Decode(&curriculum)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, utils.ErrorHandler(err, "Error fetching curriculum")
+	}
+
+	subjects, err := loadSubjects(ctx, client, curriculum.SubjectIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Curriculum{GradeLevel: gradeLevel, SubjectIds: curriculum.SubjectIds, Subjects: subjects}, nil
+}
+
+// SetCurriculumDBHandler replaces the subjects of gradeLevel. Every subject
+// must exist and be offered in that grade.
+func SetCurriculumDBHandler(ctx context.Context, gradeLevel int32, subjectIds []string) (*pb.Curriculum, error) {
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	subjects, err := checkSubjects(ctx, client, subjectIds)
+	if err != nil {
+		return nil, err
+	}
+	err = checkOfferedIn(subjects, gradeLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	coll := client.Database("school").Collection("curricula")
+	filter := bson.M{"grade_level": gradeLevel}
+	before := auditSnapshot(ctx, coll, filter)
+	_, err = coll.UpdateOne(ctx, filter,
+		bson.M{"$set": models.Curriculum{GradeLevel: gradeLevel, SubjectIds: subjectIds}},
+		options.Update().SetUpsert(true))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error saving curriculum")
+	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, filter))
+
+	return &pb.Curriculum{GradeLevel: gradeLevel, SubjectIds: subjectIds, Subjects: subjects}, nil
+}
+
+func GetTeacherQualificationsDBHandler(ctx context.Context, teacherId string) ([]string, error) {
+	objID, err := primitive.ObjectIDFromHex(teacherId)
+	if err != nil {
+		return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid teacher ID format")
+	}
+
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	var teacher struct {
+		QualifiedSubjectIds []string `bson:"qualified_subject_ids"`
+	}
+	err = client.Database("school").Collection("teachers").FindOne(ctx, bson.M{"_id": objID},
+		options.FindOne().SetProjection(bson.M{"qualified_subject_ids": 1})).Decode(&teacher)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, utils.NotFoundError(utils.ReasonNotFound, "Teacher not found")
+		}
+		return nil, utils.ErrorHandler(err, "Error fetching teacher data")
+	}
+	return teacher.QualifiedSubjectIds, nil
+}
+
+// SetTeacherQualificationsDBHandler replaces the subjects teacherId may be
+// assigned to teach. A qualification can't be dropped while the teacher still
+// has assignments in that subject.
+func SetTeacherQualificationsDBHandler(ctx context.Context, teacherId string, subjectIds []string) ([]string, error) {
+	objID, err := primitive.ObjectIDFromHex(teacherId)
+	if err != nil {
+		return nil, utils.InvalidArgumentError("teacher_id", utils.ReasonInvalidID, "Invalid teacher ID format")
+	}
+
+	client, err := CreateMongoClient(ctx)
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Database connection error")
+	}
+	defer client.Disconnect(ctx)
+
+	if _, err := checkSubjects(ctx, client, subjectIds); err != nil {
+		return nil, err
+	}
+
+	count, err := client.Database("school").Collection("teaching_assignments").CountDocuments(ctx,
+		bson.M{"teacher_id": teacherId, "subject_id": bson.M{"$nin": subjectIds}},
+		options.Count().SetLimit(1))
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error checking teacher assignments")
+	}
+	if count > 0 {
+		return nil, utils.ConflictError(utils.ReasonInUse, "Teacher still has assignments in a subject being removed")
+	}
+
+	if subjectIds == nil {
+		subjectIds = []string{}
+	}
+	coll := client.Database("school").Collection("teachers")
+	before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+	result, err := coll.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": bson.M{"qualified_subject_ids": subjectIds}})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error updating teacher qualifications")
+	}
+	if result.MatchedCount == 0 {
+		return nil, utils.NotFoundError(utils.ReasonNotFound, "Teacher not found")
+	}
+	recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bson.M{"_id": objID}))
+
+	return subjectIds, nil
+}
+
+// checkSubjects loads the subjects in subjectIds, failing with an
+// UNKNOWN_REFERENCE error on subject_ids if any of them does not exist.
+func checkSubjects(ctx context.Context, client *mongo.Client, subjectIds []string) ([]*pb.Subject, error) {
+	subjects, err := loadSubjects(ctx, client, subjectIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(subjects) != len(subjectIds) {
+		return nil, utils.InvalidArgumentError("subject_ids", utils.ReasonUnknownReference, "Subject not found")
+	}
+	return subjects, nil
+}
+
+// loadSubjects returns the subjects in subjectIds, in that order. IDs that
+// match no subject are skipped.
+func loadSubjects(ctx context.Context, client *mongo.Client, subjectIds []string) ([]*pb.Subject, error) {
+	if len(subjectIds) == 0 {
+		return nil, nil
+	}
+
+	objectIds := make([]primitive.ObjectID, 0, len(subjectIds))
+	for _, id := range subjectIds {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, utils.InvalidArgumentError("subject_ids", utils.ReasonInvalidID, "Invalid subject ID format")
+		}
+		objectIds = append(objectIds, objID)
+	}
+
+	cursor, err := client.Database("school").Collection("subjects").Find(ctx, bson.M{"_id": bson.M{"$in": objectIds}})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error fetching subjects")
+	}
+	defer cursor.Close(ctx)
+
+	found, err := DecodeEntities(ctx,
+		cursor,
+		func() *pb.Subject { return &pb.Subject{} },
+		func() *models.Subject { return &models.Subject{} })
+	if err != nil {
+		return nil, err
+	}
+
+	return orderSubjects(found, subjectIds), nil
+}
+
+// orderSubjects returns found in the order of subjectIds, skipping IDs that
+// match none of them. IDs may be given in either case.
+func orderSubjects(found []*pb.Subject, subjectIds []string) []*pb.Subject {
+	byId := make(map[string]*pb.Subject, len(found))
+	for _, subject := range found {
+		byId[subject.Id] = subject
+	}
+	subjects := make([]*pb.Subject, 0, len(found))
+	for _, id := range subjectIds {
+		if subject, ok := byId[strings.ToLower(id)]; ok {
+			subjects = append(subjects, subject)
+		}
+	}
+	return subjects
+}
+
+// checkOfferedIn returns an InvalidArgument error on subject_ids naming the
+// first subject not offered in gradeLevel. A subject without grade levels is
+// offered in every grade.
+func checkOfferedIn(subjects []*pb.Subject, gradeLevel int32) error {
+	for _, subject := range subjects {
+		if len(subject.GradeLevels) > 0 && !slices.Contains(subject.GradeLevels, gradeLevel) {
+			return utils.InvalidArgumentError("subject_ids", utils.ReasonValidationFailed,
+				fmt.Sprintf("Subject %s is not offered in grade %d", subject.Code, gradeLevel))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package mongodb
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/pkg/utils"
+	pb "github.com/aayushxrj/go-gRPC-api-school-mgmt/proto/gen"
+)
+
+func TestCheckOfferedIn(t *testing.T) {
+	algebra := &pb.Subject{Code: "ALG", GradeLevels: []int32{8, 9}}
+	calculus := &pb.Subject{Code: "CALC", GradeLevels: []int32{11, 12}}
+	art := &pb.Subject{Code: "ART"}
+
+	tests := []struct {
+		name       string
+		subjects   []*pb.Subject
+		gradeLevel int32
+		wantErr    string
+	}{
+		{"empty curriculum", nil, 9, ""},
+		{"offered", []*pb.Subject{algebra}, 9, ""},
+		{"offered in every grade", []*pb.Subject{art}, 1, ""},
+		{"not offered", []*pb.Subject{algebra, calculus}, 9, "Subject CALC is not offered in grade 9"},
+		{"first subject not offered", []*pb.Subject{calculus, art}, 10, "Subject CALC is not offered in grade 10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkOfferedIn(tt.subjects, tt.gradeLevel)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkOfferedIn() = %v, want nil", err)
+				}
+				return
+			}
+
+			var domainErr *utils.Error
+			if !errors.As(err, &domainErr) || domainErr.Kind != utils.KindInvalidArgument || domainErr.Field != "subject_ids" {
+				t.Fatalf("checkOfferedIn() = %v, want an invalid subject_ids error", err)
+			}
+			if domainErr.Message != tt.wantErr {
+				t.Errorf("checkOfferedIn() message = %q, want %q", domainErr.Message, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOrderSubjects(t *testing.T) {
+	math := &pb.Subject{Id: "0123456789abcdef0123456a", Code: "MATH"}
+	art := &pb.Subject{Id: "0123456789abcdef0123456b", Code: "ART"}
+	found := []*pb.Subject{math, art}
+
+	tests := []struct {
+		name string
+		ids  []string
+		want []*pb.Subject
+	}{
+		{"requested order", []string{art.Id, math.Id}, []*pb.Subject{art, math}},
+		{"upper case IDs", []string{"0123456789ABCDEF0123456A"}, []*pb.Subject{math}},
+		{"unknown IDs skipped", []string{"ffffffffffffffffffffffff", math.Id}, []*pb.Subject{math}},
+		{"none", nil, []*pb.Subject{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := orderSubjects(found, tt.ids); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("orderSubjects(%v) = %v, want %v", tt.ids, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubjectCodesAreUnique(t *testing.T) {
+	for _, u := range uniqueIndexes {
+		if u.collection == "subjects" && u.field == "code" {
+			return
+		}
+	}
+	t.Error("subjects.code has no unique index")
+}
@@ -10,8 +10,6 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func AddTeachersDBHandler(ctx context.Context, teachersFromReq []*pb.Teacher) ([]*pb.Teacher, error) {
@@ -27,6 +25,10 @@ func AddTeachersDBHandler(ctx context.Context, teachersFromReq []*pb.Teacher) ([
 		if err != nil {
 			return nil, utils.ErrorHandler(err, "Error mapping teacher data")
 		}
+		err = checkReference(ctx, client, "classes", "class_id", pbTeacher.ClassId, "Class not found")
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// fmt.Println(newTeachers)
@@ -35,12 +37,16 @@ func AddTeachersDBHandler(ctx context.Context, teachersFromReq []*pb.Teacher) ([
 	for _, teacher := range newTeachers {
 		result, err := client.Database("school").Collection("teachers").InsertOne(ctx, teacher)
 		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, duplicateKeyError(err, "teachers")
+			}
 			return nil, utils.ErrorHandler(err, "Error adding teacher to database")
 		}
 
 		objectId, ok := result.InsertedID.(primitive.ObjectID)
 		if ok {
 			teacher.Id = objectId.Hex()
+			recordAuditChanges(ctx, nil, auditSnapshot(ctx, client.Database("school").Collection("teachers"), bson.M{"_id": objectId}))
 		}
 
 		pbTeacher, err := mapModelTeacherToPbTeacher(*teacher)
@@ -84,7 +90,7 @@ func GetTeachersDBHandler(ctx context.Context, sortOptions primitive.D, filter p
 func UpdateTeachersDBHandler(ctx context.Context, pbTeachers []*pb.Teacher) ([]*pb.Teacher, error) {
 	client, err := CreateMongoClient(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Database connection error")
+		return nil, utils.ErrorHandler(err, "Database connection error")
 	}
 	defer client.Disconnect(ctx)
 
@@ -93,42 +99,53 @@ func UpdateTeachersDBHandler(ctx context.Context, pbTeachers []*pb.Teacher) ([]*
 	for _, teacher := range pbTeachers {
 
 		if teacher.Id == "" {
-			return nil, status.Error(codes.InvalidArgument, "Teacher ID is required for update")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonMissingID, "Teacher ID is required for update")
 		}
 
 		modelTeacher, err := mapPbTeacherToModelTeacher(teacher)
 		if err != nil {
-			return nil, status.Error(codes.Internal, "Error mapping teacher data")
+			return nil, utils.ErrorHandler(err, "Error mapping teacher data")
 		}
 
 		objID, err := primitive.ObjectIDFromHex(teacher.Id)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "Invalid ID format")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid ID format")
+		}
+
+		err = checkReference(ctx, client, "classes", "class_id", modelTeacher.ClassId, "Class not found")
+		if err != nil {
+			return nil, err
 		}
 
 		// converting modelTeacher to bson Document
 		modelDoc, err := bson.Marshal(modelTeacher)
 		if err != nil {
-			return nil, status.Error(codes.Internal, "Error preparing teacher data for update")
+			return nil, utils.ErrorHandler(err, "Error preparing teacher data for update")
 		}
 
 		var updateDoc bson.M
 		err = bson.Unmarshal(modelDoc, &updateDoc)
 		if err != nil {
-			return nil, status.Error(codes.Internal, "Error preparing teacher data for update")
+			return nil, utils.ErrorHandler(err, "Error preparing teacher data for update")
 		}
 
 		// remove the _id field from the update document
 		delete(updateDoc, "_id")
 
-		_, err = client.Database("school").Collection("teachers").UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": updateDoc})
+		coll := client.Database("school").Collection("teachers")
+		before := auditSnapshot(ctx, coll, bson.M{"_id": objID})
+		_, err = coll.UpdateOne(ctx, bson.M{"_id": objID}, bson.M{"$set": updateDoc})
 		if err != nil {
-			return nil, status.Error(codes.Internal, "Error updating teacher data")
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, duplicateKeyError(err, "teachers")
+			}
+			return nil, utils.ErrorHandler(err, "Error updating teacher data")
 		}
+		recordAuditChanges(ctx, before, auditSnapshot(ctx, coll, bson.M{"_id": objID}))
 
 		updatedTeacher, err := mapModelTeacherToPbTeacher(*modelTeacher)
 		if err != nil {
-			return nil, status.Error(codes.Internal, "Error mapping teacher data")
+			return nil, utils.ErrorHandler(err, "Error mapping teacher data")
 		}
 
 		updatedTeachers = append(updatedTeachers, updatedTeacher)
@@ -146,23 +163,26 @@ func DeleteTeachersDBHandler(ctx context.Context, teacherIdsToDelete []string) (
 	objectIds := make([]primitive.ObjectID, len(teacherIdsToDelete))
 	for i, id := range teacherIdsToDelete {
 		if id == "" {
-			return nil, utils.ErrorHandler(err, "Teacher ID is required for deletion")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonMissingID, "Teacher ID is required for deletion")
 		}
 		objID, err := primitive.ObjectIDFromHex(id)
 		if err != nil {
-			return nil, utils.ErrorHandler(err, "Invalid teacher ID format")
+			return nil, utils.InvalidArgumentError("id", utils.ReasonInvalidID, "Invalid teacher ID format")
 		}
 		objectIds[i] = objID
 	}
 
 	filter := bson.M{"_id": bson.M{"$in": objectIds}}
-	result, err := client.Database("school").Collection("teachers").DeleteMany(ctx, filter)
+	coll := client.Database("school").Collection("teachers")
+	before := auditSnapshot(ctx, coll, filter)
+	result, err := coll.DeleteMany(ctx, filter)
 	if err != nil {
 		return nil, utils.ErrorHandler(err, "Error deleting teachers from database")
 	}
+	recordAuditChanges(ctx, before, nil)
 
 	if result.DeletedCount == 0 {
-		return nil, status.Error(codes.NotFound, "No teachers found to delete")
+		return nil, utils.NotFoundError(utils.ReasonNotFound, "No teachers found to delete")
 	}
 
 	deletedIds := make([]string, result.DeletedCount)
@@ -171,5 +191,24 @@ func DeleteTeachersDBHandler(ctx context.Context, teacherIdsToDelete []string) (
 		deletedIds[i] = objID.Hex()
 	}
 
+	// classes keep no homeroom teacher rather than point at a deleted one
+	_, err = client.Database("school").Collection("classes").UpdateMany(ctx,
+		bson.M{"homeroom_teacher_id": bson.M{"$in": deletedIds}},
+		bson.M{"$unset": bson.M{"homeroom_teacher_id": ""}})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error clearing homeroom teachers")
+	}
+	_, err = client.Database("school").Collection("execs").UpdateMany(ctx,
+		bson.M{"teacher_id": bson.M{"$in": deletedIds}},
+		bson.M{"$unset": bson.M{"teacher_id": ""}})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error unlinking teacher accounts")
+	}
+	_, err = client.Database("school").Collection("teaching_assignments").DeleteMany(ctx,
+		bson.M{"teacher_id": bson.M{"$in": deletedIds}})
+	if err != nil {
+		return nil, utils.ErrorHandler(err, "Error removing teaching assignments")
+	}
+
 	return deletedIds, nil
 }
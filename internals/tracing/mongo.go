@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewMongoMonitor opens a client span for every MongoDB command, as a child of
+// whatever span is in the operation's context. Command bodies are not recorded
+// since they carry personal data.
+func NewMongoMonitor() *event.CommandMonitor {
+	var spans sync.Map // request ID -> trace.Span
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			attrs := []attribute.KeyValue{
+				attribute.String("db.system", "mongodb"),
+				attribute.String("db.name", e.DatabaseName),
+				attribute.String("db.operation", e.CommandName),
+			}
+			if coll, ok := e.Command.Lookup(e.CommandName).StringValueOK(); ok {
+				attrs = append(attrs, attribute.String("db.mongodb.collection", coll))
+			}
+			_, span := Tracer().Start(ctx, "mongodb."+e.CommandName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(attrs...),
+			)
+			spans.Store(e.RequestID, span)
+		},
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			if span, ok := spans.LoadAndDelete(e.RequestID); ok {
+				span.(trace.Span).End()
+			}
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			if span, ok := spans.LoadAndDelete(e.RequestID); ok {
+				span.(trace.Span).SetStatus(codes.Error, e.Failure)
+				span.(trace.Span).End()
+			}
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aayushxrj/go-gRPC-api-school-mgmt/internals/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const instrumentationName = "github.com/aayushxrj/go-gRPC-api-school-mgmt"
+
+// Tracer returns the tracer used for spans created by this service.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Init installs the global tracer provider and the W3C trace context
+// propagator. The exporter is "otlp" (configured through the standard
+// OTEL_EXPORTER_OTLP_* variables), "stdout" for offline testing, or "none".
+// The returned function flushes pending spans.
+func Init(ctx context.Context, cfg config.TracingConfig) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case "otlp":
+		exporter, err = otlptracegrpc.New(ctx)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "none":
+		return func(context.Context) error { return nil }, nil
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// WrapUnary runs interceptor inside its own span, so the time spent in each
+// link of the chain shows up separately in a trace.
+func WrapUnary(name string, interceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	spanName := "interceptor." + name
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := Tracer().Start(ctx, spanName)
+		defer span.End()
+
+		resp, err := interceptor(ctx, req, info, handler)
+		RecordError(span, err)
+		return resp, err
+	}
+}
+
+func WrapStream(name string, interceptor grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	spanName := "interceptor." + name
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := Tracer().Start(ss.Context(), spanName)
+		defer span.End()
+
+		err := interceptor(srv, &tracedStream{ServerStream: ss, ctx: ctx}, info, handler)
+		RecordError(span, err)
+		return err
+	}
+}
+
+// HandlerInterceptor should be last in the chain, followed only by error
+// mapping so the span records the final status. It opens the span for the
+// service method itself.
+func HandlerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, span := Tracer().Start(ctx, "handler "+info.FullMethod)
+	defer span.End()
+
+	resp, err := handler(ctx, req)
+	RecordError(span, err)
+	return resp, err
+}
+
+// RecordError marks span as failed and attaches the gRPC status of err.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	st, _ := status.FromError(err)
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", st.Code().String()))
+	span.SetStatus(codes.Error, st.Message())
+}
+
+type tracedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tracedStream) Context() context.Context {
+	return s.ctx
+}
@@ -2,7 +2,6 @@ package utils
 
 import (
 	"context"
-	"errors"
 )
 
 type ContextKey string
@@ -10,7 +9,7 @@ type ContextKey string
 func AuthorizeUser(ctx context.Context, allowedRoles ...string) error {
 	userRole, ok := ctx.Value(ContextKey("role")).(string)
 	if !ok {
-		return errors.New("user not authorized for access: role not found")
+		return PermissionDeniedError(ReasonPermissionDenied, "user not authorized for access: role not found")
 	}
 
 	for _, allowedRole := range allowedRoles {
@@ -18,5 +17,5 @@ func AuthorizeUser(ctx context.Context, allowedRoles ...string) error {
 			return nil
 		}
 	}
-	return errors.New("user not authorized for access")
+	return PermissionDeniedError(ReasonPermissionDenied, "user not authorized for access")
 }
\ No newline at end of file
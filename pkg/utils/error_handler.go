@@ -1,13 +1,19 @@
 package utils
 
 import (
-	"fmt"
-	"log"
-	"os"
+	"errors"
+	"log/slog"
 )
 
+// ErrorHandler logs err and returns an error carrying message. Domain errors
+// are returned unchanged; anything else becomes an internal error that keeps
+// err as its cause.
 func ErrorHandler(err error, message string) error {
-	errorLogger := log.New(os.Stderr, "ERROR: ", log.Ldate|log.Ltime|log.Lshortfile)
-	errorLogger.Println(message, err)
-	return fmt.Errorf("%s", message)
-}
\ No newline at end of file
+	logAtCaller(1, slog.LevelError, message, slog.Any("error", err))
+
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return err
+	}
+	return &Error{Kind: KindInternal, Reason: ReasonInternal, Message: message, Err: err}
+}
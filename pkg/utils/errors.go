@@ -0,0 +1,88 @@
+package utils
+
+// ErrorKind classifies a domain error. The API layer maps each kind to a gRPC
+// status code, so the repository layer never has to know about gRPC.
+type ErrorKind int
+
+const (
+	KindInternal ErrorKind = iota
+	KindNotFound
+	KindAlreadyExists
+	KindInvalidArgument
+	KindConflict
+	KindPermissionDenied
+)
+
+// Reasons are stable, machine readable identifiers sent to clients in the
+// ErrorInfo detail. Clients should switch on these, not on messages.
+const (
+	ReasonInternal          = "INTERNAL"
+	ReasonValidationFailed  = "VALIDATION_FAILED"
+	ReasonMissingID         = "MISSING_ID"
+	ReasonInvalidID         = "INVALID_ID"
+	ReasonNotFound          = "NOT_FOUND"
+	ReasonAlreadyExists     = "ALREADY_EXISTS"
+	ReasonInvalidToken      = "INVALID_OR_EXPIRED_TOKEN"
+	ReasonIncorrectPassword = "INCORRECT_PASSWORD"
+	ReasonEmailUnchanged    = "EMAIL_UNCHANGED"
+	ReasonLegalHold         = "LEGAL_HOLD"
+	ReasonAccountInactive   = "ACCOUNT_INACTIVE"
+	ReasonAccountPending    = "ACCOUNT_PENDING"
+	ReasonPermissionDenied  = "PERMISSION_DENIED"
+	ReasonUnknownReference  = "UNKNOWN_REFERENCE"
+	ReasonInUse             = "IN_USE"
+	ReasonNotQualified      = "NOT_QUALIFIED"
+)
+
+// Error is a domain error. Message is safe to show to clients; Err, if set,
+// is the underlying cause. It stays reachable through errors.Is/As but is
+// left out of Error() so it never leaks into a response.
+type Error struct {
+	Kind    ErrorKind
+	Reason  string
+	Message string
+	// Field names the offending field, for KindInvalidArgument and
+	// KindAlreadyExists.
+	Field string
+	Err   error
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Is reports whether target is a domain error of the same kind, so callers can
+// write errors.Is(err, utils.ErrNotFound).
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Reason == "" && t.Kind == e.Kind
+}
+
+// Sentinels for errors.Is checks.
+var (
+	ErrNotFound         = &Error{Kind: KindNotFound}
+	ErrAlreadyExists    = &Error{Kind: KindAlreadyExists}
+	ErrInvalidArgument  = &Error{Kind: KindInvalidArgument}
+	ErrConflict         = &Error{Kind: KindConflict}
+	ErrPermissionDenied = &Error{Kind: KindPermissionDenied}
+)
+
+func NotFoundError(reason, message string) error {
+	return &Error{Kind: KindNotFound, Reason: reason, Message: message}
+}
+
+func AlreadyExistsError(field, reason, message string) error {
+	return &Error{Kind: KindAlreadyExists, Reason: reason, Message: message, Field: field}
+}
+
+func InvalidArgumentError(field, reason, message string) error {
+	return &Error{Kind: KindInvalidArgument, Reason: reason, Message: message, Field: field}
+}
+
+func ConflictError(reason, message string) error {
+	return &Error{Kind: KindConflict, Reason: reason, Message: message}
+}
+
+func PermissionDeniedError(reason, message string) error {
+	return &Error{Kind: KindPermissionDenied, Reason: reason, Message: message}
+}
@@ -1,36 +1,41 @@
 package utils
 
 import (
-	"os"
+	"context"
 	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func SignToken(userId string, username, role string) (string, error) {
-	jwtSecret := os.Getenv("JWT_SECRET")
-	jwtExpiresIn := os.Getenv("JWT_EXPIRES_IN")
+var (
+	jwtSecret    []byte
+	jwtExpiresIn = 15 * time.Minute
+)
+
+// ConfigureJWT sets the secret used to sign and verify tokens and the lifetime
+// of newly issued ones. It must be called before the server starts.
+func ConfigureJWT(secret string, expiresIn time.Duration) {
+	jwtSecret = []byte(secret)
+	jwtExpiresIn = expiresIn
+}
 
+// JWTSecret returns the configured signing secret.
+func JWTSecret() []byte {
+	return jwtSecret
+}
+
+func SignToken(userId string, username, role string) (string, error) {
 	claims := jwt.MapClaims{
 		"uid":  userId,
 		"user": username,
 		"role": role,
-	}
-
-	if jwtExpiresIn != "" {
-		duration, err := time.ParseDuration(jwtExpiresIn)
-		if err != nil {
-			return "", ErrorHandler(err, "Internal error")
-		}
-		claims["exp"] = jwt.NewNumericDate(time.Now().Add(duration))
-	} else {
-		claims["exp"] = jwt.NewNumericDate(time.Now().Add(15 * time.Minute))
+		"exp":  jwt.NewNumericDate(time.Now().Add(jwtExpiresIn)),
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	signedToken, err := token.SignedString([]byte(jwtSecret))
+	signedToken, err := token.SignedString(jwtSecret)
 	if err != nil {
 		return "", ErrorHandler(err, "Internal error")
 	}
@@ -53,9 +58,18 @@ func (store *JWTStore) AddToken(token string, expiryTime time.Time) {
 	store.Tokens[token] = expiryTime
 }
 
-func (store *JWTStore) CleanUpExpiredTokens() {
+// CleanUpExpiredTokens drops expired tokens every couple of minutes until ctx
+// is cancelled.
+func (store *JWTStore) CleanUpExpiredTokens(ctx context.Context) {
+	ticker := time.NewTicker(2 * time.Minute)
+	defer ticker.Stop()
+
 	for {
-		time.Sleep(2 * time.Minute)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
 
 		store.mu.Lock()
 		for token, timeStamp := range store.Tokens {
@@ -67,6 +81,12 @@ func (store *JWTStore) CleanUpExpiredTokens() {
 	}
 }
 
+func (store *JWTStore) Size() int {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	return len(store.Tokens)
+}
+
 func (store *JWTStore) IsLoggedOut(token string) bool {
 	store.mu.Lock()
 	defer store.mu.Unlock()
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Logger is the process wide structured logger. Request scoped code should
+// prefer LoggerFromContext, which carries the request ID, method and caller.
+var Logger = slog.New(newLogHandler(os.Stderr, slog.LevelInfo, true))
+
+// sensitiveKeys are attribute names whose values are never logged.
+var sensitiveKeys = []string{"password", "token", "secret", "authorization", "reset_code"}
+
+// InitLogger configures Logger with a level (debug, info, warn, error) and a
+// format (json or text). It also routes the standard log package through the
+// same handler.
+func InitLogger(levelName, format string) {
+	level := slog.LevelInfo
+	switch strings.ToLower(levelName) {
+	case "debug":
+		level = slog.LevelDebug
+	case "warn":
+		level = slog.LevelWarn
+	case "error":
+		level = slog.LevelError
+	}
+
+	jsonOutput := strings.ToLower(format) != "text"
+
+	Logger = slog.New(newLogHandler(os.Stderr, level, jsonOutput))
+	slog.SetDefault(Logger)
+}
+
+func newLogHandler(w io.Writer, level slog.Level, jsonOutput bool) slog.Handler {
+	opts := &slog.HandlerOptions{
+		Level:       level,
+		AddSource:   true,
+		ReplaceAttr: redactAttr,
+	}
+	if jsonOutput {
+		return slog.NewJSONHandler(w, opts)
+	}
+	return slog.NewTextHandler(w, opts)
+}
+
+func redactAttr(groups []string, attr slog.Attr) slog.Attr {
+	key := strings.ToLower(attr.Key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return slog.String(attr.Key, "[REDACTED]")
+		}
+	}
+	return attr
+}
+
+// FlushLogs syncs stderr so nothing is lost when the process exits.
+func FlushLogs() {
+	_ = os.Stderr.Sync()
+}
+
+type loggerKey struct{}
+
+func ContextWithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return Logger
+}
+
+// logAtCaller logs msg with the source location of the function skip frames
+// above it, so helpers such as ErrorHandler report where they were called from.
+func logAtCaller(skip int, level slog.Level, msg string, attrs ...slog.Attr) {
+	if !Logger.Enabled(context.Background(), level) {
+		return
+	}
+	var pcs [1]uintptr
+	runtime.Callers(skip+2, pcs[:])
+	record := slog.NewRecord(time.Now(), level, msg, pcs[0])
+	record.AddAttrs(attrs...)
+	_ = Logger.Handler().Handle(context.Background(), record)
+}
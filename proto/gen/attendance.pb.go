@@ -0,0 +1,771 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: attendance.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AttendanceStatus int32
+
+const (
+	AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED AttendanceStatus = 0
+	AttendanceStatus_PRESENT                       AttendanceStatus = 1
+	AttendanceStatus_ABSENT                        AttendanceStatus = 2
+	AttendanceStatus_LATE                          AttendanceStatus = 3
+	AttendanceStatus_EXCUSED                       AttendanceStatus = 4
+)
+
+// Enum value maps for AttendanceStatus.
+var (
+	AttendanceStatus_name = map[int32]string{
+		0: "ATTENDANCE_STATUS_UNSPECIFIED",
+		1: "PRESENT",
+		2: "ABSENT",
+		3: "LATE",
+		4: "EXCUSED",
+	}
+	AttendanceStatus_value = map[string]int32{
+		"ATTENDANCE_STATUS_UNSPECIFIED": 0,
+		"PRESENT":                       1,
+		"ABSENT":                        2,
+		"LATE":                          3,
+		"EXCUSED":                       4,
+	}
+)
+
+func (x AttendanceStatus) Enum() *AttendanceStatus {
+	p := new(AttendanceStatus)
+	*p = x
+	return p
+}
+
+func (x AttendanceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttendanceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_attendance_proto_enumTypes[0].Descriptor()
+}
+
+func (AttendanceStatus) Type() protoreflect.EnumType {
+	return &file_attendance_proto_enumTypes[0]
+}
+
+func (x AttendanceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttendanceStatus.Descriptor instead.
+func (AttendanceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{0}
+}
+
+type MarkAttendanceRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ClassId string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// date is a calendar date, YYYY-MM-DD
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// period is the lesson number, or 0 for whole-day attendance
+	Period        int32             `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	Marks         []*AttendanceMark `protobuf:"bytes,4,rep,name=marks,proto3" json:"marks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAttendanceRequest) Reset() {
+	*x = MarkAttendanceRequest{}
+	mi := &file_attendance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAttendanceRequest) ProtoMessage() {}
+
+func (x *MarkAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*MarkAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MarkAttendanceRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *MarkAttendanceRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MarkAttendanceRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *MarkAttendanceRequest) GetMarks() []*AttendanceMark {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+type AttendanceMark struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Status        AttendanceStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=main.AttendanceStatus" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceMark) Reset() {
+	*x = AttendanceMark{}
+	mi := &file_attendance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceMark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceMark) ProtoMessage() {}
+
+func (x *AttendanceMark) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceMark.ProtoReflect.Descriptor instead.
+func (*AttendanceMark) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttendanceMark) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AttendanceMark) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AttendanceMark) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AttendanceCorrection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        AttendanceStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=main.AttendanceStatus" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceCorrection) Reset() {
+	*x = AttendanceCorrection{}
+	mi := &file_attendance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceCorrection) ProtoMessage() {}
+
+func (x *AttendanceCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceCorrection.ProtoReflect.Descriptor instead.
+func (*AttendanceCorrection) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AttendanceCorrection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceCorrection) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AttendanceCorrection) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AttendanceCorrection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AttendanceRecord struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId string                 `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	ClassId   string                 `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Date      string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Period    int32                  `protobuf:"varint,5,opt,name=period,proto3" json:"period,omitempty"`
+	Status    AttendanceStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=main.AttendanceStatus" json:"status,omitempty"`
+	Note      string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	// recorded_by is the ID of the account that made the latest change
+	RecordedBy string `protobuf:"bytes,8,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	RecordedAt string `protobuf:"bytes,9,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	// history holds earlier states of the record, oldest first
+	History       []*AttendanceChange `protobuf:"bytes,10,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRecord) Reset() {
+	*x = AttendanceRecord{}
+	mi := &file_attendance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecord) ProtoMessage() {}
+
+func (x *AttendanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecord.ProtoReflect.Descriptor instead.
+func (*AttendanceRecord) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AttendanceRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *AttendanceRecord) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AttendanceRecord) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+func (x *AttendanceRecord) GetHistory() []*AttendanceChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// AttendanceChange is a state a record had before it was changed, and why it
+// was changed.
+type AttendanceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        AttendanceStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=main.AttendanceStatus" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	RecordedBy    string                 `protobuf:"bytes,3,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	RecordedAt    string                 `protobuf:"bytes,4,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceChange) Reset() {
+	*x = AttendanceChange{}
+	mi := &file_attendance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceChange) ProtoMessage() {}
+
+func (x *AttendanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceChange.ProtoReflect.Descriptor instead.
+func (*AttendanceChange) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AttendanceChange) GetStatus() AttendanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttendanceStatus_ATTENDANCE_STATUS_UNSPECIFIED
+}
+
+func (x *AttendanceChange) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AttendanceChange) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return ""
+}
+
+func (x *AttendanceChange) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+func (x *AttendanceChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AttendanceRecords struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*AttendanceRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRecords) Reset() {
+	*x = AttendanceRecords{}
+	mi := &file_attendance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRecords) ProtoMessage() {}
+
+func (x *AttendanceRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRecords.ProtoReflect.Descriptor instead.
+func (*AttendanceRecords) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AttendanceRecords) GetRecords() []*AttendanceRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type StudentAttendanceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// from_date and to_date bound the range, both inclusive
+	FromDate      string `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate        string `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentAttendanceRequest) Reset() {
+	*x = StudentAttendanceRequest{}
+	mi := &file_attendance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentAttendanceRequest) ProtoMessage() {}
+
+func (x *StudentAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*StudentAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StudentAttendanceRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentAttendanceRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *StudentAttendanceRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type ClassAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassAttendanceRequest) Reset() {
+	*x = ClassAttendanceRequest{}
+	mi := &file_attendance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassAttendanceRequest) ProtoMessage() {}
+
+func (x *ClassAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attendance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*ClassAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_attendance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ClassAttendanceRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *ClassAttendanceRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+var File_attendance_proto protoreflect.FileDescriptor
+
+const file_attendance_proto_rawDesc = "" +
+	"\n" +
+	"\x10attendance.proto\x12\x04main\x1a\x17validate/validate.proto\"\xe2\x01\n" +
+	"\x15MarkAttendanceRequest\x127\n" +
+	"\bclass_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\aclassId\x127\n" +
+	"\x04date\x18\x02 \x01(\tB#\xfaB r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\x04date\x12!\n" +
+	"\x06period\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x00R\x06period\x124\n" +
+	"\x05marks\x18\x04 \x03(\v2\x14.main.AttendanceMarkB\b\xfaB\x05\x92\x01\x02\b\x01R\x05marks\"\xa7\x01\n" +
+	"\x0eAttendanceMark\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12:\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.main.AttendanceStatusB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06status\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x04note\"\xc2\x01\n" +
+	"\x14AttendanceCorrection\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\x12:\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.main.AttendanceStatusB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x06status\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xc8\x01R\x04note\x12\"\n" +
+	"\x06reason\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\x06reason\"\xc0\x02\n" +
+	"\x10AttendanceRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x02 \x01(\tR\tstudentId\x12\x19\n" +
+	"\bclass_id\x18\x03 \x01(\tR\aclassId\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x16\n" +
+	"\x06period\x18\x05 \x01(\x05R\x06period\x12.\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x16.main.AttendanceStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x1f\n" +
+	"\vrecorded_by\x18\b \x01(\tR\n" +
+	"recordedBy\x12\x1f\n" +
+	"\vrecorded_at\x18\t \x01(\tR\n" +
+	"recordedAt\x120\n" +
+	"\ahistory\x18\n" +
+	" \x03(\v2\x16.main.AttendanceChangeR\ahistory\"\xb0\x01\n" +
+	"\x10AttendanceChange\x12.\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.main.AttendanceStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12\x1f\n" +
+	"\vrecorded_by\x18\x03 \x01(\tR\n" +
+	"recordedBy\x12\x1f\n" +
+	"\vrecorded_at\x18\x04 \x01(\tR\n" +
+	"recordedAt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"E\n" +
+	"\x11AttendanceRecords\x120\n" +
+	"\arecords\x18\x01 \x03(\v2\x16.main.AttendanceRecordR\arecords\"\xd7\x01\n" +
+	"\x18StudentAttendanceRequest\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12@\n" +
+	"\tfrom_date\x18\x02 \x01(\tB#\xfaB r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\bfromDate\x12<\n" +
+	"\ato_date\x18\x03 \x01(\tB#\xfaB r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\x06toDate\"\x8a\x01\n" +
+	"\x16ClassAttendanceRequest\x127\n" +
+	"\bclass_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\aclassId\x127\n" +
+	"\x04date\x18\x02 \x01(\tB#\xfaB r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\x04date*e\n" +
+	"\x10AttendanceStatus\x12!\n" +
+	"\x1dATTENDANCE_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPRESENT\x10\x01\x12\n" +
+	"\n" +
+	"\x06ABSENT\x10\x02\x12\b\n" +
+	"\x04LATE\x10\x03\x12\v\n" +
+	"\aEXCUSED\x10\x042\xc2\x02\n" +
+	"\x11AttendanceService\x12F\n" +
+	"\x0eMarkAttendance\x12\x1b.main.MarkAttendanceRequest\x1a\x17.main.AttendanceRecords\x12G\n" +
+	"\x11CorrectAttendance\x12\x1a.main.AttendanceCorrection\x1a\x16.main.AttendanceRecord\x12O\n" +
+	"\x14GetStudentAttendance\x12\x1e.main.StudentAttendanceRequest\x1a\x17.main.AttendanceRecords\x12K\n" +
+	"\x12GetClassAttendance\x12\x1c.main.ClassAttendanceRequest\x1a\x17.main.AttendanceRecordsB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_attendance_proto_rawDescOnce sync.Once
+	file_attendance_proto_rawDescData []byte
+)
+
+func file_attendance_proto_rawDescGZIP() []byte {
+	file_attendance_proto_rawDescOnce.Do(func() {
+		file_attendance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_attendance_proto_rawDesc), len(file_attendance_proto_rawDesc)))
+	})
+	return file_attendance_proto_rawDescData
+}
+
+var file_attendance_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_attendance_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_attendance_proto_goTypes = []any{
+	(AttendanceStatus)(0),            // 0: main.AttendanceStatus
+	(*MarkAttendanceRequest)(nil),    // 1: main.MarkAttendanceRequest
+	(*AttendanceMark)(nil),           // 2: main.AttendanceMark
+	(*AttendanceCorrection)(nil),     // 3: main.AttendanceCorrection
+	(*AttendanceRecord)(nil),         // 4: main.AttendanceRecord
+	(*AttendanceChange)(nil),         // 5: main.AttendanceChange
+	(*AttendanceRecords)(nil),        // 6: main.AttendanceRecords
+	(*StudentAttendanceRequest)(nil), // 7: main.StudentAttendanceRequest
+	(*ClassAttendanceRequest)(nil),   // 8: main.ClassAttendanceRequest
+}
+var file_attendance_proto_depIdxs = []int32{
+	2,  // 0: main.MarkAttendanceRequest.marks:type_name -> main.AttendanceMark
+	0,  // 1: main.AttendanceMark.status:type_name -> main.AttendanceStatus
+	0,  // 2: main.AttendanceCorrection.status:type_name -> main.AttendanceStatus
+	0,  // 3: main.AttendanceRecord.status:type_name -> main.AttendanceStatus
+	5,  // 4: main.AttendanceRecord.history:type_name -> main.AttendanceChange
+	0,  // 5: main.AttendanceChange.status:type_name -> main.AttendanceStatus
+	4,  // 6: main.AttendanceRecords.records:type_name -> main.AttendanceRecord
+	1,  // 7: main.AttendanceService.MarkAttendance:input_type -> main.MarkAttendanceRequest
+	3,  // 8: main.AttendanceService.CorrectAttendance:input_type -> main.AttendanceCorrection
+	7,  // 9: main.AttendanceService.GetStudentAttendance:input_type -> main.StudentAttendanceRequest
+	8,  // 10: main.AttendanceService.GetClassAttendance:input_type -> main.ClassAttendanceRequest
+	6,  // 11: main.AttendanceService.MarkAttendance:output_type -> main.AttendanceRecords
+	4,  // 12: main.AttendanceService.CorrectAttendance:output_type -> main.AttendanceRecord
+	6,  // 13: main.AttendanceService.GetStudentAttendance:output_type -> main.AttendanceRecords
+	6,  // 14: main.AttendanceService.GetClassAttendance:output_type -> main.AttendanceRecords
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_attendance_proto_init() }
+func file_attendance_proto_init() {
+	if File_attendance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attendance_proto_rawDesc), len(file_attendance_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_attendance_proto_goTypes,
+		DependencyIndexes: file_attendance_proto_depIdxs,
+		EnumInfos:         file_attendance_proto_enumTypes,
+		MessageInfos:      file_attendance_proto_msgTypes,
+	}.Build()
+	File_attendance_proto = out.File
+	file_attendance_proto_goTypes = nil
+	file_attendance_proto_depIdxs = nil
+}
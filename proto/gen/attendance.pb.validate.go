@@ -0,0 +1,1260 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: attendance.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on MarkAttendanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkAttendanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkAttendanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkAttendanceRequestMultiError, or nil if none found.
+func (m *MarkAttendanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkAttendanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClassId()) != 24 {
+		err := MarkAttendanceRequestValidationError{
+			field:  "ClassId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_MarkAttendanceRequest_ClassId_Pattern.MatchString(m.GetClassId()) {
+		err := MarkAttendanceRequestValidationError{
+			field:  "ClassId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_MarkAttendanceRequest_Date_Pattern.MatchString(m.GetDate()) {
+		err := MarkAttendanceRequestValidationError{
+			field:  "Date",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPeriod(); val < 0 || val > 12 {
+		err := MarkAttendanceRequestValidationError{
+			field:  "Period",
+			reason: "value must be inside range [0, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMarks()) < 1 {
+		err := MarkAttendanceRequestValidationError{
+			field:  "Marks",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMarks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MarkAttendanceRequestValidationError{
+						field:  fmt.Sprintf("Marks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MarkAttendanceRequestValidationError{
+						field:  fmt.Sprintf("Marks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MarkAttendanceRequestValidationError{
+					field:  fmt.Sprintf("Marks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MarkAttendanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkAttendanceRequestMultiError is an error wrapping multiple validation
+// errors returned by MarkAttendanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MarkAttendanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkAttendanceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkAttendanceRequestMultiError) AllErrors() []error { return m }
+
+// MarkAttendanceRequestValidationError is the validation error returned by
+// MarkAttendanceRequest.Validate if the designated constraints aren't met.
+type MarkAttendanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkAttendanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkAttendanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkAttendanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkAttendanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkAttendanceRequestValidationError) ErrorName() string {
+	return "MarkAttendanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkAttendanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkAttendanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkAttendanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkAttendanceRequestValidationError{}
+
+var _MarkAttendanceRequest_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _MarkAttendanceRequest_Date_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on AttendanceMark with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttendanceMark) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceMark with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttendanceMarkMultiError,
+// or nil if none found.
+func (m *AttendanceMark) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceMark) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := AttendanceMarkValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_AttendanceMark_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := AttendanceMarkValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AttendanceMark_Status_NotInLookup[m.GetStatus()]; ok {
+		err := AttendanceMarkValidationError{
+			field:  "Status",
+			reason: "value must not be in list [ATTENDANCE_STATUS_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AttendanceStatus_name[int32(m.GetStatus())]; !ok {
+		err := AttendanceMarkValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 200 {
+		err := AttendanceMarkValidationError{
+			field:  "Note",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttendanceMarkMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceMarkMultiError is an error wrapping multiple validation errors
+// returned by AttendanceMark.ValidateAll() if the designated constraints
+// aren't met.
+type AttendanceMarkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceMarkMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceMarkMultiError) AllErrors() []error { return m }
+
+// AttendanceMarkValidationError is the validation error returned by
+// AttendanceMark.Validate if the designated constraints aren't met.
+type AttendanceMarkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceMarkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceMarkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceMarkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceMarkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceMarkValidationError) ErrorName() string { return "AttendanceMarkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttendanceMarkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceMark.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceMarkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceMarkValidationError{}
+
+var _AttendanceMark_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _AttendanceMark_Status_NotInLookup = map[AttendanceStatus]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on AttendanceCorrection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttendanceCorrection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceCorrection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttendanceCorrectionMultiError, or nil if none found.
+func (m *AttendanceCorrection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceCorrection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := AttendanceCorrectionValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_AttendanceCorrection_Id_Pattern.MatchString(m.GetId()) {
+		err := AttendanceCorrectionValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AttendanceCorrection_Status_NotInLookup[m.GetStatus()]; ok {
+		err := AttendanceCorrectionValidationError{
+			field:  "Status",
+			reason: "value must not be in list [ATTENDANCE_STATUS_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AttendanceStatus_name[int32(m.GetStatus())]; !ok {
+		err := AttendanceCorrectionValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 200 {
+		err := AttendanceCorrectionValidationError{
+			field:  "Note",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 200 {
+		err := AttendanceCorrectionValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttendanceCorrectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceCorrectionMultiError is an error wrapping multiple validation
+// errors returned by AttendanceCorrection.ValidateAll() if the designated
+// constraints aren't met.
+type AttendanceCorrectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceCorrectionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceCorrectionMultiError) AllErrors() []error { return m }
+
+// AttendanceCorrectionValidationError is the validation error returned by
+// AttendanceCorrection.Validate if the designated constraints aren't met.
+type AttendanceCorrectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceCorrectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceCorrectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceCorrectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceCorrectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceCorrectionValidationError) ErrorName() string {
+	return "AttendanceCorrectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttendanceCorrectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceCorrection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceCorrectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceCorrectionValidationError{}
+
+var _AttendanceCorrection_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _AttendanceCorrection_Status_NotInLookup = map[AttendanceStatus]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on AttendanceRecord with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttendanceRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttendanceRecordMultiError, or nil if none found.
+func (m *AttendanceRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for StudentId
+
+	// no validation rules for ClassId
+
+	// no validation rules for Date
+
+	// no validation rules for Period
+
+	// no validation rules for Status
+
+	// no validation rules for Note
+
+	// no validation rules for RecordedBy
+
+	// no validation rules for RecordedAt
+
+	for idx, item := range m.GetHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttendanceRecordValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttendanceRecordValidationError{
+						field:  fmt.Sprintf("History[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttendanceRecordValidationError{
+					field:  fmt.Sprintf("History[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AttendanceRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceRecordMultiError is an error wrapping multiple validation errors
+// returned by AttendanceRecord.ValidateAll() if the designated constraints
+// aren't met.
+type AttendanceRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceRecordMultiError) AllErrors() []error { return m }
+
+// AttendanceRecordValidationError is the validation error returned by
+// AttendanceRecord.Validate if the designated constraints aren't met.
+type AttendanceRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceRecordValidationError) ErrorName() string { return "AttendanceRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttendanceRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceRecordValidationError{}
+
+// Validate checks the field values on AttendanceChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttendanceChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttendanceChangeMultiError, or nil if none found.
+func (m *AttendanceChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Note
+
+	// no validation rules for RecordedBy
+
+	// no validation rules for RecordedAt
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return AttendanceChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceChangeMultiError is an error wrapping multiple validation errors
+// returned by AttendanceChange.ValidateAll() if the designated constraints
+// aren't met.
+type AttendanceChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceChangeMultiError) AllErrors() []error { return m }
+
+// AttendanceChangeValidationError is the validation error returned by
+// AttendanceChange.Validate if the designated constraints aren't met.
+type AttendanceChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceChangeValidationError) ErrorName() string { return "AttendanceChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttendanceChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceChangeValidationError{}
+
+// Validate checks the field values on AttendanceRecords with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttendanceRecords) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttendanceRecords with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttendanceRecordsMultiError, or nil if none found.
+func (m *AttendanceRecords) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttendanceRecords) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttendanceRecordsValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttendanceRecordsValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttendanceRecordsValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AttendanceRecordsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttendanceRecordsMultiError is an error wrapping multiple validation errors
+// returned by AttendanceRecords.ValidateAll() if the designated constraints
+// aren't met.
+type AttendanceRecordsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttendanceRecordsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttendanceRecordsMultiError) AllErrors() []error { return m }
+
+// AttendanceRecordsValidationError is the validation error returned by
+// AttendanceRecords.Validate if the designated constraints aren't met.
+type AttendanceRecordsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttendanceRecordsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttendanceRecordsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttendanceRecordsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttendanceRecordsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttendanceRecordsValidationError) ErrorName() string {
+	return "AttendanceRecordsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttendanceRecordsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttendanceRecords.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttendanceRecordsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttendanceRecordsValidationError{}
+
+// Validate checks the field values on StudentAttendanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StudentAttendanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StudentAttendanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StudentAttendanceRequestMultiError, or nil if none found.
+func (m *StudentAttendanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StudentAttendanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := StudentAttendanceRequestValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_StudentAttendanceRequest_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := StudentAttendanceRequestValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StudentAttendanceRequest_FromDate_Pattern.MatchString(m.GetFromDate()) {
+		err := StudentAttendanceRequestValidationError{
+			field:  "FromDate",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StudentAttendanceRequest_ToDate_Pattern.MatchString(m.GetToDate()) {
+		err := StudentAttendanceRequestValidationError{
+			field:  "ToDate",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StudentAttendanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudentAttendanceRequestMultiError is an error wrapping multiple validation
+// errors returned by StudentAttendanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StudentAttendanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudentAttendanceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudentAttendanceRequestMultiError) AllErrors() []error { return m }
+
+// StudentAttendanceRequestValidationError is the validation error returned by
+// StudentAttendanceRequest.Validate if the designated constraints aren't met.
+type StudentAttendanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudentAttendanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudentAttendanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudentAttendanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudentAttendanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudentAttendanceRequestValidationError) ErrorName() string {
+	return "StudentAttendanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StudentAttendanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudentAttendanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudentAttendanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudentAttendanceRequestValidationError{}
+
+var _StudentAttendanceRequest_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _StudentAttendanceRequest_FromDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _StudentAttendanceRequest_ToDate_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+// Validate checks the field values on ClassAttendanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClassAttendanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClassAttendanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClassAttendanceRequestMultiError, or nil if none found.
+func (m *ClassAttendanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClassAttendanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClassId()) != 24 {
+		err := ClassAttendanceRequestValidationError{
+			field:  "ClassId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ClassAttendanceRequest_ClassId_Pattern.MatchString(m.GetClassId()) {
+		err := ClassAttendanceRequestValidationError{
+			field:  "ClassId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ClassAttendanceRequest_Date_Pattern.MatchString(m.GetDate()) {
+		err := ClassAttendanceRequestValidationError{
+			field:  "Date",
+			reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClassAttendanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassAttendanceRequestMultiError is an error wrapping multiple validation
+// errors returned by ClassAttendanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ClassAttendanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassAttendanceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassAttendanceRequestMultiError) AllErrors() []error { return m }
+
+// ClassAttendanceRequestValidationError is the validation error returned by
+// ClassAttendanceRequest.Validate if the designated constraints aren't met.
+type ClassAttendanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassAttendanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassAttendanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassAttendanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassAttendanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassAttendanceRequestValidationError) ErrorName() string {
+	return "ClassAttendanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClassAttendanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClassAttendanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassAttendanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassAttendanceRequestValidationError{}
+
+var _ClassAttendanceRequest_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _ClassAttendanceRequest_Date_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
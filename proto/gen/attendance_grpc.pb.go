@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: attendance.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AttendanceService_MarkAttendance_FullMethodName       = "/main.AttendanceService/MarkAttendance"
+	AttendanceService_CorrectAttendance_FullMethodName    = "/main.AttendanceService/CorrectAttendance"
+	AttendanceService_GetStudentAttendance_FullMethodName = "/main.AttendanceService/GetStudentAttendance"
+	AttendanceService_GetClassAttendance_FullMethodName   = "/main.AttendanceService/GetClassAttendance"
+)
+
+// AttendanceServiceClient is the client API for AttendanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AttendanceService records daily or per-period attendance. Admins and
+// managers can work with any class; other accounts only with the classes of
+// the teacher they are linked to through Exec.teacher_id.
+type AttendanceServiceClient interface {
+	// MarkAttendance records the attendance of several students of one class
+	// in one call. Re-marking a student replaces the record and keeps the
+	// previous state in its history.
+	MarkAttendance(ctx context.Context, in *MarkAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error)
+	// CorrectAttendance changes a single record, with a reason kept in its
+	// history.
+	CorrectAttendance(ctx context.Context, in *AttendanceCorrection, opts ...grpc.CallOption) (*AttendanceRecord, error)
+	GetStudentAttendance(ctx context.Context, in *StudentAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error)
+	GetClassAttendance(ctx context.Context, in *ClassAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error)
+}
+
+type attendanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendanceServiceClient(cc grpc.ClientConnInterface) AttendanceServiceClient {
+	return &attendanceServiceClient{cc}
+}
+
+func (c *attendanceServiceClient) MarkAttendance(ctx context.Context, in *MarkAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceRecords)
+	err := c.cc.Invoke(ctx, AttendanceService_MarkAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) CorrectAttendance(ctx context.Context, in *AttendanceCorrection, opts ...grpc.CallOption) (*AttendanceRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceRecord)
+	err := c.cc.Invoke(ctx, AttendanceService_CorrectAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetStudentAttendance(ctx context.Context, in *StudentAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceRecords)
+	err := c.cc.Invoke(ctx, AttendanceService_GetStudentAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) GetClassAttendance(ctx context.Context, in *ClassAttendanceRequest, opts ...grpc.CallOption) (*AttendanceRecords, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendanceRecords)
+	err := c.cc.Invoke(ctx, AttendanceService_GetClassAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendanceServiceServer is the server API for AttendanceService service.
+// All implementations must embed UnimplementedAttendanceServiceServer
+// for forward compatibility.
+//
+// AttendanceService records daily or per-period attendance. Admins and
+// managers can work with any class; other accounts only with the classes of
+// the teacher they are linked to through Exec.teacher_id.
+type AttendanceServiceServer interface {
+	// MarkAttendance records the attendance of several students of one class
+	// in one call. Re-marking a student replaces the record and keeps the
+	// previous state in its history.
+	MarkAttendance(context.Context, *MarkAttendanceRequest) (*AttendanceRecords, error)
+	// CorrectAttendance changes a single record, with a reason kept in its
+	// history.
+	CorrectAttendance(context.Context, *AttendanceCorrection) (*AttendanceRecord, error)
+	GetStudentAttendance(context.Context, *StudentAttendanceRequest) (*AttendanceRecords, error)
+	GetClassAttendance(context.Context, *ClassAttendanceRequest) (*AttendanceRecords, error)
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+// UnimplementedAttendanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendanceServiceServer struct{}
+
+func (UnimplementedAttendanceServiceServer) MarkAttendance(context.Context, *MarkAttendanceRequest) (*AttendanceRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) CorrectAttendance(context.Context, *AttendanceCorrection) (*AttendanceRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CorrectAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetStudentAttendance(context.Context, *StudentAttendanceRequest) (*AttendanceRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) GetClassAttendance(context.Context, *ClassAttendanceRequest) (*AttendanceRecords, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
+func (UnimplementedAttendanceServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceServiceServer interface {
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+func RegisterAttendanceServiceServer(s grpc.ServiceRegistrar, srv AttendanceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceService_ServiceDesc, srv)
+}
+
+func _AttendanceService_MarkAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).MarkAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_MarkAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).MarkAttendance(ctx, req.(*MarkAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_CorrectAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttendanceCorrection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).CorrectAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_CorrectAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).CorrectAttendance(ctx, req.(*AttendanceCorrection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetStudentAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetStudentAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetStudentAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetStudentAttendance(ctx, req.(*StudentAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_GetClassAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetClassAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetClassAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetClassAttendance(ctx, req.(*ClassAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.AttendanceService",
+	HandlerType: (*AttendanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MarkAttendance",
+			Handler:    _AttendanceService_MarkAttendance_Handler,
+		},
+		{
+			MethodName: "CorrectAttendance",
+			Handler:    _AttendanceService_CorrectAttendance_Handler,
+		},
+		{
+			MethodName: "GetStudentAttendance",
+			Handler:    _AttendanceService_GetStudentAttendance_Handler,
+		},
+		{
+			MethodName: "GetClassAttendance",
+			Handler:    _AttendanceService_GetClassAttendance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "attendance.proto",
+}
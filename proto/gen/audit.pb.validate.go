@@ -0,0 +1,573 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: audit.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on QueryAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryAuditLogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryAuditLogRequestMultiError, or nil if none found.
+func (m *QueryAuditLogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryAuditLogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetActorId() != "" {
+
+		if !_QueryAuditLogRequest_ActorId_Pattern.MatchString(m.GetActorId()) {
+			err := QueryAuditLogRequestValidationError{
+				field:  "ActorId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Entity
+
+	// no validation rules for TargetId
+
+	// no validation rules for Action
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	// no validation rules for PageNumber
+
+	if m.GetPageSize() > 500 {
+		err := QueryAuditLogRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 500",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return QueryAuditLogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryAuditLogRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryAuditLogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryAuditLogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryAuditLogRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryAuditLogRequestMultiError) AllErrors() []error { return m }
+
+// QueryAuditLogRequestValidationError is the validation error returned by
+// QueryAuditLogRequest.Validate if the designated constraints aren't met.
+type QueryAuditLogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryAuditLogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryAuditLogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryAuditLogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryAuditLogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryAuditLogRequestValidationError) ErrorName() string {
+	return "QueryAuditLogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryAuditLogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryAuditLogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryAuditLogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryAuditLogRequestValidationError{}
+
+var _QueryAuditLogRequest_ActorId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on AuditChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditChangeMultiError, or
+// nil if none found.
+func (m *AuditChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TargetId
+
+	// no validation rules for Field
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	if len(errors) > 0 {
+		return AuditChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditChangeMultiError is an error wrapping multiple validation errors
+// returned by AuditChange.ValidateAll() if the designated constraints aren't met.
+type AuditChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditChangeMultiError) AllErrors() []error { return m }
+
+// AuditChangeValidationError is the validation error returned by
+// AuditChange.Validate if the designated constraints aren't met.
+type AuditChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditChangeValidationError) ErrorName() string { return "AuditChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditChangeValidationError{}
+
+// Validate checks the field values on AuditEntry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEntryMultiError, or
+// nil if none found.
+func (m *AuditEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Timestamp
+
+	// no validation rules for ActorId
+
+	// no validation rules for ActorUsername
+
+	// no validation rules for Role
+
+	// no validation rules for Method
+
+	// no validation rules for Action
+
+	// no validation rules for Entity
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditEntryValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditEntryValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditEntryValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ClientIp
+
+	// no validation rules for RequestId
+
+	// no validation rules for StatusCode
+
+	if len(errors) > 0 {
+		return AuditEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEntryMultiError is an error wrapping multiple validation errors
+// returned by AuditEntry.ValidateAll() if the designated constraints aren't met.
+type AuditEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEntryMultiError) AllErrors() []error { return m }
+
+// AuditEntryValidationError is the validation error returned by
+// AuditEntry.Validate if the designated constraints aren't met.
+type AuditEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEntryValidationError) ErrorName() string { return "AuditEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEntryValidationError{}
+
+// Validate checks the field values on AuditEntries with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEntries) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEntries with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEntriesMultiError, or
+// nil if none found.
+func (m *AuditEntries) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEntries) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditEntriesValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditEntriesValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditEntriesValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuditEntriesMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEntriesMultiError is an error wrapping multiple validation errors
+// returned by AuditEntries.ValidateAll() if the designated constraints aren't met.
+type AuditEntriesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEntriesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEntriesMultiError) AllErrors() []error { return m }
+
+// AuditEntriesValidationError is the validation error returned by
+// AuditEntries.Validate if the designated constraints aren't met.
+type AuditEntriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEntriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEntriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEntriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEntriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEntriesValidationError) ErrorName() string { return "AuditEntriesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEntriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEntries.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEntriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEntriesValidationError{}
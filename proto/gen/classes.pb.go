@@ -0,0 +1,409 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: classes.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteClassesConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClassesConfirmation) Reset() {
+	*x = DeleteClassesConfirmation{}
+	mi := &file_classes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClassesConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClassesConfirmation) ProtoMessage() {}
+
+func (x *DeleteClassesConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClassesConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteClassesConfirmation) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteClassesConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteClassesConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type ClassIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassIds) Reset() {
+	*x = ClassIds{}
+	mi := &file_classes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassIds) ProtoMessage() {}
+
+func (x *ClassIds) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassIds.ProtoReflect.Descriptor instead.
+func (*ClassIds) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClassIds) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetClassesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Class         *Class                 `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	SortBy        []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClassesRequest) Reset() {
+	*x = GetClassesRequest{}
+	mi := &file_classes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClassesRequest) ProtoMessage() {}
+
+func (x *GetClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClassesRequest.ProtoReflect.Descriptor instead.
+func (*GetClassesRequest) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetClassesRequest) GetClass() *Class {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+func (x *GetClassesRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+// Class is one section of a grade in a given academic year, e.g. grade 9
+// section A in 2025-2026. The three together are unique.
+type Class struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GradeLevel int32                  `protobuf:"varint,2,opt,name=grade_level,json=gradeLevel,proto3" json:"grade_level,omitempty"`
+	// section is stored upper case, so "a" and "A" are the same section
+	Section string `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`
+	// academic_year is written as the two calendar years it spans, YYYY-YYYY
+	AcademicYear      string `protobuf:"bytes,4,opt,name=academic_year,json=academicYear,proto3" json:"academic_year,omitempty"`
+	Room              string `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
+	Capacity          int32  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	HomeroomTeacherId string `protobuf:"bytes,7,opt,name=homeroom_teacher_id,json=homeroomTeacherId,proto3" json:"homeroom_teacher_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Class) Reset() {
+	*x = Class{}
+	mi := &file_classes_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Class) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Class) ProtoMessage() {}
+
+func (x *Class) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Class.ProtoReflect.Descriptor instead.
+func (*Class) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Class) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Class) GetGradeLevel() int32 {
+	if x != nil {
+		return x.GradeLevel
+	}
+	return 0
+}
+
+func (x *Class) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Class) GetAcademicYear() string {
+	if x != nil {
+		return x.AcademicYear
+	}
+	return ""
+}
+
+func (x *Class) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *Class) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Class) GetHomeroomTeacherId() string {
+	if x != nil {
+		return x.HomeroomTeacherId
+	}
+	return ""
+}
+
+type Classes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classes       []*Class               `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Classes) Reset() {
+	*x = Classes{}
+	mi := &file_classes_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Classes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Classes) ProtoMessage() {}
+
+func (x *Classes) ProtoReflect() protoreflect.Message {
+	mi := &file_classes_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Classes.ProtoReflect.Descriptor instead.
+func (*Classes) Descriptor() ([]byte, []int) {
+	return file_classes_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Classes) GetClasses() []*Class {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+var File_classes_proto protoreflect.FileDescriptor
+
+const file_classes_proto_rawDesc = "" +
+	"\n" +
+	"\rclasses.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\x0estudents.proto\"T\n" +
+	"\x19DeleteClassesConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"A\n" +
+	"\bClassIds\x125\n" +
+	"\x03ids\x18\x01 \x03(\tB#\xfaB \x92\x01\x1d\b\x01\"\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x03ids\"`\n" +
+	"\x11GetClassesRequest\x12!\n" +
+	"\x05class\x18\x01 \x01(\v2\v.main.ClassR\x05class\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\"\xe0\x02\n" +
+	"\x05Class\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\vgrade_level\x18\x02 \x01(\x05B\v\xfaB\b\x1a\x06\x18\f(\x01@\x01R\n" +
+	"gradeLevel\x126\n" +
+	"\asection\x18\x03 \x01(\tB\x1c\xfaB\x19r\x172\x12^[A-Za-z0-9]{1,4}$\xd0\x01\x01R\asection\x12B\n" +
+	"\racademic_year\x18\x04 \x01(\tB\x1d\xfaB\x1ar\x182\x13^[0-9]{4}-[0-9]{4}$\xd0\x01\x01R\facademicYear\x12+\n" +
+	"\x04room\x18\x05 \x01(\tB\x17\xfaB\x14r\x122\x10^[A-Za-z0-9 -]*$R\x04room\x12#\n" +
+	"\bcapacity\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bcapacity\x12K\n" +
+	"\x13homeroom_teacher_id\x18\a \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\x11homeroomTeacherId\"0\n" +
+	"\aClasses\x12%\n" +
+	"\aclasses\x18\x01 \x03(\v2\v.main.ClassR\aclasses2\xe3\x01\n" +
+	"\x0eClassesService\x124\n" +
+	"\n" +
+	"GetClasses\x12\x17.main.GetClassesRequest\x1a\r.main.Classes\x12*\n" +
+	"\n" +
+	"AddClasses\x12\r.main.Classes\x1a\r.main.Classes\x12-\n" +
+	"\rUpdateClasses\x12\r.main.Classes\x1a\r.main.Classes\x12@\n" +
+	"\rDeleteClasses\x12\x0e.main.ClassIds\x1a\x1f.main.DeleteClassesConfirmationB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_classes_proto_rawDescOnce sync.Once
+	file_classes_proto_rawDescData []byte
+)
+
+func file_classes_proto_rawDescGZIP() []byte {
+	file_classes_proto_rawDescOnce.Do(func() {
+		file_classes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_classes_proto_rawDesc), len(file_classes_proto_rawDesc)))
+	})
+	return file_classes_proto_rawDescData
+}
+
+var file_classes_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_classes_proto_goTypes = []any{
+	(*DeleteClassesConfirmation)(nil), // 0: main.DeleteClassesConfirmation
+	(*ClassIds)(nil),                  // 1: main.ClassIds
+	(*GetClassesRequest)(nil),         // 2: main.GetClassesRequest
+	(*Class)(nil),                     // 3: main.Class
+	(*Classes)(nil),                   // 4: main.Classes
+	(*SortField)(nil),                 // 5: main.SortField
+}
+var file_classes_proto_depIdxs = []int32{
+	3, // 0: main.GetClassesRequest.class:type_name -> main.Class
+	5, // 1: main.GetClassesRequest.sort_by:type_name -> main.SortField
+	3, // 2: main.Classes.classes:type_name -> main.Class
+	2, // 3: main.ClassesService.GetClasses:input_type -> main.GetClassesRequest
+	4, // 4: main.ClassesService.AddClasses:input_type -> main.Classes
+	4, // 5: main.ClassesService.UpdateClasses:input_type -> main.Classes
+	1, // 6: main.ClassesService.DeleteClasses:input_type -> main.ClassIds
+	4, // 7: main.ClassesService.GetClasses:output_type -> main.Classes
+	4, // 8: main.ClassesService.AddClasses:output_type -> main.Classes
+	4, // 9: main.ClassesService.UpdateClasses:output_type -> main.Classes
+	0, // 10: main.ClassesService.DeleteClasses:output_type -> main.DeleteClassesConfirmation
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_classes_proto_init() }
+func file_classes_proto_init() {
+	if File_classes_proto != nil {
+		return
+	}
+	file_students_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_classes_proto_rawDesc), len(file_classes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_classes_proto_goTypes,
+		DependencyIndexes: file_classes_proto_depIdxs,
+		MessageInfos:      file_classes_proto_msgTypes,
+	}.Build()
+	File_classes_proto = out.File
+	file_classes_proto_goTypes = nil
+	file_classes_proto_depIdxs = nil
+}
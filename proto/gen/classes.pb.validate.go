@@ -0,0 +1,767 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: classes.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DeleteClassesConfirmation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteClassesConfirmation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteClassesConfirmation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteClassesConfirmationMultiError, or nil if none found.
+func (m *DeleteClassesConfirmation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteClassesConfirmation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteClassesConfirmationMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteClassesConfirmationMultiError is an error wrapping multiple validation
+// errors returned by DeleteClassesConfirmation.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteClassesConfirmationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteClassesConfirmationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteClassesConfirmationMultiError) AllErrors() []error { return m }
+
+// DeleteClassesConfirmationValidationError is the validation error returned by
+// DeleteClassesConfirmation.Validate if the designated constraints aren't met.
+type DeleteClassesConfirmationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteClassesConfirmationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteClassesConfirmationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteClassesConfirmationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteClassesConfirmationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteClassesConfirmationValidationError) ErrorName() string {
+	return "DeleteClassesConfirmationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteClassesConfirmationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteClassesConfirmation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteClassesConfirmationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteClassesConfirmationValidationError{}
+
+// Validate checks the field values on ClassIds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClassIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClassIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClassIdsMultiError, or nil
+// if none found.
+func (m *ClassIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClassIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) < 1 {
+		err := ClassIdsValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) != 24 {
+			err := ClassIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value length must be 24 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_ClassIds_Ids_Pattern.MatchString(item) {
+			err := ClassIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClassIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassIdsMultiError is an error wrapping multiple validation errors returned
+// by ClassIds.ValidateAll() if the designated constraints aren't met.
+type ClassIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassIdsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassIdsMultiError) AllErrors() []error { return m }
+
+// ClassIdsValidationError is the validation error returned by
+// ClassIds.Validate if the designated constraints aren't met.
+type ClassIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassIdsValidationError) ErrorName() string { return "ClassIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClassIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClassIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassIdsValidationError{}
+
+var _ClassIds_Ids_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on GetClassesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetClassesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClassesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClassesRequestMultiError, or nil if none found.
+func (m *GetClassesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClassesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClass()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetClassesRequestValidationError{
+					field:  "Class",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetClassesRequestValidationError{
+					field:  "Class",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClass()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClassesRequestValidationError{
+				field:  "Class",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetClassesRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetClassesRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetClassesRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetClassesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClassesRequestMultiError is an error wrapping multiple validation errors
+// returned by GetClassesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetClassesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClassesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClassesRequestMultiError) AllErrors() []error { return m }
+
+// GetClassesRequestValidationError is the validation error returned by
+// GetClassesRequest.Validate if the designated constraints aren't met.
+type GetClassesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClassesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClassesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClassesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClassesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClassesRequestValidationError) ErrorName() string {
+	return "GetClassesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClassesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClassesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClassesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClassesRequestValidationError{}
+
+// Validate checks the field values on Class with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Class) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Class with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ClassMultiError, or nil if none found.
+func (m *Class) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Class) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetGradeLevel() != 0 {
+
+		if val := m.GetGradeLevel(); val < 1 || val > 12 {
+			err := ClassValidationError{
+				field:  "GradeLevel",
+				reason: "value must be inside range [1, 12]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetSection() != "" {
+
+		if !_Class_Section_Pattern.MatchString(m.GetSection()) {
+			err := ClassValidationError{
+				field:  "Section",
+				reason: "value does not match regex pattern \"^[A-Za-z0-9]{1,4}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetAcademicYear() != "" {
+
+		if !_Class_AcademicYear_Pattern.MatchString(m.GetAcademicYear()) {
+			err := ClassValidationError{
+				field:  "AcademicYear",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{4}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if !_Class_Room_Pattern.MatchString(m.GetRoom()) {
+		err := ClassValidationError{
+			field:  "Room",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCapacity() < 0 {
+		err := ClassValidationError{
+			field:  "Capacity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHomeroomTeacherId() != "" {
+
+		if !_Class_HomeroomTeacherId_Pattern.MatchString(m.GetHomeroomTeacherId()) {
+			err := ClassValidationError{
+				field:  "HomeroomTeacherId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClassMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassMultiError is an error wrapping multiple validation errors returned by
+// Class.ValidateAll() if the designated constraints aren't met.
+type ClassMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassMultiError) AllErrors() []error { return m }
+
+// ClassValidationError is the validation error returned by Class.Validate if
+// the designated constraints aren't met.
+type ClassValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassValidationError) ErrorName() string { return "ClassValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClassValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClass.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassValidationError{}
+
+var _Class_Section_Pattern = regexp.MustCompile("^[A-Za-z0-9]{1,4}$")
+
+var _Class_AcademicYear_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{4}$")
+
+var _Class_Room_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+var _Class_HomeroomTeacherId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on Classes with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Classes) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Classes with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ClassesMultiError, or nil if none found.
+func (m *Classes) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Classes) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClasses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClassesValidationError{
+						field:  fmt.Sprintf("Classes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClassesValidationError{
+						field:  fmt.Sprintf("Classes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClassesValidationError{
+					field:  fmt.Sprintf("Classes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClassesMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassesMultiError is an error wrapping multiple validation errors returned
+// by Classes.ValidateAll() if the designated constraints aren't met.
+type ClassesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassesMultiError) AllErrors() []error { return m }
+
+// ClassesValidationError is the validation error returned by Classes.Validate
+// if the designated constraints aren't met.
+type ClassesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassesValidationError) ErrorName() string { return "ClassesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClassesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClasses.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassesValidationError{}
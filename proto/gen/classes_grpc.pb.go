@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: classes.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ClassesService_GetClasses_FullMethodName    = "/main.ClassesService/GetClasses"
+	ClassesService_AddClasses_FullMethodName    = "/main.ClassesService/AddClasses"
+	ClassesService_UpdateClasses_FullMethodName = "/main.ClassesService/UpdateClasses"
+	ClassesService_DeleteClasses_FullMethodName = "/main.ClassesService/DeleteClasses"
+)
+
+// ClassesServiceClient is the client API for ClassesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClassesServiceClient interface {
+	GetClasses(ctx context.Context, in *GetClassesRequest, opts ...grpc.CallOption) (*Classes, error)
+	AddClasses(ctx context.Context, in *Classes, opts ...grpc.CallOption) (*Classes, error)
+	UpdateClasses(ctx context.Context, in *Classes, opts ...grpc.CallOption) (*Classes, error)
+	// DeleteClasses refuses classes that students or teachers still
+	// reference.
+	DeleteClasses(ctx context.Context, in *ClassIds, opts ...grpc.CallOption) (*DeleteClassesConfirmation, error)
+}
+
+type classesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClassesServiceClient(cc grpc.ClientConnInterface) ClassesServiceClient {
+	return &classesServiceClient{cc}
+}
+
+func (c *classesServiceClient) GetClasses(ctx context.Context, in *GetClassesRequest, opts ...grpc.CallOption) (*Classes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Classes)
+	err := c.cc.Invoke(ctx, ClassesService_GetClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classesServiceClient) AddClasses(ctx context.Context, in *Classes, opts ...grpc.CallOption) (*Classes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Classes)
+	err := c.cc.Invoke(ctx, ClassesService_AddClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classesServiceClient) UpdateClasses(ctx context.Context, in *Classes, opts ...grpc.CallOption) (*Classes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Classes)
+	err := c.cc.Invoke(ctx, ClassesService_UpdateClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *classesServiceClient) DeleteClasses(ctx context.Context, in *ClassIds, opts ...grpc.CallOption) (*DeleteClassesConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteClassesConfirmation)
+	err := c.cc.Invoke(ctx, ClassesService_DeleteClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClassesServiceServer is the server API for ClassesService service.
+// All implementations must embed UnimplementedClassesServiceServer
+// for forward compatibility.
+type ClassesServiceServer interface {
+	GetClasses(context.Context, *GetClassesRequest) (*Classes, error)
+	AddClasses(context.Context, *Classes) (*Classes, error)
+	UpdateClasses(context.Context, *Classes) (*Classes, error)
+	// DeleteClasses refuses classes that students or teachers still
+	// reference.
+	DeleteClasses(context.Context, *ClassIds) (*DeleteClassesConfirmation, error)
+	mustEmbedUnimplementedClassesServiceServer()
+}
+
+// UnimplementedClassesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClassesServiceServer struct{}
+
+func (UnimplementedClassesServiceServer) GetClasses(context.Context, *GetClassesRequest) (*Classes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClasses not implemented")
+}
+func (UnimplementedClassesServiceServer) AddClasses(context.Context, *Classes) (*Classes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddClasses not implemented")
+}
+func (UnimplementedClassesServiceServer) UpdateClasses(context.Context, *Classes) (*Classes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClasses not implemented")
+}
+func (UnimplementedClassesServiceServer) DeleteClasses(context.Context, *ClassIds) (*DeleteClassesConfirmation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClasses not implemented")
+}
+func (UnimplementedClassesServiceServer) mustEmbedUnimplementedClassesServiceServer() {}
+func (UnimplementedClassesServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeClassesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClassesServiceServer will
+// result in compilation errors.
+type UnsafeClassesServiceServer interface {
+	mustEmbedUnimplementedClassesServiceServer()
+}
+
+func RegisterClassesServiceServer(s grpc.ServiceRegistrar, srv ClassesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedClassesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClassesService_ServiceDesc, srv)
+}
+
+func _ClassesService_GetClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassesServiceServer).GetClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassesService_GetClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassesServiceServer).GetClasses(ctx, req.(*GetClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassesService_AddClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Classes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassesServiceServer).AddClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassesService_AddClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassesServiceServer).AddClasses(ctx, req.(*Classes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassesService_UpdateClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Classes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassesServiceServer).UpdateClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassesService_UpdateClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassesServiceServer).UpdateClasses(ctx, req.(*Classes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClassesService_DeleteClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClassesServiceServer).DeleteClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClassesService_DeleteClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClassesServiceServer).DeleteClasses(ctx, req.(*ClassIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClassesService_ServiceDesc is the grpc.ServiceDesc for ClassesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClassesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.ClassesService",
+	HandlerType: (*ClassesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetClasses",
+			Handler:    _ClassesService_GetClasses_Handler,
+		},
+		{
+			MethodName: "AddClasses",
+			Handler:    _ClassesService_AddClasses_Handler,
+		},
+		{
+			MethodName: "UpdateClasses",
+			Handler:    _ClassesService_UpdateClasses_Handler,
+		},
+		{
+			MethodName: "DeleteClasses",
+			Handler:    _ClassesService_DeleteClasses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "classes.proto",
+}
@@ -0,0 +1,234 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: duplicates.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FindDuplicatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is one of student, teacher or exec
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindDuplicatesRequest) Reset() {
+	*x = FindDuplicatesRequest{}
+	mi := &file_duplicates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindDuplicatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicatesRequest) ProtoMessage() {}
+
+func (x *FindDuplicatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_duplicates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicatesRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicatesRequest) Descriptor() ([]byte, []int) {
+	return file_duplicates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FindDuplicatesRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type DuplicateGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// reasons lists why the records were matched: same_name_and_dob,
+	// same_name or similar_email
+	Reasons       []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateGroup) Reset() {
+	*x = DuplicateGroup{}
+	mi := &file_duplicates_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateGroup) ProtoMessage() {}
+
+func (x *DuplicateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_duplicates_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateGroup.ProtoReflect.Descriptor instead.
+func (*DuplicateGroup) Descriptor() ([]byte, []int) {
+	return file_duplicates_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DuplicateGroup) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DuplicateGroup) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type DuplicateGroups struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*DuplicateGroup      `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateGroups) Reset() {
+	*x = DuplicateGroups{}
+	mi := &file_duplicates_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateGroups) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateGroups) ProtoMessage() {}
+
+func (x *DuplicateGroups) ProtoReflect() protoreflect.Message {
+	mi := &file_duplicates_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateGroups.ProtoReflect.Descriptor instead.
+func (*DuplicateGroups) Descriptor() ([]byte, []int) {
+	return file_duplicates_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DuplicateGroups) GetGroups() []*DuplicateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+var File_duplicates_proto protoreflect.FileDescriptor
+
+const file_duplicates_proto_rawDesc = "" +
+	"\n" +
+	"\x10duplicates.proto\x12\x04main\x1a\x17validate/validate.proto\"J\n" +
+	"\x15FindDuplicatesRequest\x121\n" +
+	"\x04kind\x18\x01 \x01(\tB\x1d\xfaB\x1ar\x18R\astudentR\ateacherR\x04execR\x04kind\"<\n" +
+	"\x0eDuplicateGroup\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x18\n" +
+	"\areasons\x18\x02 \x03(\tR\areasons\"?\n" +
+	"\x0fDuplicateGroups\x12,\n" +
+	"\x06groups\x18\x01 \x03(\v2\x14.main.DuplicateGroupR\x06groups2Y\n" +
+	"\x11DuplicatesService\x12D\n" +
+	"\x0eFindDuplicates\x12\x1b.main.FindDuplicatesRequest\x1a\x15.main.DuplicateGroupsB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_duplicates_proto_rawDescOnce sync.Once
+	file_duplicates_proto_rawDescData []byte
+)
+
+func file_duplicates_proto_rawDescGZIP() []byte {
+	file_duplicates_proto_rawDescOnce.Do(func() {
+		file_duplicates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_duplicates_proto_rawDesc), len(file_duplicates_proto_rawDesc)))
+	})
+	return file_duplicates_proto_rawDescData
+}
+
+var file_duplicates_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_duplicates_proto_goTypes = []any{
+	(*FindDuplicatesRequest)(nil), // 0: main.FindDuplicatesRequest
+	(*DuplicateGroup)(nil),        // 1: main.DuplicateGroup
+	(*DuplicateGroups)(nil),       // 2: main.DuplicateGroups
+}
+var file_duplicates_proto_depIdxs = []int32{
+	1, // 0: main.DuplicateGroups.groups:type_name -> main.DuplicateGroup
+	0, // 1: main.DuplicatesService.FindDuplicates:input_type -> main.FindDuplicatesRequest
+	2, // 2: main.DuplicatesService.FindDuplicates:output_type -> main.DuplicateGroups
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_duplicates_proto_init() }
+func file_duplicates_proto_init() {
+	if File_duplicates_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_duplicates_proto_rawDesc), len(file_duplicates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_duplicates_proto_goTypes,
+		DependencyIndexes: file_duplicates_proto_depIdxs,
+		MessageInfos:      file_duplicates_proto_msgTypes,
+	}.Build()
+	File_duplicates_proto = out.File
+	file_duplicates_proto_goTypes = nil
+	file_duplicates_proto_depIdxs = nil
+}
@@ -0,0 +1,389 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: duplicates.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on FindDuplicatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindDuplicatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindDuplicatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindDuplicatesRequestMultiError, or nil if none found.
+func (m *FindDuplicatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindDuplicatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _FindDuplicatesRequest_Kind_InLookup[m.GetKind()]; !ok {
+		err := FindDuplicatesRequestValidationError{
+			field:  "Kind",
+			reason: "value must be in list [student teacher exec]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FindDuplicatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindDuplicatesRequestMultiError is an error wrapping multiple validation
+// errors returned by FindDuplicatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FindDuplicatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindDuplicatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindDuplicatesRequestMultiError) AllErrors() []error { return m }
+
+// FindDuplicatesRequestValidationError is the validation error returned by
+// FindDuplicatesRequest.Validate if the designated constraints aren't met.
+type FindDuplicatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindDuplicatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindDuplicatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindDuplicatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindDuplicatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindDuplicatesRequestValidationError) ErrorName() string {
+	return "FindDuplicatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindDuplicatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindDuplicatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindDuplicatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindDuplicatesRequestValidationError{}
+
+var _FindDuplicatesRequest_Kind_InLookup = map[string]struct{}{
+	"student": {},
+	"teacher": {},
+	"exec":    {},
+}
+
+// Validate checks the field values on DuplicateGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DuplicateGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DuplicateGroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DuplicateGroupMultiError,
+// or nil if none found.
+func (m *DuplicateGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DuplicateGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DuplicateGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// DuplicateGroupMultiError is an error wrapping multiple validation errors
+// returned by DuplicateGroup.ValidateAll() if the designated constraints
+// aren't met.
+type DuplicateGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DuplicateGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DuplicateGroupMultiError) AllErrors() []error { return m }
+
+// DuplicateGroupValidationError is the validation error returned by
+// DuplicateGroup.Validate if the designated constraints aren't met.
+type DuplicateGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DuplicateGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DuplicateGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DuplicateGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DuplicateGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DuplicateGroupValidationError) ErrorName() string { return "DuplicateGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DuplicateGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDuplicateGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DuplicateGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DuplicateGroupValidationError{}
+
+// Validate checks the field values on DuplicateGroups with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DuplicateGroups) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DuplicateGroups with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DuplicateGroupsMultiError, or nil if none found.
+func (m *DuplicateGroups) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DuplicateGroups) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DuplicateGroupsValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DuplicateGroupsValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DuplicateGroupsValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DuplicateGroupsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DuplicateGroupsMultiError is an error wrapping multiple validation errors
+// returned by DuplicateGroups.ValidateAll() if the designated constraints
+// aren't met.
+type DuplicateGroupsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DuplicateGroupsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DuplicateGroupsMultiError) AllErrors() []error { return m }
+
+// DuplicateGroupsValidationError is the validation error returned by
+// DuplicateGroups.Validate if the designated constraints aren't met.
+type DuplicateGroupsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DuplicateGroupsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DuplicateGroupsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DuplicateGroupsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DuplicateGroupsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DuplicateGroupsValidationError) ErrorName() string { return "DuplicateGroupsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DuplicateGroupsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDuplicateGroups.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DuplicateGroupsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DuplicateGroupsValidationError{}
@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: duplicates.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DuplicatesService_FindDuplicates_FullMethodName = "/main.DuplicatesService/FindDuplicates"
+)
+
+// DuplicatesServiceClient is the client API for DuplicatesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DuplicatesServiceClient interface {
+	// FindDuplicates reports groups of records that probably describe the same
+	// person. Nothing is changed; the report is meant to guide manual cleanup.
+	FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*DuplicateGroups, error)
+}
+
+type duplicatesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDuplicatesServiceClient(cc grpc.ClientConnInterface) DuplicatesServiceClient {
+	return &duplicatesServiceClient{cc}
+}
+
+func (c *duplicatesServiceClient) FindDuplicates(ctx context.Context, in *FindDuplicatesRequest, opts ...grpc.CallOption) (*DuplicateGroups, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DuplicateGroups)
+	err := c.cc.Invoke(ctx, DuplicatesService_FindDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DuplicatesServiceServer is the server API for DuplicatesService service.
+// All implementations must embed UnimplementedDuplicatesServiceServer
+// for forward compatibility.
+type DuplicatesServiceServer interface {
+	// FindDuplicates reports groups of records that probably describe the same
+	// person. Nothing is changed; the report is meant to guide manual cleanup.
+	FindDuplicates(context.Context, *FindDuplicatesRequest) (*DuplicateGroups, error)
+	mustEmbedUnimplementedDuplicatesServiceServer()
+}
+
+// UnimplementedDuplicatesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDuplicatesServiceServer struct{}
+
+func (UnimplementedDuplicatesServiceServer) FindDuplicates(context.Context, *FindDuplicatesRequest) (*DuplicateGroups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindDuplicates not implemented")
+}
+func (UnimplementedDuplicatesServiceServer) mustEmbedUnimplementedDuplicatesServiceServer() {}
+func (UnimplementedDuplicatesServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeDuplicatesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DuplicatesServiceServer will
+// result in compilation errors.
+type UnsafeDuplicatesServiceServer interface {
+	mustEmbedUnimplementedDuplicatesServiceServer()
+}
+
+func RegisterDuplicatesServiceServer(s grpc.ServiceRegistrar, srv DuplicatesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDuplicatesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DuplicatesService_ServiceDesc, srv)
+}
+
+func _DuplicatesService_FindDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DuplicatesServiceServer).FindDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DuplicatesService_FindDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DuplicatesServiceServer).FindDuplicates(ctx, req.(*FindDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DuplicatesService_ServiceDesc is the grpc.ServiceDesc for DuplicatesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DuplicatesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.DuplicatesService",
+	HandlerType: (*DuplicatesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindDuplicates",
+			Handler:    _DuplicatesService_FindDuplicates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "duplicates.proto",
+}
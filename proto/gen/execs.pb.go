@@ -22,6 +22,206 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RequestEmailChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewEmail      string                 `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeRequest) Reset() {
+	*x = RequestEmailChangeRequest{}
+	mi := &file_execs_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequestEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+type ConfirmEmailChangeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VerificationCode string                 `protobuf:"bytes,1,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	mi := &file_execs_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfirmEmailChangeRequest) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return ""
+}
+
+type ActivateAccountRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ActivationCode  string                 `protobuf:"bytes,1,opt,name=activation_code,json=activationCode,proto3" json:"activation_code,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	ConfirmPassword string                 `protobuf:"bytes,3,opt,name=confirm_password,json=confirmPassword,proto3" json:"confirm_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ActivateAccountRequest) Reset() {
+	*x = ActivateAccountRequest{}
+	mi := &file_execs_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateAccountRequest) ProtoMessage() {}
+
+func (x *ActivateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateAccountRequest.ProtoReflect.Descriptor instead.
+func (*ActivateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivateAccountRequest) GetActivationCode() string {
+	if x != nil {
+		return x.ActivationCode
+	}
+	return ""
+}
+
+func (x *ActivateAccountRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ActivateAccountRequest) GetConfirmPassword() string {
+	if x != nil {
+		return x.ConfirmPassword
+	}
+	return ""
+}
+
+type ResendActivationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Confirmation  bool                   `protobuf:"varint,1,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+	ResentIds     []string               `protobuf:"bytes,2,rep,name=resent_ids,json=resentIds,proto3" json:"resent_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendActivationResponse) Reset() {
+	*x = ResendActivationResponse{}
+	mi := &file_execs_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendActivationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendActivationResponse) ProtoMessage() {}
+
+func (x *ResendActivationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_execs_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendActivationResponse.ProtoReflect.Descriptor instead.
+func (*ResendActivationResponse) Descriptor() ([]byte, []int) {
+	return file_execs_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResendActivationResponse) GetConfirmation() bool {
+	if x != nil {
+		return x.Confirmation
+	}
+	return false
+}
+
+func (x *ResendActivationResponse) GetResentIds() []string {
+	if x != nil {
+		return x.ResentIds
+	}
+	return nil
+}
+
 type ForgotPasswordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Confirmation  bool                   `protobuf:"varint,1,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
@@ -32,7 +232,7 @@ type ForgotPasswordResponse struct {
 
 func (x *ForgotPasswordResponse) Reset() {
 	*x = ForgotPasswordResponse{}
-	mi := &file_execs_proto_msgTypes[0]
+	mi := &file_execs_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +244,7 @@ func (x *ForgotPasswordResponse) String() string {
 func (*ForgotPasswordResponse) ProtoMessage() {}
 
 func (x *ForgotPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[0]
+	mi := &file_execs_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +257,7 @@ func (x *ForgotPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgotPasswordResponse.ProtoReflect.Descriptor instead.
 func (*ForgotPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{0}
+	return file_execs_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ForgotPasswordResponse) GetConfirmation() bool {
@@ -83,7 +283,7 @@ type ForgotPasswordRequest struct {
 
 func (x *ForgotPasswordRequest) Reset() {
 	*x = ForgotPasswordRequest{}
-	mi := &file_execs_proto_msgTypes[1]
+	mi := &file_execs_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -95,7 +295,7 @@ func (x *ForgotPasswordRequest) String() string {
 func (*ForgotPasswordRequest) ProtoMessage() {}
 
 func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[1]
+	mi := &file_execs_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,7 +308,7 @@ func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{1}
+	return file_execs_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ForgotPasswordRequest) GetEmail() string {
@@ -127,7 +327,7 @@ type Confirmation struct {
 
 func (x *Confirmation) Reset() {
 	*x = Confirmation{}
-	mi := &file_execs_proto_msgTypes[2]
+	mi := &file_execs_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -139,7 +339,7 @@ func (x *Confirmation) String() string {
 func (*Confirmation) ProtoMessage() {}
 
 func (x *Confirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[2]
+	mi := &file_execs_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +352,7 @@ func (x *Confirmation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Confirmation.ProtoReflect.Descriptor instead.
 func (*Confirmation) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{2}
+	return file_execs_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Confirmation) GetConfirmation() bool {
@@ -173,7 +373,7 @@ type ResetPasswordRequest struct {
 
 func (x *ResetPasswordRequest) Reset() {
 	*x = ResetPasswordRequest{}
-	mi := &file_execs_proto_msgTypes[3]
+	mi := &file_execs_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -185,7 +385,7 @@ func (x *ResetPasswordRequest) String() string {
 func (*ResetPasswordRequest) ProtoMessage() {}
 
 func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[3]
+	mi := &file_execs_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +398,7 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{3}
+	return file_execs_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ResetPasswordRequest) GetResetCode() string {
@@ -232,7 +432,7 @@ type UpdatePasswordResponse struct {
 
 func (x *UpdatePasswordResponse) Reset() {
 	*x = UpdatePasswordResponse{}
-	mi := &file_execs_proto_msgTypes[4]
+	mi := &file_execs_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -244,7 +444,7 @@ func (x *UpdatePasswordResponse) String() string {
 func (*UpdatePasswordResponse) ProtoMessage() {}
 
 func (x *UpdatePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[4]
+	mi := &file_execs_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,7 +457,7 @@ func (x *UpdatePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePasswordResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{4}
+	return file_execs_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdatePasswordResponse) GetPasswordUpdated() bool {
@@ -285,7 +485,7 @@ type UpdatePasswordRequest struct {
 
 func (x *UpdatePasswordRequest) Reset() {
 	*x = UpdatePasswordRequest{}
-	mi := &file_execs_proto_msgTypes[5]
+	mi := &file_execs_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -297,7 +497,7 @@ func (x *UpdatePasswordRequest) String() string {
 func (*UpdatePasswordRequest) ProtoMessage() {}
 
 func (x *UpdatePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[5]
+	mi := &file_execs_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +510,7 @@ func (x *UpdatePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePasswordRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{5}
+	return file_execs_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdatePasswordRequest) GetId() string {
@@ -343,7 +543,7 @@ type ExecLogoutResponse struct {
 
 func (x *ExecLogoutResponse) Reset() {
 	*x = ExecLogoutResponse{}
-	mi := &file_execs_proto_msgTypes[6]
+	mi := &file_execs_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -355,7 +555,7 @@ func (x *ExecLogoutResponse) String() string {
 func (*ExecLogoutResponse) ProtoMessage() {}
 
 func (x *ExecLogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[6]
+	mi := &file_execs_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,7 +568,7 @@ func (x *ExecLogoutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecLogoutResponse.ProtoReflect.Descriptor instead.
 func (*ExecLogoutResponse) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{6}
+	return file_execs_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ExecLogoutResponse) GetLoggedOut() bool {
@@ -386,7 +586,7 @@ type EmptyRequest struct {
 
 func (x *EmptyRequest) Reset() {
 	*x = EmptyRequest{}
-	mi := &file_execs_proto_msgTypes[7]
+	mi := &file_execs_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +598,7 @@ func (x *EmptyRequest) String() string {
 func (*EmptyRequest) ProtoMessage() {}
 
 func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[7]
+	mi := &file_execs_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +611,7 @@ func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
 func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{7}
+	return file_execs_proto_rawDescGZIP(), []int{11}
 }
 
 type ExecLoginResponse struct {
@@ -424,7 +624,7 @@ type ExecLoginResponse struct {
 
 func (x *ExecLoginResponse) Reset() {
 	*x = ExecLoginResponse{}
-	mi := &file_execs_proto_msgTypes[8]
+	mi := &file_execs_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -436,7 +636,7 @@ func (x *ExecLoginResponse) String() string {
 func (*ExecLoginResponse) ProtoMessage() {}
 
 func (x *ExecLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[8]
+	mi := &file_execs_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +649,7 @@ func (x *ExecLoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecLoginResponse.ProtoReflect.Descriptor instead.
 func (*ExecLoginResponse) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{8}
+	return file_execs_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ExecLoginResponse) GetStatus() bool {
@@ -476,7 +676,7 @@ type ExecLoginRequest struct {
 
 func (x *ExecLoginRequest) Reset() {
 	*x = ExecLoginRequest{}
-	mi := &file_execs_proto_msgTypes[9]
+	mi := &file_execs_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -488,7 +688,7 @@ func (x *ExecLoginRequest) String() string {
 func (*ExecLoginRequest) ProtoMessage() {}
 
 func (x *ExecLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[9]
+	mi := &file_execs_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,7 +701,7 @@ func (x *ExecLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecLoginRequest.ProtoReflect.Descriptor instead.
 func (*ExecLoginRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{9}
+	return file_execs_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ExecLoginRequest) GetUsername() string {
@@ -528,7 +728,7 @@ type DeleteExecsConfirmation struct {
 
 func (x *DeleteExecsConfirmation) Reset() {
 	*x = DeleteExecsConfirmation{}
-	mi := &file_execs_proto_msgTypes[10]
+	mi := &file_execs_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +740,7 @@ func (x *DeleteExecsConfirmation) String() string {
 func (*DeleteExecsConfirmation) ProtoMessage() {}
 
 func (x *DeleteExecsConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[10]
+	mi := &file_execs_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +753,7 @@ func (x *DeleteExecsConfirmation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteExecsConfirmation.ProtoReflect.Descriptor instead.
 func (*DeleteExecsConfirmation) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{10}
+	return file_execs_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteExecsConfirmation) GetStatus() string {
@@ -579,7 +779,7 @@ type ExecIds struct {
 
 func (x *ExecIds) Reset() {
 	*x = ExecIds{}
-	mi := &file_execs_proto_msgTypes[11]
+	mi := &file_execs_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +791,7 @@ func (x *ExecIds) String() string {
 func (*ExecIds) ProtoMessage() {}
 
 func (x *ExecIds) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[11]
+	mi := &file_execs_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +804,7 @@ func (x *ExecIds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecIds.ProtoReflect.Descriptor instead.
 func (*ExecIds) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{11}
+	return file_execs_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ExecIds) GetIds() []string {
@@ -624,7 +824,7 @@ type GetExecsRequest struct {
 
 func (x *GetExecsRequest) Reset() {
 	*x = GetExecsRequest{}
-	mi := &file_execs_proto_msgTypes[12]
+	mi := &file_execs_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -636,7 +836,7 @@ func (x *GetExecsRequest) String() string {
 func (*GetExecsRequest) ProtoMessage() {}
 
 func (x *GetExecsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[12]
+	mi := &file_execs_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +849,7 @@ func (x *GetExecsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExecsRequest.ProtoReflect.Descriptor instead.
 func (*GetExecsRequest) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{12}
+	return file_execs_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetExecsRequest) GetExec() *Exec {
@@ -666,27 +866,40 @@ func (x *GetExecsRequest) GetSortBy() []*SortField {
 	return nil
 }
 
+// Exec is used for creates, partial updates and filters alike, so empty fields
+// are allowed here. AddExecs checks the fields a new exec must have.
 type Exec struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName            string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName             string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email                string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Username             string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
-	Password             string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
-	PasswordChangedAt    string                 `protobuf:"bytes,7,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"`
-	UserCreatedAt        string                 `protobuf:"bytes,8,opt,name=user_created_at,json=userCreatedAt,proto3" json:"user_created_at,omitempty"`
-	PasswordResetToken   string                 `protobuf:"bytes,9,opt,name=password_reset_token,json=passwordResetToken,proto3" json:"password_reset_token,omitempty"`
-	PasswordTokenExpires string                 `protobuf:"bytes,10,opt,name=password_token_expires,json=passwordTokenExpires,proto3" json:"password_token_expires,omitempty"`
-	Role                 string                 `protobuf:"bytes,11,opt,name=role,proto3" json:"role,omitempty"`
-	InactiveStatus       bool                   `protobuf:"varint,12,opt,name=inactive_status,json=inactiveStatus,proto3" json:"inactive_status,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email     string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Username  string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// password may be left empty, in which case the account is created in the
+	// "pending" state and the exec receives an activation link instead
+	Password                string `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	PasswordChangedAt       string `protobuf:"bytes,7,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"`
+	UserCreatedAt           string `protobuf:"bytes,8,opt,name=user_created_at,json=userCreatedAt,proto3" json:"user_created_at,omitempty"`
+	PasswordResetToken      string `protobuf:"bytes,9,opt,name=password_reset_token,json=passwordResetToken,proto3" json:"password_reset_token,omitempty"`
+	PasswordTokenExpires    string `protobuf:"bytes,10,opt,name=password_token_expires,json=passwordTokenExpires,proto3" json:"password_token_expires,omitempty"`
+	Role                    string `protobuf:"bytes,11,opt,name=role,proto3" json:"role,omitempty"`
+	InactiveStatus          bool   `protobuf:"varint,12,opt,name=inactive_status,json=inactiveStatus,proto3" json:"inactive_status,omitempty"`
+	AccountStatus           string `protobuf:"bytes,13,opt,name=account_status,json=accountStatus,proto3" json:"account_status,omitempty"`
+	ActivationToken         string `protobuf:"bytes,14,opt,name=activation_token,json=activationToken,proto3" json:"activation_token,omitempty"`
+	ActivationTokenExpires  string `protobuf:"bytes,15,opt,name=activation_token_expires,json=activationTokenExpires,proto3" json:"activation_token_expires,omitempty"`
+	PendingEmail            string `protobuf:"bytes,16,opt,name=pending_email,json=pendingEmail,proto3" json:"pending_email,omitempty"`
+	EmailChangeToken        string `protobuf:"bytes,17,opt,name=email_change_token,json=emailChangeToken,proto3" json:"email_change_token,omitempty"`
+	EmailChangeTokenExpires string `protobuf:"bytes,18,opt,name=email_change_token_expires,json=emailChangeTokenExpires,proto3" json:"email_change_token_expires,omitempty"`
+	// teacher_id links the account to a teacher record, letting it act as
+	// that teacher, e.g. to record attendance for their classes
+	TeacherId     string `protobuf:"bytes,19,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Exec) Reset() {
 	*x = Exec{}
-	mi := &file_execs_proto_msgTypes[13]
+	mi := &file_execs_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -698,7 +911,7 @@ func (x *Exec) String() string {
 func (*Exec) ProtoMessage() {}
 
 func (x *Exec) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[13]
+	mi := &file_execs_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,7 +924,7 @@ func (x *Exec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Exec.ProtoReflect.Descriptor instead.
 func (*Exec) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{13}
+	return file_execs_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Exec) GetId() string {
@@ -798,6 +1011,55 @@ func (x *Exec) GetInactiveStatus() bool {
 	return false
 }
 
+func (x *Exec) GetAccountStatus() string {
+	if x != nil {
+		return x.AccountStatus
+	}
+	return ""
+}
+
+func (x *Exec) GetActivationToken() string {
+	if x != nil {
+		return x.ActivationToken
+	}
+	return ""
+}
+
+func (x *Exec) GetActivationTokenExpires() string {
+	if x != nil {
+		return x.ActivationTokenExpires
+	}
+	return ""
+}
+
+func (x *Exec) GetPendingEmail() string {
+	if x != nil {
+		return x.PendingEmail
+	}
+	return ""
+}
+
+func (x *Exec) GetEmailChangeToken() string {
+	if x != nil {
+		return x.EmailChangeToken
+	}
+	return ""
+}
+
+func (x *Exec) GetEmailChangeTokenExpires() string {
+	if x != nil {
+		return x.EmailChangeTokenExpires
+	}
+	return ""
+}
+
+func (x *Exec) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
 type Execs struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Execs         []*Exec                `protobuf:"bytes,1,rep,name=execs,proto3" json:"execs,omitempty"`
@@ -807,7 +1069,7 @@ type Execs struct {
 
 func (x *Execs) Reset() {
 	*x = Execs{}
-	mi := &file_execs_proto_msgTypes[14]
+	mi := &file_execs_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -819,7 +1081,7 @@ func (x *Execs) String() string {
 func (*Execs) ProtoMessage() {}
 
 func (x *Execs) ProtoReflect() protoreflect.Message {
-	mi := &file_execs_proto_msgTypes[14]
+	mi := &file_execs_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,7 +1094,7 @@ func (x *Execs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Execs.ProtoReflect.Descriptor instead.
 func (*Execs) Descriptor() ([]byte, []int) {
-	return file_execs_proto_rawDescGZIP(), []int{14}
+	return file_execs_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Execs) GetExecs() []*Exec {
@@ -846,26 +1108,38 @@ var File_execs_proto protoreflect.FileDescriptor
 
 const file_execs_proto_rawDesc = "" +
 	"\n" +
-	"\vexecs.proto\x12\x04main\x1a\x0estudents.proto\x1a\x17validate/validate.proto\"V\n" +
+	"\vexecs.proto\x12\x04main\x1a\x0estudents.proto\x1a\x17validate/validate.proto\"A\n" +
+	"\x19RequestEmailChangeRequest\x12$\n" +
+	"\tnew_email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\bnewEmail\"Q\n" +
+	"\x19ConfirmEmailChangeRequest\x124\n" +
+	"\x11verification_code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x10verificationCode\"\xb7\x01\n" +
+	"\x16ActivateAccountRequest\x120\n" +
+	"\x0factivation_code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0eactivationCode\x12@\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x1d\xfaB\x1ar\x18\x10\t2\x14^[a-zA-Z0-9@.#$+-]+$R\vnewPassword\x12)\n" +
+	"\x10confirm_password\x18\x03 \x01(\tR\x0fconfirmPassword\"]\n" +
+	"\x18ResendActivationResponse\x12\"\n" +
+	"\fconfirmation\x18\x01 \x01(\bR\fconfirmation\x12\x1d\n" +
+	"\n" +
+	"resent_ids\x18\x02 \x03(\tR\tresentIds\"V\n" +
 	"\x16ForgotPasswordResponse\x12\"\n" +
 	"\fconfirmation\x18\x01 \x01(\bR\fconfirmation\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"-\n" +
-	"\x15ForgotPasswordRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"2\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
+	"\x15ForgotPasswordRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\"2\n" +
 	"\fConfirmation\x12\"\n" +
-	"\fconfirmation\x18\x01 \x01(\bR\fconfirmation\"\x83\x01\n" +
-	"\x14ResetPasswordRequest\x12\x1d\n" +
+	"\fconfirmation\x18\x01 \x01(\bR\fconfirmation\"\xab\x01\n" +
+	"\x14ResetPasswordRequest\x12&\n" +
 	"\n" +
-	"reset_code\x18\x01 \x01(\tR\tresetCode\x12!\n" +
-	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\x12)\n" +
+	"reset_code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tresetCode\x12@\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x1d\xfaB\x1ar\x18\x10\t2\x14^[a-zA-Z0-9@.#$+-]+$R\vnewPassword\x12)\n" +
 	"\x10confirm_password\x18\x03 \x01(\tR\x0fconfirmPassword\"Y\n" +
 	"\x16UpdatePasswordResponse\x12)\n" +
 	"\x10password_updated\x18\x01 \x01(\bR\x0fpasswordUpdated\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\"u\n" +
-	"\x15UpdatePasswordRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12)\n" +
-	"\x10current_password\x18\x02 \x01(\tR\x0fcurrentPassword\x12!\n" +
-	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"3\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xbb\x01\n" +
+	"\x15UpdatePasswordRequest\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\x122\n" +
+	"\x10current_password\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0fcurrentPassword\x12@\n" +
+	"\fnew_password\x18\x03 \x01(\tB\x1d\xfaB\x1ar\x18\x10\t2\x14^[a-zA-Z0-9@.#$+-]+$R\vnewPassword\"3\n" +
 	"\x12ExecLogoutResponse\x12\x1d\n" +
 	"\n" +
 	"logged_out\x18\x01 \x01(\bR\tloggedOut\"\x0e\n" +
@@ -879,31 +1153,40 @@ const file_execs_proto_rawDesc = "" +
 	"\x17DeleteExecsConfirmation\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
 	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
-	"deletedIds\"\x1b\n" +
-	"\aExecIds\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\tR\x03ids\"[\n" +
+	"deletedIds\"@\n" +
+	"\aExecIds\x125\n" +
+	"\x03ids\x18\x01 \x03(\tB#\xfaB \x92\x01\x1d\b\x01\"\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x03ids\"[\n" +
 	"\x0fGetExecsRequest\x12\x1e\n" +
 	"\x04exec\x18\x01 \x01(\v2\n" +
 	".main.ExecR\x04exec\x12(\n" +
-	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\"\x92\x04\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\"\xf5\x06\n" +
 	"\x04Exec\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
 	"\n" +
-	"first_name\x18\x02 \x01(\tB\x15\xfaB\x12r\x10\x10\x012\f^[a-zA-Z ]+$R\tfirstName\x122\n" +
-	"\tlast_name\x18\x03 \x01(\tB\x15\xfaB\x12r\x10\x10\x012\f^[a-zA-Z ]+$R\blastName\x12\x1d\n" +
-	"\x05email\x18\x04 \x01(\tB\a\xfaB\x04r\x02`\x01R\x05email\x129\n" +
-	"\busername\x18\x05 \x01(\tB\x1d\xfaB\x1ar\x18\x10\x062\x14^[a-zA-Z0-9@.#$+-]+$R\busername\x129\n" +
-	"\bpassword\x18\x06 \x01(\tB\x1d\xfaB\x1ar\x18\x10\t2\x14^[a-zA-Z0-9@.#$+-]+$R\bpassword\x12.\n" +
+	"first_name\x18\x02 \x01(\tB\x16\xfaB\x13r\x112\f^[a-zA-Z ]+$\xd0\x01\x01R\tfirstName\x123\n" +
+	"\tlast_name\x18\x03 \x01(\tB\x16\xfaB\x13r\x112\f^[a-zA-Z ]+$\xd0\x01\x01R\blastName\x12 \n" +
+	"\x05email\x18\x04 \x01(\tB\n" +
+	"\xfaB\ar\x05\xd0\x01\x01`\x01R\x05email\x12<\n" +
+	"\busername\x18\x05 \x01(\tB \xfaB\x1dr\x1b\x10\x062\x14^[a-zA-Z0-9@.#$+-]+$\xd0\x01\x01R\busername\x12<\n" +
+	"\bpassword\x18\x06 \x01(\tB \xfaB\x1dr\x1b\x10\t2\x14^[a-zA-Z0-9@.#$+-]+$\xd0\x01\x01R\bpassword\x12.\n" +
 	"\x13password_changed_at\x18\a \x01(\tR\x11passwordChangedAt\x12&\n" +
 	"\x0fuser_created_at\x18\b \x01(\tR\ruserCreatedAt\x120\n" +
 	"\x14password_reset_token\x18\t \x01(\tR\x12passwordResetToken\x124\n" +
 	"\x16password_token_expires\x18\n" +
 	" \x01(\tR\x14passwordTokenExpires\x12\x12\n" +
 	"\x04role\x18\v \x01(\tR\x04role\x12'\n" +
-	"\x0finactive_status\x18\f \x01(\bR\x0einactiveStatus\")\n" +
+	"\x0finactive_status\x18\f \x01(\bR\x0einactiveStatus\x12%\n" +
+	"\x0eaccount_status\x18\r \x01(\tR\raccountStatus\x12)\n" +
+	"\x10activation_token\x18\x0e \x01(\tR\x0factivationToken\x128\n" +
+	"\x18activation_token_expires\x18\x0f \x01(\tR\x16activationTokenExpires\x12#\n" +
+	"\rpending_email\x18\x10 \x01(\tR\fpendingEmail\x12,\n" +
+	"\x12email_change_token\x18\x11 \x01(\tR\x10emailChangeToken\x12;\n" +
+	"\x1aemail_change_token_expires\x18\x12 \x01(\tR\x17emailChangeTokenExpires\x12:\n" +
+	"\n" +
+	"teacher_id\x18\x13 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tteacherId\")\n" +
 	"\x05Execs\x12 \n" +
 	"\x05execs\x18\x01 \x03(\v2\n" +
-	".main.ExecR\x05execs2\xcc\x04\n" +
+	".main.ExecR\x05execs2\xea\x06\n" +
 	"\fExecsService\x12.\n" +
 	"\bGetExecs\x12\x15.main.GetExecsRequest\x1a\v.main.Execs\x12$\n" +
 	"\bAddExecs\x12\v.main.Execs\x1a\v.main.Execs\x12'\n" +
@@ -914,7 +1197,11 @@ const file_execs_proto_rawDesc = "" +
 	"\x0eUpdatePassword\x12\x1b.main.UpdatePasswordRequest\x1a\x1c.main.UpdatePasswordResponse\x12?\n" +
 	"\rResetPassword\x12\x1a.main.ResetPasswordRequest\x1a\x12.main.Confirmation\x12K\n" +
 	"\x0eForgotPassword\x12\x1b.main.ForgotPasswordRequest\x1a\x1c.main.ForgotPasswordResponse\x123\n" +
-	"\x0eDeactivateUser\x12\r.main.ExecIds\x1a\x12.main.ConfirmationB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+	"\x0eDeactivateUser\x12\r.main.ExecIds\x1a\x12.main.Confirmation\x12C\n" +
+	"\x0fActivateAccount\x12\x1c.main.ActivateAccountRequest\x1a\x12.main.Confirmation\x12A\n" +
+	"\x10ResendActivation\x12\r.main.ExecIds\x1a\x1e.main.ResendActivationResponse\x12I\n" +
+	"\x12RequestEmailChange\x12\x1f.main.RequestEmailChangeRequest\x1a\x12.main.Confirmation\x12I\n" +
+	"\x12ConfirmEmailChange\x12\x1f.main.ConfirmEmailChangeRequest\x1a\x12.main.ConfirmationB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
 
 var (
 	file_execs_proto_rawDescOnce sync.Once
@@ -928,51 +1215,63 @@ func file_execs_proto_rawDescGZIP() []byte {
 	return file_execs_proto_rawDescData
 }
 
-var file_execs_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_execs_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_execs_proto_goTypes = []any{
-	(*ForgotPasswordResponse)(nil),  // 0: main.ForgotPasswordResponse
-	(*ForgotPasswordRequest)(nil),   // 1: main.ForgotPasswordRequest
-	(*Confirmation)(nil),            // 2: main.Confirmation
-	(*ResetPasswordRequest)(nil),    // 3: main.ResetPasswordRequest
-	(*UpdatePasswordResponse)(nil),  // 4: main.UpdatePasswordResponse
-	(*UpdatePasswordRequest)(nil),   // 5: main.UpdatePasswordRequest
-	(*ExecLogoutResponse)(nil),      // 6: main.ExecLogoutResponse
-	(*EmptyRequest)(nil),            // 7: main.EmptyRequest
-	(*ExecLoginResponse)(nil),       // 8: main.ExecLoginResponse
-	(*ExecLoginRequest)(nil),        // 9: main.ExecLoginRequest
-	(*DeleteExecsConfirmation)(nil), // 10: main.DeleteExecsConfirmation
-	(*ExecIds)(nil),                 // 11: main.ExecIds
-	(*GetExecsRequest)(nil),         // 12: main.GetExecsRequest
-	(*Exec)(nil),                    // 13: main.Exec
-	(*Execs)(nil),                   // 14: main.Execs
-	(*SortField)(nil),               // 15: main.SortField
+	(*RequestEmailChangeRequest)(nil), // 0: main.RequestEmailChangeRequest
+	(*ConfirmEmailChangeRequest)(nil), // 1: main.ConfirmEmailChangeRequest
+	(*ActivateAccountRequest)(nil),    // 2: main.ActivateAccountRequest
+	(*ResendActivationResponse)(nil),  // 3: main.ResendActivationResponse
+	(*ForgotPasswordResponse)(nil),    // 4: main.ForgotPasswordResponse
+	(*ForgotPasswordRequest)(nil),     // 5: main.ForgotPasswordRequest
+	(*Confirmation)(nil),              // 6: main.Confirmation
+	(*ResetPasswordRequest)(nil),      // 7: main.ResetPasswordRequest
+	(*UpdatePasswordResponse)(nil),    // 8: main.UpdatePasswordResponse
+	(*UpdatePasswordRequest)(nil),     // 9: main.UpdatePasswordRequest
+	(*ExecLogoutResponse)(nil),        // 10: main.ExecLogoutResponse
+	(*EmptyRequest)(nil),              // 11: main.EmptyRequest
+	(*ExecLoginResponse)(nil),         // 12: main.ExecLoginResponse
+	(*ExecLoginRequest)(nil),          // 13: main.ExecLoginRequest
+	(*DeleteExecsConfirmation)(nil),   // 14: main.DeleteExecsConfirmation
+	(*ExecIds)(nil),                   // 15: main.ExecIds
+	(*GetExecsRequest)(nil),           // 16: main.GetExecsRequest
+	(*Exec)(nil),                      // 17: main.Exec
+	(*Execs)(nil),                     // 18: main.Execs
+	(*SortField)(nil),                 // 19: main.SortField
 }
 var file_execs_proto_depIdxs = []int32{
-	13, // 0: main.GetExecsRequest.exec:type_name -> main.Exec
-	15, // 1: main.GetExecsRequest.sort_by:type_name -> main.SortField
-	13, // 2: main.Execs.execs:type_name -> main.Exec
-	12, // 3: main.ExecsService.GetExecs:input_type -> main.GetExecsRequest
-	14, // 4: main.ExecsService.AddExecs:input_type -> main.Execs
-	14, // 5: main.ExecsService.UpdateExecs:input_type -> main.Execs
-	11, // 6: main.ExecsService.DeleteExecs:input_type -> main.ExecIds
-	9,  // 7: main.ExecsService.Login:input_type -> main.ExecLoginRequest
-	7,  // 8: main.ExecsService.Logout:input_type -> main.EmptyRequest
-	5,  // 9: main.ExecsService.UpdatePassword:input_type -> main.UpdatePasswordRequest
-	3,  // 10: main.ExecsService.ResetPassword:input_type -> main.ResetPasswordRequest
-	1,  // 11: main.ExecsService.ForgotPassword:input_type -> main.ForgotPasswordRequest
-	11, // 12: main.ExecsService.DeactivateUser:input_type -> main.ExecIds
-	14, // 13: main.ExecsService.GetExecs:output_type -> main.Execs
-	14, // 14: main.ExecsService.AddExecs:output_type -> main.Execs
-	14, // 15: main.ExecsService.UpdateExecs:output_type -> main.Execs
-	10, // 16: main.ExecsService.DeleteExecs:output_type -> main.DeleteExecsConfirmation
-	8,  // 17: main.ExecsService.Login:output_type -> main.ExecLoginResponse
-	6,  // 18: main.ExecsService.Logout:output_type -> main.ExecLogoutResponse
-	4,  // 19: main.ExecsService.UpdatePassword:output_type -> main.UpdatePasswordResponse
-	2,  // 20: main.ExecsService.ResetPassword:output_type -> main.Confirmation
-	0,  // 21: main.ExecsService.ForgotPassword:output_type -> main.ForgotPasswordResponse
-	2,  // 22: main.ExecsService.DeactivateUser:output_type -> main.Confirmation
-	13, // [13:23] is the sub-list for method output_type
-	3,  // [3:13] is the sub-list for method input_type
+	17, // 0: main.GetExecsRequest.exec:type_name -> main.Exec
+	19, // 1: main.GetExecsRequest.sort_by:type_name -> main.SortField
+	17, // 2: main.Execs.execs:type_name -> main.Exec
+	16, // 3: main.ExecsService.GetExecs:input_type -> main.GetExecsRequest
+	18, // 4: main.ExecsService.AddExecs:input_type -> main.Execs
+	18, // 5: main.ExecsService.UpdateExecs:input_type -> main.Execs
+	15, // 6: main.ExecsService.DeleteExecs:input_type -> main.ExecIds
+	13, // 7: main.ExecsService.Login:input_type -> main.ExecLoginRequest
+	11, // 8: main.ExecsService.Logout:input_type -> main.EmptyRequest
+	9,  // 9: main.ExecsService.UpdatePassword:input_type -> main.UpdatePasswordRequest
+	7,  // 10: main.ExecsService.ResetPassword:input_type -> main.ResetPasswordRequest
+	5,  // 11: main.ExecsService.ForgotPassword:input_type -> main.ForgotPasswordRequest
+	15, // 12: main.ExecsService.DeactivateUser:input_type -> main.ExecIds
+	2,  // 13: main.ExecsService.ActivateAccount:input_type -> main.ActivateAccountRequest
+	15, // 14: main.ExecsService.ResendActivation:input_type -> main.ExecIds
+	0,  // 15: main.ExecsService.RequestEmailChange:input_type -> main.RequestEmailChangeRequest
+	1,  // 16: main.ExecsService.ConfirmEmailChange:input_type -> main.ConfirmEmailChangeRequest
+	18, // 17: main.ExecsService.GetExecs:output_type -> main.Execs
+	18, // 18: main.ExecsService.AddExecs:output_type -> main.Execs
+	18, // 19: main.ExecsService.UpdateExecs:output_type -> main.Execs
+	14, // 20: main.ExecsService.DeleteExecs:output_type -> main.DeleteExecsConfirmation
+	12, // 21: main.ExecsService.Login:output_type -> main.ExecLoginResponse
+	10, // 22: main.ExecsService.Logout:output_type -> main.ExecLogoutResponse
+	8,  // 23: main.ExecsService.UpdatePassword:output_type -> main.UpdatePasswordResponse
+	6,  // 24: main.ExecsService.ResetPassword:output_type -> main.Confirmation
+	4,  // 25: main.ExecsService.ForgotPassword:output_type -> main.ForgotPasswordResponse
+	6,  // 26: main.ExecsService.DeactivateUser:output_type -> main.Confirmation
+	6,  // 27: main.ExecsService.ActivateAccount:output_type -> main.Confirmation
+	3,  // 28: main.ExecsService.ResendActivation:output_type -> main.ResendActivationResponse
+	6,  // 29: main.ExecsService.RequestEmailChange:output_type -> main.Confirmation
+	6,  // 30: main.ExecsService.ConfirmEmailChange:output_type -> main.Confirmation
+	17, // [17:31] is the sub-list for method output_type
+	3,  // [3:17] is the sub-list for method input_type
 	3,  // [3:3] is the sub-list for extension type_name
 	3,  // [3:3] is the sub-list for extension extendee
 	0,  // [0:3] is the sub-list for field type_name
@@ -990,7 +1289,7 @@ func file_execs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_execs_proto_rawDesc), len(file_execs_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
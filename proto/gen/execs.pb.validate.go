@@ -35,6 +35,526 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on RequestEmailChangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestEmailChangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestEmailChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestEmailChangeRequestMultiError, or nil if none found.
+func (m *RequestEmailChangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestEmailChangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateEmail(m.GetNewEmail()); err != nil {
+		err = RequestEmailChangeRequestValidationError{
+			field:  "NewEmail",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestEmailChangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RequestEmailChangeRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RequestEmailChangeRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RequestEmailChangeRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestEmailChangeRequest.ValidateAll() if the
+// designated constraints aren't met.
+type RequestEmailChangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestEmailChangeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestEmailChangeRequestMultiError) AllErrors() []error { return m }
+
+// RequestEmailChangeRequestValidationError is the validation error returned by
+// RequestEmailChangeRequest.Validate if the designated constraints aren't met.
+type RequestEmailChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestEmailChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestEmailChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestEmailChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestEmailChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestEmailChangeRequestValidationError) ErrorName() string {
+	return "RequestEmailChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestEmailChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestEmailChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestEmailChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestEmailChangeRequestValidationError{}
+
+// Validate checks the field values on ConfirmEmailChangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmEmailChangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmEmailChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmEmailChangeRequestMultiError, or nil if none found.
+func (m *ConfirmEmailChangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmEmailChangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetVerificationCode()) < 1 {
+		err := ConfirmEmailChangeRequestValidationError{
+			field:  "VerificationCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfirmEmailChangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmEmailChangeRequestMultiError is an error wrapping multiple validation
+// errors returned by ConfirmEmailChangeRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ConfirmEmailChangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmEmailChangeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmEmailChangeRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmEmailChangeRequestValidationError is the validation error returned by
+// ConfirmEmailChangeRequest.Validate if the designated constraints aren't met.
+type ConfirmEmailChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmEmailChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmEmailChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmEmailChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmEmailChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmEmailChangeRequestValidationError) ErrorName() string {
+	return "ConfirmEmailChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmEmailChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmEmailChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmEmailChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmEmailChangeRequestValidationError{}
+
+// Validate checks the field values on ActivateAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ActivateAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivateAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActivateAccountRequestMultiError, or nil if none found.
+func (m *ActivateAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivateAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetActivationCode()) < 1 {
+		err := ActivateAccountRequestValidationError{
+			field:  "ActivationCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNewPassword()) < 9 {
+		err := ActivateAccountRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be at least 9 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ActivateAccountRequest_NewPassword_Pattern.MatchString(m.GetNewPassword()) {
+		err := ActivateAccountRequestValidationError{
+			field:  "NewPassword",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9@.#$+-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ConfirmPassword
+
+	if len(errors) > 0 {
+		return ActivateAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivateAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by ActivateAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ActivateAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivateAccountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivateAccountRequestMultiError) AllErrors() []error { return m }
+
+// ActivateAccountRequestValidationError is the validation error returned by
+// ActivateAccountRequest.Validate if the designated constraints aren't met.
+type ActivateAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivateAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivateAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivateAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivateAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivateAccountRequestValidationError) ErrorName() string {
+	return "ActivateAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActivateAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivateAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivateAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivateAccountRequestValidationError{}
+
+var _ActivateAccountRequest_NewPassword_Pattern = regexp.MustCompile("^[a-zA-Z0-9@.#$+-]+$")
+
+// Validate checks the field values on ResendActivationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResendActivationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResendActivationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResendActivationResponseMultiError, or nil if none found.
+func (m *ResendActivationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResendActivationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Confirmation
+
+	if len(errors) > 0 {
+		return ResendActivationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResendActivationResponseMultiError is an error wrapping multiple validation
+// errors returned by ResendActivationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResendActivationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResendActivationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResendActivationResponseMultiError) AllErrors() []error { return m }
+
+// ResendActivationResponseValidationError is the validation error returned by
+// ResendActivationResponse.Validate if the designated constraints aren't met.
+type ResendActivationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResendActivationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResendActivationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResendActivationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResendActivationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResendActivationResponseValidationError) ErrorName() string {
+	return "ResendActivationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResendActivationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResendActivationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResendActivationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResendActivationResponseValidationError{}
+
 // Validate checks the field values on ForgotPasswordResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -163,7 +683,17 @@ func (m *ForgotPasswordRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Email
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = ForgotPasswordRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return ForgotPasswordRequestMultiError(errors)
@@ -172,6 +702,56 @@ func (m *ForgotPasswordRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *ForgotPasswordRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *ForgotPasswordRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
 // ForgotPasswordRequestMultiError is an error wrapping multiple validation
 // errors returned by ForgotPasswordRequest.ValidateAll() if the designated
 // constraints aren't met.
@@ -368,9 +948,38 @@ func (m *ResetPasswordRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for ResetCode
+	if utf8.RuneCountInString(m.GetResetCode()) < 1 {
+		err := ResetPasswordRequestValidationError{
+			field:  "ResetCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNewPassword()) < 9 {
+		err := ResetPasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be at least 9 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for NewPassword
+	if !_ResetPasswordRequest_NewPassword_Pattern.MatchString(m.GetNewPassword()) {
+		err := ResetPasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9@.#$+-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for ConfirmPassword
 
@@ -454,6 +1063,8 @@ var _ interface {
 	ErrorName() string
 } = ResetPasswordRequestValidationError{}
 
+var _ResetPasswordRequest_NewPassword_Pattern = regexp.MustCompile("^[a-zA-Z0-9@.#$+-]+$")
+
 // Validate checks the field values on UpdatePasswordResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -582,11 +1193,61 @@ func (m *UpdatePasswordRequest) validate(all bool) error {
 
 	var errors []error
 
-	// no validation rules for Id
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := UpdatePasswordRequestValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_UpdatePasswordRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := UpdatePasswordRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCurrentPassword()) < 1 {
+		err := UpdatePasswordRequestValidationError{
+			field:  "CurrentPassword",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for CurrentPassword
+	if utf8.RuneCountInString(m.GetNewPassword()) < 9 {
+		err := UpdatePasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be at least 9 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for NewPassword
+	if !_UpdatePasswordRequest_NewPassword_Pattern.MatchString(m.GetNewPassword()) {
+		err := UpdatePasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9@.#$+-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
 		return UpdatePasswordRequestMultiError(errors)
@@ -668,6 +1329,10 @@ var _ interface {
 	ErrorName() string
 } = UpdatePasswordRequestValidationError{}
 
+var _UpdatePasswordRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _UpdatePasswordRequest_NewPassword_Pattern = regexp.MustCompile("^[a-zA-Z0-9@.#$+-]+$")
+
 // Validate checks the field values on ExecLogoutResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1250,6 +1915,45 @@ func (m *ExecIds) validate(all bool) error {
 
 	var errors []error
 
+	if len(m.GetIds()) < 1 {
+		err := ExecIdsValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) != 24 {
+			err := ExecIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value length must be 24 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_ExecIds_Ids_Pattern.MatchString(item) {
+			err := ExecIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ExecIdsMultiError(errors)
 	}
@@ -1327,6 +2031,8 @@ var _ interface {
 	ErrorName() string
 } = ExecIdsValidationError{}
 
+var _ExecIds_Ids_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
 // Validate checks the field values on GetExecsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1513,104 +2219,102 @@ func (m *Exec) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if utf8.RuneCountInString(m.GetFirstName()) < 1 {
-		err := ExecValidationError{
-			field:  "FirstName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	if m.GetFirstName() != "" {
 
-	if !_Exec_FirstName_Pattern.MatchString(m.GetFirstName()) {
-		err := ExecValidationError{
-			field:  "FirstName",
-			reason: "value does not match regex pattern \"^[a-zA-Z ]+$\"",
-		}
-		if !all {
-			return err
+		if !_Exec_FirstName_Pattern.MatchString(m.GetFirstName()) {
+			err := ExecValidationError{
+				field:  "FirstName",
+				reason: "value does not match regex pattern \"^[a-zA-Z ]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetLastName()) < 1 {
-		err := ExecValidationError{
-			field:  "LastName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if !_Exec_LastName_Pattern.MatchString(m.GetLastName()) {
-		err := ExecValidationError{
-			field:  "LastName",
-			reason: "value does not match regex pattern \"^[a-zA-Z ]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	if m.GetLastName() != "" {
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = ExecValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
+		if !_Exec_LastName_Pattern.MatchString(m.GetLastName()) {
+			err := ExecValidationError{
+				field:  "LastName",
+				reason: "value does not match regex pattern \"^[a-zA-Z ]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetUsername()) < 6 {
-		err := ExecValidationError{
-			field:  "Username",
-			reason: "value length must be at least 6 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
-	if !_Exec_Username_Pattern.MatchString(m.GetUsername()) {
-		err := ExecValidationError{
-			field:  "Username",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9@.#$+-]+$\"",
-		}
-		if !all {
-			return err
+	if m.GetEmail() != "" {
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = ExecValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
-	if utf8.RuneCountInString(m.GetPassword()) < 9 {
-		err := ExecValidationError{
-			field:  "Password",
-			reason: "value length must be at least 9 runes",
+	if m.GetUsername() != "" {
+
+		if utf8.RuneCountInString(m.GetUsername()) < 6 {
+			err := ExecValidationError{
+				field:  "Username",
+				reason: "value length must be at least 6 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		if !all {
-			return err
+
+		if !_Exec_Username_Pattern.MatchString(m.GetUsername()) {
+			err := ExecValidationError{
+				field:  "Username",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9@.#$+-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
-	if !_Exec_Password_Pattern.MatchString(m.GetPassword()) {
-		err := ExecValidationError{
-			field:  "Password",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9@.#$+-]+$\"",
+	if m.GetPassword() != "" {
+
+		if utf8.RuneCountInString(m.GetPassword()) < 9 {
+			err := ExecValidationError{
+				field:  "Password",
+				reason: "value length must be at least 9 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		if !all {
-			return err
+
+		if !_Exec_Password_Pattern.MatchString(m.GetPassword()) {
+			err := ExecValidationError{
+				field:  "Password",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9@.#$+-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
 	// no validation rules for PasswordChangedAt
@@ -1625,6 +2329,33 @@ func (m *Exec) validate(all bool) error {
 
 	// no validation rules for InactiveStatus
 
+	// no validation rules for AccountStatus
+
+	// no validation rules for ActivationToken
+
+	// no validation rules for ActivationTokenExpires
+
+	// no validation rules for PendingEmail
+
+	// no validation rules for EmailChangeToken
+
+	// no validation rules for EmailChangeTokenExpires
+
+	if m.GetTeacherId() != "" {
+
+		if !_Exec_TeacherId_Pattern.MatchString(m.GetTeacherId()) {
+			err := ExecValidationError{
+				field:  "TeacherId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ExecMultiError(errors)
 	}
@@ -1760,6 +2491,8 @@ var _Exec_Username_Pattern = regexp.MustCompile("^[a-zA-Z0-9@.#$+-]+$")
 
 var _Exec_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9@.#$+-]+$")
 
+var _Exec_TeacherId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
 // Validate checks the field values on Execs with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -19,16 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExecsService_GetExecs_FullMethodName       = "/main.ExecsService/GetExecs"
-	ExecsService_AddExecs_FullMethodName       = "/main.ExecsService/AddExecs"
-	ExecsService_UpdateExecs_FullMethodName    = "/main.ExecsService/UpdateExecs"
-	ExecsService_DeleteExecs_FullMethodName    = "/main.ExecsService/DeleteExecs"
-	ExecsService_Login_FullMethodName          = "/main.ExecsService/Login"
-	ExecsService_Logout_FullMethodName         = "/main.ExecsService/Logout"
-	ExecsService_UpdatePassword_FullMethodName = "/main.ExecsService/UpdatePassword"
-	ExecsService_ResetPassword_FullMethodName  = "/main.ExecsService/ResetPassword"
-	ExecsService_ForgotPassword_FullMethodName = "/main.ExecsService/ForgotPassword"
-	ExecsService_DeactivateUser_FullMethodName = "/main.ExecsService/DeactivateUser"
+	ExecsService_GetExecs_FullMethodName           = "/main.ExecsService/GetExecs"
+	ExecsService_AddExecs_FullMethodName           = "/main.ExecsService/AddExecs"
+	ExecsService_UpdateExecs_FullMethodName        = "/main.ExecsService/UpdateExecs"
+	ExecsService_DeleteExecs_FullMethodName        = "/main.ExecsService/DeleteExecs"
+	ExecsService_Login_FullMethodName              = "/main.ExecsService/Login"
+	ExecsService_Logout_FullMethodName             = "/main.ExecsService/Logout"
+	ExecsService_UpdatePassword_FullMethodName     = "/main.ExecsService/UpdatePassword"
+	ExecsService_ResetPassword_FullMethodName      = "/main.ExecsService/ResetPassword"
+	ExecsService_ForgotPassword_FullMethodName     = "/main.ExecsService/ForgotPassword"
+	ExecsService_DeactivateUser_FullMethodName     = "/main.ExecsService/DeactivateUser"
+	ExecsService_ActivateAccount_FullMethodName    = "/main.ExecsService/ActivateAccount"
+	ExecsService_ResendActivation_FullMethodName   = "/main.ExecsService/ResendActivation"
+	ExecsService_RequestEmailChange_FullMethodName = "/main.ExecsService/RequestEmailChange"
+	ExecsService_ConfirmEmailChange_FullMethodName = "/main.ExecsService/ConfirmEmailChange"
 )
 
 // ExecsServiceClient is the client API for ExecsService service.
@@ -45,6 +49,10 @@ type ExecsServiceClient interface {
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*Confirmation, error)
 	ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*ForgotPasswordResponse, error)
 	DeactivateUser(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*Confirmation, error)
+	ActivateAccount(ctx context.Context, in *ActivateAccountRequest, opts ...grpc.CallOption) (*Confirmation, error)
+	ResendActivation(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*ResendActivationResponse, error)
+	RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*Confirmation, error)
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*Confirmation, error)
 }
 
 type execsServiceClient struct {
@@ -155,6 +163,46 @@ func (c *execsServiceClient) DeactivateUser(ctx context.Context, in *ExecIds, op
 	return out, nil
 }
 
+func (c *execsServiceClient) ActivateAccount(ctx context.Context, in *ActivateAccountRequest, opts ...grpc.CallOption) (*Confirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Confirmation)
+	err := c.cc.Invoke(ctx, ExecsService_ActivateAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *execsServiceClient) ResendActivation(ctx context.Context, in *ExecIds, opts ...grpc.CallOption) (*ResendActivationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendActivationResponse)
+	err := c.cc.Invoke(ctx, ExecsService_ResendActivation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *execsServiceClient) RequestEmailChange(ctx context.Context, in *RequestEmailChangeRequest, opts ...grpc.CallOption) (*Confirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Confirmation)
+	err := c.cc.Invoke(ctx, ExecsService_RequestEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *execsServiceClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*Confirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Confirmation)
+	err := c.cc.Invoke(ctx, ExecsService_ConfirmEmailChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExecsServiceServer is the server API for ExecsService service.
 // All implementations must embed UnimplementedExecsServiceServer
 // for forward compatibility.
@@ -169,6 +217,10 @@ type ExecsServiceServer interface {
 	ResetPassword(context.Context, *ResetPasswordRequest) (*Confirmation, error)
 	ForgotPassword(context.Context, *ForgotPasswordRequest) (*ForgotPasswordResponse, error)
 	DeactivateUser(context.Context, *ExecIds) (*Confirmation, error)
+	ActivateAccount(context.Context, *ActivateAccountRequest) (*Confirmation, error)
+	ResendActivation(context.Context, *ExecIds) (*ResendActivationResponse, error)
+	RequestEmailChange(context.Context, *RequestEmailChangeRequest) (*Confirmation, error)
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*Confirmation, error)
 	mustEmbedUnimplementedExecsServiceServer()
 }
 
@@ -209,6 +261,18 @@ func (UnimplementedExecsServiceServer) ForgotPassword(context.Context, *ForgotPa
 func (UnimplementedExecsServiceServer) DeactivateUser(context.Context, *ExecIds) (*Confirmation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateUser not implemented")
 }
+func (UnimplementedExecsServiceServer) ActivateAccount(context.Context, *ActivateAccountRequest) (*Confirmation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateAccount not implemented")
+}
+func (UnimplementedExecsServiceServer) ResendActivation(context.Context, *ExecIds) (*ResendActivationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendActivation not implemented")
+}
+func (UnimplementedExecsServiceServer) RequestEmailChange(context.Context, *RequestEmailChangeRequest) (*Confirmation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailChange not implemented")
+}
+func (UnimplementedExecsServiceServer) ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*Confirmation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailChange not implemented")
+}
 func (UnimplementedExecsServiceServer) mustEmbedUnimplementedExecsServiceServer() {}
 func (UnimplementedExecsServiceServer) testEmbeddedByValue()                      {}
 
@@ -410,6 +474,78 @@ func _ExecsService_DeactivateUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExecsService_ActivateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).ActivateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_ActivateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).ActivateAccount(ctx, req.(*ActivateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecsService_ResendActivation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).ResendActivation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_ResendActivation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).ResendActivation(ctx, req.(*ExecIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecsService_RequestEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).RequestEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_RequestEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).RequestEmailChange(ctx, req.(*RequestEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecsService_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecsServiceServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExecsService_ConfirmEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecsServiceServer).ConfirmEmailChange(ctx, req.(*ConfirmEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExecsService_ServiceDesc is the grpc.ServiceDesc for ExecsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -457,6 +593,22 @@ var ExecsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateUser",
 			Handler:    _ExecsService_DeactivateUser_Handler,
 		},
+		{
+			MethodName: "ActivateAccount",
+			Handler:    _ExecsService_ActivateAccount_Handler,
+		},
+		{
+			MethodName: "ResendActivation",
+			Handler:    _ExecsService_ResendActivation_Handler,
+		},
+		{
+			MethodName: "RequestEmailChange",
+			Handler:    _ExecsService_RequestEmailChange_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _ExecsService_ConfirmEmailChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "execs.proto",
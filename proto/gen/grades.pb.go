@@ -0,0 +1,1243 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: grades.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AssessmentType int32
+
+const (
+	AssessmentType_ASSESSMENT_TYPE_UNSPECIFIED AssessmentType = 0
+	AssessmentType_QUIZ                        AssessmentType = 1
+	AssessmentType_EXAM                        AssessmentType = 2
+	AssessmentType_ASSIGNMENT                  AssessmentType = 3
+)
+
+// Enum value maps for AssessmentType.
+var (
+	AssessmentType_name = map[int32]string{
+		0: "ASSESSMENT_TYPE_UNSPECIFIED",
+		1: "QUIZ",
+		2: "EXAM",
+		3: "ASSIGNMENT",
+	}
+	AssessmentType_value = map[string]int32{
+		"ASSESSMENT_TYPE_UNSPECIFIED": 0,
+		"QUIZ":                        1,
+		"EXAM":                        2,
+		"ASSIGNMENT":                  3,
+	}
+)
+
+func (x AssessmentType) Enum() *AssessmentType {
+	p := new(AssessmentType)
+	*p = x
+	return p
+}
+
+func (x AssessmentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssessmentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_grades_proto_enumTypes[0].Descriptor()
+}
+
+func (AssessmentType) Type() protoreflect.EnumType {
+	return &file_grades_proto_enumTypes[0]
+}
+
+func (x AssessmentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssessmentType.Descriptor instead.
+func (AssessmentType) EnumDescriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{0}
+}
+
+type Assessment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassId   string                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	SubjectId string                 `protobuf:"bytes,3,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// term names the teaching period, as in TeachingAssignment
+	Term     string         `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	Title    string         `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Type     AssessmentType `protobuf:"varint,6,opt,name=type,proto3,enum=main.AssessmentType" json:"type,omitempty"`
+	MaxScore float32        `protobuf:"fixed32,7,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	// weight is the assessment's share of the average relative to the other
+	// assessments of the same class, subject and term
+	Weight float32 `protobuf:"fixed32,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	// date is when the assessment takes place, YYYY-MM-DD
+	Date string `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`
+	// created_by is the ID of the account that added the assessment
+	CreatedBy     string `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assessment) Reset() {
+	*x = Assessment{}
+	mi := &file_grades_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assessment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assessment) ProtoMessage() {}
+
+func (x *Assessment) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assessment.ProtoReflect.Descriptor instead.
+func (*Assessment) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Assessment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Assessment) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Assessment) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *Assessment) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Assessment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Assessment) GetType() AssessmentType {
+	if x != nil {
+		return x.Type
+	}
+	return AssessmentType_ASSESSMENT_TYPE_UNSPECIFIED
+}
+
+func (x *Assessment) GetMaxScore() float32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Assessment) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Assessment) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Assessment) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type Assessments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assessments   []*Assessment          `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Assessments) Reset() {
+	*x = Assessments{}
+	mi := &file_grades_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assessments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assessments) ProtoMessage() {}
+
+func (x *Assessments) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assessments.ProtoReflect.Descriptor instead.
+func (*Assessments) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Assessments) GetAssessments() []*Assessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+type GetAssessmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Term          string                 `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssessmentsRequest) Reset() {
+	*x = GetAssessmentsRequest{}
+	mi := &file_grades_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssessmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssessmentsRequest) ProtoMessage() {}
+
+func (x *GetAssessmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssessmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAssessmentsRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *GetAssessmentsRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *GetAssessmentsRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type AssessmentIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessmentIds) Reset() {
+	*x = AssessmentIds{}
+	mi := &file_grades_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessmentIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessmentIds) ProtoMessage() {}
+
+func (x *AssessmentIds) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessmentIds.ProtoReflect.Descriptor instead.
+func (*AssessmentIds) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AssessmentIds) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type AssessmentId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssessmentId) Reset() {
+	*x = AssessmentId{}
+	mi := &file_grades_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssessmentId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessmentId) ProtoMessage() {}
+
+func (x *AssessmentId) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessmentId.ProtoReflect.Descriptor instead.
+func (*AssessmentId) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AssessmentId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAssessmentsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAssessmentsConfirmation) Reset() {
+	*x = DeleteAssessmentsConfirmation{}
+	mi := &file_grades_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAssessmentsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAssessmentsConfirmation) ProtoMessage() {}
+
+func (x *DeleteAssessmentsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAssessmentsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteAssessmentsConfirmation) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteAssessmentsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteAssessmentsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type RecordScoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssessmentId  string                 `protobuf:"bytes,1,opt,name=assessment_id,json=assessmentId,proto3" json:"assessment_id,omitempty"`
+	Scores        []*ScoreEntry          `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordScoresRequest) Reset() {
+	*x = RecordScoresRequest{}
+	mi := &file_grades_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordScoresRequest) ProtoMessage() {}
+
+func (x *RecordScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordScoresRequest.ProtoReflect.Descriptor instead.
+func (*RecordScoresRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RecordScoresRequest) GetAssessmentId() string {
+	if x != nil {
+		return x.AssessmentId
+	}
+	return ""
+}
+
+func (x *RecordScoresRequest) GetScores() []*ScoreEntry {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type ScoreEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// score must be between 0 and the assessment's max score
+	Score float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	// excused students are left out of the average for this assessment
+	Excused       bool `protobuf:"varint,3,opt,name=excused,proto3" json:"excused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreEntry) Reset() {
+	*x = ScoreEntry{}
+	mi := &file_grades_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreEntry) ProtoMessage() {}
+
+func (x *ScoreEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreEntry.ProtoReflect.Descriptor instead.
+func (*ScoreEntry) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScoreEntry) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ScoreEntry) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScoreEntry) GetExcused() bool {
+	if x != nil {
+		return x.Excused
+	}
+	return false
+}
+
+type Score struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssessmentId string                 `protobuf:"bytes,2,opt,name=assessment_id,json=assessmentId,proto3" json:"assessment_id,omitempty"`
+	StudentId    string                 `protobuf:"bytes,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Score        float32                `protobuf:"fixed32,4,opt,name=score,proto3" json:"score,omitempty"`
+	Excused      bool                   `protobuf:"varint,5,opt,name=excused,proto3" json:"excused,omitempty"`
+	// recorded_by is the ID of the account that made the latest change
+	RecordedBy    string `protobuf:"bytes,6,opt,name=recorded_by,json=recordedBy,proto3" json:"recorded_by,omitempty"`
+	RecordedAt    string `protobuf:"bytes,7,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	mi := &file_grades_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Score) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Score) GetAssessmentId() string {
+	if x != nil {
+		return x.AssessmentId
+	}
+	return ""
+}
+
+func (x *Score) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Score) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Score) GetExcused() bool {
+	if x != nil {
+		return x.Excused
+	}
+	return false
+}
+
+func (x *Score) GetRecordedBy() string {
+	if x != nil {
+		return x.RecordedBy
+	}
+	return ""
+}
+
+func (x *Score) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+type Scores struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scores        []*Score               `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Scores) Reset() {
+	*x = Scores{}
+	mi := &file_grades_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scores) ProtoMessage() {}
+
+func (x *Scores) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scores.ProtoReflect.Descriptor instead.
+func (*Scores) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Scores) GetScores() []*Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type StudentGradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentGradesRequest) Reset() {
+	*x = StudentGradesRequest{}
+	mi := &file_grades_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGradesRequest) ProtoMessage() {}
+
+func (x *StudentGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGradesRequest.ProtoReflect.Descriptor instead.
+func (*StudentGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StudentGradesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentGradesRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type ClassGradesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassId       string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	SubjectId     string                 `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Term          string                 `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassGradesRequest) Reset() {
+	*x = ClassGradesRequest{}
+	mi := &file_grades_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassGradesRequest) ProtoMessage() {}
+
+func (x *ClassGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassGradesRequest.ProtoReflect.Descriptor instead.
+func (*ClassGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClassGradesRequest) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *ClassGradesRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *ClassGradesRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+// Grade is a student's weighted average in one class, subject and term.
+// Assessments without a score for the student, or where the student was
+// excused, are left out.
+type Grade struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	ClassId   string                 `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	SubjectId string                 `protobuf:"bytes,3,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Term      string                 `protobuf:"bytes,4,opt,name=term,proto3" json:"term,omitempty"`
+	// percentage is the weighted average of score / max score, 0-100
+	Percentage        float32 `protobuf:"fixed32,5,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Letter            string  `protobuf:"bytes,6,opt,name=letter,proto3" json:"letter,omitempty"`
+	GradedAssessments int32   `protobuf:"varint,7,opt,name=graded_assessments,json=gradedAssessments,proto3" json:"graded_assessments,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Grade) Reset() {
+	*x = Grade{}
+	mi := &file_grades_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Grade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grade) ProtoMessage() {}
+
+func (x *Grade) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grade.ProtoReflect.Descriptor instead.
+func (*Grade) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Grade) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Grade) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *Grade) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *Grade) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Grade) GetPercentage() float32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *Grade) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *Grade) GetGradedAssessments() int32 {
+	if x != nil {
+		return x.GradedAssessments
+	}
+	return 0
+}
+
+type Grades struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grades        []*Grade               `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Grades) Reset() {
+	*x = Grades{}
+	mi := &file_grades_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Grades) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grades) ProtoMessage() {}
+
+func (x *Grades) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grades.ProtoReflect.Descriptor instead.
+func (*Grades) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Grades) GetGrades() []*Grade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+type GradingScale struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Boundaries    []*GradeBoundary       `protobuf:"bytes,1,rep,name=boundaries,proto3" json:"boundaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradingScale) Reset() {
+	*x = GradingScale{}
+	mi := &file_grades_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradingScale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradingScale) ProtoMessage() {}
+
+func (x *GradingScale) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradingScale.ProtoReflect.Descriptor instead.
+func (*GradingScale) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GradingScale) GetBoundaries() []*GradeBoundary {
+	if x != nil {
+		return x.Boundaries
+	}
+	return nil
+}
+
+// GradeBoundary gives letter to averages of at least min_percentage, up to
+// the next boundary.
+type GradeBoundary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Letter        string                 `protobuf:"bytes,1,opt,name=letter,proto3" json:"letter,omitempty"`
+	MinPercentage float32                `protobuf:"fixed32,2,opt,name=min_percentage,json=minPercentage,proto3" json:"min_percentage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeBoundary) Reset() {
+	*x = GradeBoundary{}
+	mi := &file_grades_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeBoundary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeBoundary) ProtoMessage() {}
+
+func (x *GradeBoundary) ProtoReflect() protoreflect.Message {
+	mi := &file_grades_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeBoundary.ProtoReflect.Descriptor instead.
+func (*GradeBoundary) Descriptor() ([]byte, []int) {
+	return file_grades_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GradeBoundary) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *GradeBoundary) GetMinPercentage() float32 {
+	if x != nil {
+		return x.MinPercentage
+	}
+	return 0
+}
+
+var File_grades_proto protoreflect.FileDescriptor
+
+const file_grades_proto_rawDesc = "" +
+	"\n" +
+	"\fgrades.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\vexecs.proto\"\xba\x03\n" +
+	"\n" +
+	"Assessment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\bclass_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\x12:\n" +
+	"\n" +
+	"subject_id\x18\x03 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tsubjectId\x12-\n" +
+	"\x04term\x18\x04 \x01(\tB\x19\xfaB\x16r\x14\x18 2\x10^[A-Za-z0-9 -]*$R\x04term\x12\x1d\n" +
+	"\x05title\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x18dR\x05title\x122\n" +
+	"\x04type\x18\x06 \x01(\x0e2\x14.main.AssessmentTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04type\x12'\n" +
+	"\tmax_score\x18\a \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00R\bmaxScore\x12\"\n" +
+	"\x06weight\x18\b \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00R\x06weight\x12:\n" +
+	"\x04date\x18\t \x01(\tB&\xfaB#r!2\x1f^([0-9]{4}-[0-9]{2}-[0-9]{2})?$R\x04date\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\"A\n" +
+	"\vAssessments\x122\n" +
+	"\vassessments\x18\x01 \x03(\v2\x10.main.AssessmentR\vassessments\"\x9f\x01\n" +
+	"\x15GetAssessmentsRequest\x126\n" +
+	"\bclass_id\x18\x01 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\x12:\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tsubjectId\x12\x12\n" +
+	"\x04term\x18\x03 \x01(\tR\x04term\"F\n" +
+	"\rAssessmentIds\x125\n" +
+	"\x03ids\x18\x01 \x03(\tB#\xfaB \x92\x01\x1d\b\x01\"\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x03ids\"<\n" +
+	"\fAssessmentId\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\"X\n" +
+	"\x1dDeleteAssessmentsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"\x8c\x01\n" +
+	"\x13RecordScoresRequest\x12A\n" +
+	"\rassessment_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\fassessmentId\x122\n" +
+	"\x06scores\x18\x02 \x03(\v2\x10.main.ScoreEntryB\b\xfaB\x05\x92\x01\x02\b\x01R\x06scores\"\x85\x01\n" +
+	"\n" +
+	"ScoreEntry\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12 \n" +
+	"\x05score\x18\x02 \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00R\x05score\x12\x18\n" +
+	"\aexcused\x18\x03 \x01(\bR\aexcused\"\xcd\x01\n" +
+	"\x05Score\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rassessment_id\x18\x02 \x01(\tR\fassessmentId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\tR\tstudentId\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x02R\x05score\x12\x18\n" +
+	"\aexcused\x18\x05 \x01(\bR\aexcused\x12\x1f\n" +
+	"\vrecorded_by\x18\x06 \x01(\tR\n" +
+	"recordedBy\x12\x1f\n" +
+	"\vrecorded_at\x18\a \x01(\tR\n" +
+	"recordedAt\"-\n" +
+	"\x06Scores\x12#\n" +
+	"\x06scores\x18\x01 \x03(\v2\v.main.ScoreR\x06scores\"g\n" +
+	"\x14StudentGradesRequest\x12;\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tstudentId\x12\x12\n" +
+	"\x04term\x18\x02 \x01(\tR\x04term\"\x9d\x01\n" +
+	"\x12ClassGradesRequest\x127\n" +
+	"\bclass_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\aclassId\x12:\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tsubjectId\x12\x12\n" +
+	"\x04term\x18\x03 \x01(\tR\x04term\"\xdb\x01\n" +
+	"\x05Grade\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x01 \x01(\tR\tstudentId\x12\x19\n" +
+	"\bclass_id\x18\x02 \x01(\tR\aclassId\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x03 \x01(\tR\tsubjectId\x12\x12\n" +
+	"\x04term\x18\x04 \x01(\tR\x04term\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x05 \x01(\x02R\n" +
+	"percentage\x12\x16\n" +
+	"\x06letter\x18\x06 \x01(\tR\x06letter\x12-\n" +
+	"\x12graded_assessments\x18\a \x01(\x05R\x11gradedAssessments\"-\n" +
+	"\x06Grades\x12#\n" +
+	"\x06grades\x18\x01 \x03(\v2\v.main.GradeR\x06grades\"C\n" +
+	"\fGradingScale\x123\n" +
+	"\n" +
+	"boundaries\x18\x01 \x03(\v2\x13.main.GradeBoundaryR\n" +
+	"boundaries\"N\n" +
+	"\rGradeBoundary\x12\x16\n" +
+	"\x06letter\x18\x01 \x01(\tR\x06letter\x12%\n" +
+	"\x0emin_percentage\x18\x02 \x01(\x02R\rminPercentage*U\n" +
+	"\x0eAssessmentType\x12\x1f\n" +
+	"\x1bASSESSMENT_TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04QUIZ\x10\x01\x12\b\n" +
+	"\x04EXAM\x10\x02\x12\x0e\n" +
+	"\n" +
+	"ASSIGNMENT\x10\x032\xae\x04\n" +
+	"\rGradesService\x12@\n" +
+	"\x0eGetAssessments\x12\x1b.main.GetAssessmentsRequest\x1a\x11.main.Assessments\x126\n" +
+	"\x0eAddAssessments\x12\x11.main.Assessments\x1a\x11.main.Assessments\x129\n" +
+	"\x11UpdateAssessments\x12\x11.main.Assessments\x1a\x11.main.Assessments\x12M\n" +
+	"\x11DeleteAssessments\x12\x13.main.AssessmentIds\x1a#.main.DeleteAssessmentsConfirmation\x127\n" +
+	"\fRecordScores\x12\x19.main.RecordScoresRequest\x1a\f.main.Scores\x12-\n" +
+	"\tGetScores\x12\x12.main.AssessmentId\x1a\f.main.Scores\x12<\n" +
+	"\x10GetStudentGrades\x12\x1a.main.StudentGradesRequest\x1a\f.main.Grades\x128\n" +
+	"\x0eGetClassGrades\x12\x18.main.ClassGradesRequest\x1a\f.main.Grades\x129\n" +
+	"\x0fGetGradingScale\x12\x12.main.EmptyRequest\x1a\x12.main.GradingScaleB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_grades_proto_rawDescOnce sync.Once
+	file_grades_proto_rawDescData []byte
+)
+
+func file_grades_proto_rawDescGZIP() []byte {
+	file_grades_proto_rawDescOnce.Do(func() {
+		file_grades_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_grades_proto_rawDesc), len(file_grades_proto_rawDesc)))
+	})
+	return file_grades_proto_rawDescData
+}
+
+var file_grades_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_grades_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_grades_proto_goTypes = []any{
+	(AssessmentType)(0),                   // 0: main.AssessmentType
+	(*Assessment)(nil),                    // 1: main.Assessment
+	(*Assessments)(nil),                   // 2: main.Assessments
+	(*GetAssessmentsRequest)(nil),         // 3: main.GetAssessmentsRequest
+	(*AssessmentIds)(nil),                 // 4: main.AssessmentIds
+	(*AssessmentId)(nil),                  // 5: main.AssessmentId
+	(*DeleteAssessmentsConfirmation)(nil), // 6: main.DeleteAssessmentsConfirmation
+	(*RecordScoresRequest)(nil),           // 7: main.RecordScoresRequest
+	(*ScoreEntry)(nil),                    // 8: main.ScoreEntry
+	(*Score)(nil),                         // 9: main.Score
+	(*Scores)(nil),                        // 10: main.Scores
+	(*StudentGradesRequest)(nil),          // 11: main.StudentGradesRequest
+	(*ClassGradesRequest)(nil),            // 12: main.ClassGradesRequest
+	(*Grade)(nil),                         // 13: main.Grade
+	(*Grades)(nil),                        // 14: main.Grades
+	(*GradingScale)(nil),                  // 15: main.GradingScale
+	(*GradeBoundary)(nil),                 // 16: main.GradeBoundary
+	(*EmptyRequest)(nil),                  // 17: main.EmptyRequest
+}
+var file_grades_proto_depIdxs = []int32{
+	0,  // 0: main.Assessment.type:type_name -> main.AssessmentType
+	1,  // 1: main.Assessments.assessments:type_name -> main.Assessment
+	8,  // 2: main.RecordScoresRequest.scores:type_name -> main.ScoreEntry
+	9,  // 3: main.Scores.scores:type_name -> main.Score
+	13, // 4: main.Grades.grades:type_name -> main.Grade
+	16, // 5: main.GradingScale.boundaries:type_name -> main.GradeBoundary
+	3,  // 6: main.GradesService.GetAssessments:input_type -> main.GetAssessmentsRequest
+	2,  // 7: main.GradesService.AddAssessments:input_type -> main.Assessments
+	2,  // 8: main.GradesService.UpdateAssessments:input_type -> main.Assessments
+	4,  // 9: main.GradesService.DeleteAssessments:input_type -> main.AssessmentIds
+	7,  // 10: main.GradesService.RecordScores:input_type -> main.RecordScoresRequest
+	5,  // 11: main.GradesService.GetScores:input_type -> main.AssessmentId
+	11, // 12: main.GradesService.GetStudentGrades:input_type -> main.StudentGradesRequest
+	12, // 13: main.GradesService.GetClassGrades:input_type -> main.ClassGradesRequest
+	17, // 14: main.GradesService.GetGradingScale:input_type -> main.EmptyRequest
+	2,  // 15: main.GradesService.GetAssessments:output_type -> main.Assessments
+	2,  // 16: main.GradesService.AddAssessments:output_type -> main.Assessments
+	2,  // 17: main.GradesService.UpdateAssessments:output_type -> main.Assessments
+	6,  // 18: main.GradesService.DeleteAssessments:output_type -> main.DeleteAssessmentsConfirmation
+	10, // 19: main.GradesService.RecordScores:output_type -> main.Scores
+	10, // 20: main.GradesService.GetScores:output_type -> main.Scores
+	14, // 21: main.GradesService.GetStudentGrades:output_type -> main.Grades
+	14, // 22: main.GradesService.GetClassGrades:output_type -> main.Grades
+	15, // 23: main.GradesService.GetGradingScale:output_type -> main.GradingScale
+	15, // [15:24] is the sub-list for method output_type
+	6,  // [6:15] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_grades_proto_init() }
+func file_grades_proto_init() {
+	if File_grades_proto != nil {
+		return
+	}
+	file_execs_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_grades_proto_rawDesc), len(file_grades_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_grades_proto_goTypes,
+		DependencyIndexes: file_grades_proto_depIdxs,
+		EnumInfos:         file_grades_proto_enumTypes,
+		MessageInfos:      file_grades_proto_msgTypes,
+	}.Build()
+	File_grades_proto = out.File
+	file_grades_proto_goTypes = nil
+	file_grades_proto_depIdxs = nil
+}
@@ -0,0 +1,2204 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: grades.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Assessment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Assessment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Assessment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AssessmentMultiError, or
+// nil if none found.
+func (m *Assessment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Assessment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetClassId() != "" {
+
+		if !_Assessment_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := AssessmentValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetSubjectId() != "" {
+
+		if !_Assessment_SubjectId_Pattern.MatchString(m.GetSubjectId()) {
+			err := AssessmentValidationError{
+				field:  "SubjectId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetTerm()) > 32 {
+		err := AssessmentValidationError{
+			field:  "Term",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Assessment_Term_Pattern.MatchString(m.GetTerm()) {
+		err := AssessmentValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) > 100 {
+		err := AssessmentValidationError{
+			field:  "Title",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AssessmentType_name[int32(m.GetType())]; !ok {
+		err := AssessmentValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxScore() < 0 {
+		err := AssessmentValidationError{
+			field:  "MaxScore",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWeight() < 0 {
+		err := AssessmentValidationError{
+			field:  "Weight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Assessment_Date_Pattern.MatchString(m.GetDate()) {
+		err := AssessmentValidationError{
+			field:  "Date",
+			reason: "value does not match regex pattern \"^([0-9]{4}-[0-9]{2}-[0-9]{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CreatedBy
+
+	if len(errors) > 0 {
+		return AssessmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssessmentMultiError is an error wrapping multiple validation errors
+// returned by Assessment.ValidateAll() if the designated constraints aren't met.
+type AssessmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssessmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssessmentMultiError) AllErrors() []error { return m }
+
+// AssessmentValidationError is the validation error returned by
+// Assessment.Validate if the designated constraints aren't met.
+type AssessmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssessmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssessmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssessmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssessmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssessmentValidationError) ErrorName() string { return "AssessmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssessmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssessment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssessmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssessmentValidationError{}
+
+var _Assessment_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Assessment_SubjectId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _Assessment_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+var _Assessment_Date_Pattern = regexp.MustCompile("^([0-9]{4}-[0-9]{2}-[0-9]{2})?$")
+
+// Validate checks the field values on Assessments with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Assessments) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Assessments with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AssessmentsMultiError, or
+// nil if none found.
+func (m *Assessments) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Assessments) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAssessments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AssessmentsValidationError{
+						field:  fmt.Sprintf("Assessments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AssessmentsValidationError{
+						field:  fmt.Sprintf("Assessments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AssessmentsValidationError{
+					field:  fmt.Sprintf("Assessments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AssessmentsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssessmentsMultiError is an error wrapping multiple validation errors
+// returned by Assessments.ValidateAll() if the designated constraints aren't met.
+type AssessmentsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssessmentsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssessmentsMultiError) AllErrors() []error { return m }
+
+// AssessmentsValidationError is the validation error returned by
+// Assessments.Validate if the designated constraints aren't met.
+type AssessmentsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssessmentsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssessmentsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssessmentsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssessmentsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssessmentsValidationError) ErrorName() string { return "AssessmentsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssessmentsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssessments.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssessmentsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssessmentsValidationError{}
+
+// Validate checks the field values on GetAssessmentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAssessmentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAssessmentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAssessmentsRequestMultiError, or nil if none found.
+func (m *GetAssessmentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAssessmentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClassId() != "" {
+
+		if !_GetAssessmentsRequest_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := GetAssessmentsRequestValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetSubjectId() != "" {
+
+		if !_GetAssessmentsRequest_SubjectId_Pattern.MatchString(m.GetSubjectId()) {
+			err := GetAssessmentsRequestValidationError{
+				field:  "SubjectId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Term
+
+	if len(errors) > 0 {
+		return GetAssessmentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAssessmentsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAssessmentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAssessmentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAssessmentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAssessmentsRequestMultiError) AllErrors() []error { return m }
+
+// GetAssessmentsRequestValidationError is the validation error returned by
+// GetAssessmentsRequest.Validate if the designated constraints aren't met.
+type GetAssessmentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAssessmentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAssessmentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAssessmentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAssessmentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAssessmentsRequestValidationError) ErrorName() string {
+	return "GetAssessmentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAssessmentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAssessmentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAssessmentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAssessmentsRequestValidationError{}
+
+var _GetAssessmentsRequest_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _GetAssessmentsRequest_SubjectId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on AssessmentIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AssessmentIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssessmentIds with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AssessmentIdsMultiError, or
+// nil if none found.
+func (m *AssessmentIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssessmentIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) < 1 {
+		err := AssessmentIdsValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) != 24 {
+			err := AssessmentIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value length must be 24 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_AssessmentIds_Ids_Pattern.MatchString(item) {
+			err := AssessmentIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AssessmentIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssessmentIdsMultiError is an error wrapping multiple validation errors
+// returned by AssessmentIds.ValidateAll() if the designated constraints
+// aren't met.
+type AssessmentIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssessmentIdsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssessmentIdsMultiError) AllErrors() []error { return m }
+
+// AssessmentIdsValidationError is the validation error returned by
+// AssessmentIds.Validate if the designated constraints aren't met.
+type AssessmentIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssessmentIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssessmentIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssessmentIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssessmentIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssessmentIdsValidationError) ErrorName() string { return "AssessmentIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssessmentIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssessmentIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssessmentIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssessmentIdsValidationError{}
+
+var _AssessmentIds_Ids_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on AssessmentId with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AssessmentId) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssessmentId with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AssessmentIdMultiError, or
+// nil if none found.
+func (m *AssessmentId) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssessmentId) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := AssessmentIdValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_AssessmentId_Id_Pattern.MatchString(m.GetId()) {
+		err := AssessmentIdValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AssessmentIdMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssessmentIdMultiError is an error wrapping multiple validation errors
+// returned by AssessmentId.ValidateAll() if the designated constraints aren't met.
+type AssessmentIdMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssessmentIdMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssessmentIdMultiError) AllErrors() []error { return m }
+
+// AssessmentIdValidationError is the validation error returned by
+// AssessmentId.Validate if the designated constraints aren't met.
+type AssessmentIdValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssessmentIdValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssessmentIdValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssessmentIdValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssessmentIdValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssessmentIdValidationError) ErrorName() string { return "AssessmentIdValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssessmentIdValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssessmentId.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssessmentIdValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssessmentIdValidationError{}
+
+var _AssessmentId_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on DeleteAssessmentsConfirmation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAssessmentsConfirmation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAssessmentsConfirmation with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteAssessmentsConfirmationMultiError, or nil if none found.
+func (m *DeleteAssessmentsConfirmation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAssessmentsConfirmation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteAssessmentsConfirmationMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAssessmentsConfirmationMultiError is an error wrapping multiple
+// validation errors returned by DeleteAssessmentsConfirmation.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteAssessmentsConfirmationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAssessmentsConfirmationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAssessmentsConfirmationMultiError) AllErrors() []error { return m }
+
+// DeleteAssessmentsConfirmationValidationError is the validation error
+// returned by DeleteAssessmentsConfirmation.Validate if the designated
+// constraints aren't met.
+type DeleteAssessmentsConfirmationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAssessmentsConfirmationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAssessmentsConfirmationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAssessmentsConfirmationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAssessmentsConfirmationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAssessmentsConfirmationValidationError) ErrorName() string {
+	return "DeleteAssessmentsConfirmationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAssessmentsConfirmationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAssessmentsConfirmation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAssessmentsConfirmationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAssessmentsConfirmationValidationError{}
+
+// Validate checks the field values on RecordScoresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordScoresRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordScoresRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordScoresRequestMultiError, or nil if none found.
+func (m *RecordScoresRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordScoresRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAssessmentId()) != 24 {
+		err := RecordScoresRequestValidationError{
+			field:  "AssessmentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_RecordScoresRequest_AssessmentId_Pattern.MatchString(m.GetAssessmentId()) {
+		err := RecordScoresRequestValidationError{
+			field:  "AssessmentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetScores()) < 1 {
+		err := RecordScoresRequestValidationError{
+			field:  "Scores",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetScores() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RecordScoresRequestValidationError{
+						field:  fmt.Sprintf("Scores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RecordScoresRequestValidationError{
+						field:  fmt.Sprintf("Scores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RecordScoresRequestValidationError{
+					field:  fmt.Sprintf("Scores[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RecordScoresRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordScoresRequestMultiError is an error wrapping multiple validation
+// errors returned by RecordScoresRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecordScoresRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordScoresRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordScoresRequestMultiError) AllErrors() []error { return m }
+
+// RecordScoresRequestValidationError is the validation error returned by
+// RecordScoresRequest.Validate if the designated constraints aren't met.
+type RecordScoresRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordScoresRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordScoresRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordScoresRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordScoresRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordScoresRequestValidationError) ErrorName() string {
+	return "RecordScoresRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordScoresRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordScoresRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordScoresRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordScoresRequestValidationError{}
+
+var _RecordScoresRequest_AssessmentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on ScoreEntry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ScoreEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScoreEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScoreEntryMultiError, or
+// nil if none found.
+func (m *ScoreEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScoreEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := ScoreEntryValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ScoreEntry_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := ScoreEntryValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetScore() < 0 {
+		err := ScoreEntryValidationError{
+			field:  "Score",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Excused
+
+	if len(errors) > 0 {
+		return ScoreEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScoreEntryMultiError is an error wrapping multiple validation errors
+// returned by ScoreEntry.ValidateAll() if the designated constraints aren't met.
+type ScoreEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScoreEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScoreEntryMultiError) AllErrors() []error { return m }
+
+// ScoreEntryValidationError is the validation error returned by
+// ScoreEntry.Validate if the designated constraints aren't met.
+type ScoreEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScoreEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScoreEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScoreEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScoreEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScoreEntryValidationError) ErrorName() string { return "ScoreEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScoreEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScoreEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScoreEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScoreEntryValidationError{}
+
+var _ScoreEntry_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on Score with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Score) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Score with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ScoreMultiError, or nil if none found.
+func (m *Score) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Score) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AssessmentId
+
+	// no validation rules for StudentId
+
+	// no validation rules for Score
+
+	// no validation rules for Excused
+
+	// no validation rules for RecordedBy
+
+	// no validation rules for RecordedAt
+
+	if len(errors) > 0 {
+		return ScoreMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScoreMultiError is an error wrapping multiple validation errors returned by
+// Score.ValidateAll() if the designated constraints aren't met.
+type ScoreMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScoreMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScoreMultiError) AllErrors() []error { return m }
+
+// ScoreValidationError is the validation error returned by Score.Validate if
+// the designated constraints aren't met.
+type ScoreValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScoreValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScoreValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScoreValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScoreValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScoreValidationError) ErrorName() string { return "ScoreValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScoreValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScore.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScoreValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScoreValidationError{}
+
+// Validate checks the field values on Scores with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Scores) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Scores with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ScoresMultiError, or nil if none found.
+func (m *Scores) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Scores) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetScores() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ScoresValidationError{
+						field:  fmt.Sprintf("Scores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ScoresValidationError{
+						field:  fmt.Sprintf("Scores[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScoresValidationError{
+					field:  fmt.Sprintf("Scores[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ScoresMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScoresMultiError is an error wrapping multiple validation errors returned by
+// Scores.ValidateAll() if the designated constraints aren't met.
+type ScoresMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScoresMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScoresMultiError) AllErrors() []error { return m }
+
+// ScoresValidationError is the validation error returned by Scores.Validate if
+// the designated constraints aren't met.
+type ScoresValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScoresValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScoresValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScoresValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScoresValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScoresValidationError) ErrorName() string { return "ScoresValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScoresValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScores.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScoresValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScoresValidationError{}
+
+// Validate checks the field values on StudentGradesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StudentGradesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StudentGradesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StudentGradesRequestMultiError, or nil if none found.
+func (m *StudentGradesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StudentGradesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetStudentId()) != 24 {
+		err := StudentGradesRequestValidationError{
+			field:  "StudentId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_StudentGradesRequest_StudentId_Pattern.MatchString(m.GetStudentId()) {
+		err := StudentGradesRequestValidationError{
+			field:  "StudentId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Term
+
+	if len(errors) > 0 {
+		return StudentGradesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudentGradesRequestMultiError is an error wrapping multiple validation
+// errors returned by StudentGradesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StudentGradesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudentGradesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudentGradesRequestMultiError) AllErrors() []error { return m }
+
+// StudentGradesRequestValidationError is the validation error returned by
+// StudentGradesRequest.Validate if the designated constraints aren't met.
+type StudentGradesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudentGradesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudentGradesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudentGradesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudentGradesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudentGradesRequestValidationError) ErrorName() string {
+	return "StudentGradesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StudentGradesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudentGradesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudentGradesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudentGradesRequestValidationError{}
+
+var _StudentGradesRequest_StudentId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on ClassGradesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClassGradesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClassGradesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClassGradesRequestMultiError, or nil if none found.
+func (m *ClassGradesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClassGradesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClassId()) != 24 {
+		err := ClassGradesRequestValidationError{
+			field:  "ClassId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ClassGradesRequest_ClassId_Pattern.MatchString(m.GetClassId()) {
+		err := ClassGradesRequestValidationError{
+			field:  "ClassId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSubjectId() != "" {
+
+		if !_ClassGradesRequest_SubjectId_Pattern.MatchString(m.GetSubjectId()) {
+			err := ClassGradesRequestValidationError{
+				field:  "SubjectId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Term
+
+	if len(errors) > 0 {
+		return ClassGradesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassGradesRequestMultiError is an error wrapping multiple validation errors
+// returned by ClassGradesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClassGradesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassGradesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassGradesRequestMultiError) AllErrors() []error { return m }
+
+// ClassGradesRequestValidationError is the validation error returned by
+// ClassGradesRequest.Validate if the designated constraints aren't met.
+type ClassGradesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassGradesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassGradesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassGradesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassGradesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassGradesRequestValidationError) ErrorName() string {
+	return "ClassGradesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClassGradesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClassGradesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassGradesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassGradesRequestValidationError{}
+
+var _ClassGradesRequest_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _ClassGradesRequest_SubjectId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on Grade with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Grade) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Grade with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GradeMultiError, or nil if none found.
+func (m *Grade) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Grade) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StudentId
+
+	// no validation rules for ClassId
+
+	// no validation rules for SubjectId
+
+	// no validation rules for Term
+
+	// no validation rules for Percentage
+
+	// no validation rules for Letter
+
+	// no validation rules for GradedAssessments
+
+	if len(errors) > 0 {
+		return GradeMultiError(errors)
+	}
+
+	return nil
+}
+
+// GradeMultiError is an error wrapping multiple validation errors returned by
+// Grade.ValidateAll() if the designated constraints aren't met.
+type GradeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GradeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GradeMultiError) AllErrors() []error { return m }
+
+// GradeValidationError is the validation error returned by Grade.Validate if
+// the designated constraints aren't met.
+type GradeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GradeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GradeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GradeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GradeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GradeValidationError) ErrorName() string { return "GradeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GradeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrade.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GradeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GradeValidationError{}
+
+// Validate checks the field values on Grades with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Grades) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Grades with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in GradesMultiError, or nil if none found.
+func (m *Grades) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Grades) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGrades() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GradesValidationError{
+						field:  fmt.Sprintf("Grades[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GradesValidationError{
+						field:  fmt.Sprintf("Grades[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GradesValidationError{
+					field:  fmt.Sprintf("Grades[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GradesMultiError(errors)
+	}
+
+	return nil
+}
+
+// GradesMultiError is an error wrapping multiple validation errors returned by
+// Grades.ValidateAll() if the designated constraints aren't met.
+type GradesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GradesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GradesMultiError) AllErrors() []error { return m }
+
+// GradesValidationError is the validation error returned by Grades.Validate if
+// the designated constraints aren't met.
+type GradesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GradesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GradesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GradesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GradesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GradesValidationError) ErrorName() string { return "GradesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GradesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGrades.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GradesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GradesValidationError{}
+
+// Validate checks the field values on GradingScale with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GradingScale) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GradingScale with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GradingScaleMultiError, or
+// nil if none found.
+func (m *GradingScale) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GradingScale) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBoundaries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GradingScaleValidationError{
+						field:  fmt.Sprintf("Boundaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GradingScaleValidationError{
+						field:  fmt.Sprintf("Boundaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GradingScaleValidationError{
+					field:  fmt.Sprintf("Boundaries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GradingScaleMultiError(errors)
+	}
+
+	return nil
+}
+
+// GradingScaleMultiError is an error wrapping multiple validation errors
+// returned by GradingScale.ValidateAll() if the designated constraints aren't met.
+type GradingScaleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GradingScaleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GradingScaleMultiError) AllErrors() []error { return m }
+
+// GradingScaleValidationError is the validation error returned by
+// GradingScale.Validate if the designated constraints aren't met.
+type GradingScaleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GradingScaleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GradingScaleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GradingScaleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GradingScaleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GradingScaleValidationError) ErrorName() string { return "GradingScaleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GradingScaleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGradingScale.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GradingScaleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GradingScaleValidationError{}
+
+// Validate checks the field values on GradeBoundary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GradeBoundary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GradeBoundary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GradeBoundaryMultiError, or
+// nil if none found.
+func (m *GradeBoundary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GradeBoundary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Letter
+
+	// no validation rules for MinPercentage
+
+	if len(errors) > 0 {
+		return GradeBoundaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// GradeBoundaryMultiError is an error wrapping multiple validation errors
+// returned by GradeBoundary.ValidateAll() if the designated constraints
+// aren't met.
+type GradeBoundaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GradeBoundaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GradeBoundaryMultiError) AllErrors() []error { return m }
+
+// GradeBoundaryValidationError is the validation error returned by
+// GradeBoundary.Validate if the designated constraints aren't met.
+type GradeBoundaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GradeBoundaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GradeBoundaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GradeBoundaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GradeBoundaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GradeBoundaryValidationError) ErrorName() string { return "GradeBoundaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GradeBoundaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGradeBoundary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GradeBoundaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GradeBoundaryValidationError{}
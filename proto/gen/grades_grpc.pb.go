@@ -0,0 +1,449 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: grades.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GradesService_GetAssessments_FullMethodName    = "/main.GradesService/GetAssessments"
+	GradesService_AddAssessments_FullMethodName    = "/main.GradesService/AddAssessments"
+	GradesService_UpdateAssessments_FullMethodName = "/main.GradesService/UpdateAssessments"
+	GradesService_DeleteAssessments_FullMethodName = "/main.GradesService/DeleteAssessments"
+	GradesService_RecordScores_FullMethodName      = "/main.GradesService/RecordScores"
+	GradesService_GetScores_FullMethodName         = "/main.GradesService/GetScores"
+	GradesService_GetStudentGrades_FullMethodName  = "/main.GradesService/GetStudentGrades"
+	GradesService_GetClassGrades_FullMethodName    = "/main.GradesService/GetClassGrades"
+	GradesService_GetGradingScale_FullMethodName   = "/main.GradesService/GetGradingScale"
+)
+
+// GradesServiceClient is the client API for GradesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GradesService is the gradebook. Teachers define assessments for a class and
+// subject and record scores against them; weighted averages and letter
+// grades are computed when read. Admins and managers can work with every
+// class. Other accounts can only change assessments and scores for a class
+// and subject their linked teacher has a teaching assignment for, and can
+// only read grades of classes that teacher teaches.
+type GradesServiceClient interface {
+	GetAssessments(ctx context.Context, in *GetAssessmentsRequest, opts ...grpc.CallOption) (*Assessments, error)
+	AddAssessments(ctx context.Context, in *Assessments, opts ...grpc.CallOption) (*Assessments, error)
+	// UpdateAssessments can't move an assessment to another class or
+	// subject, or lower its max score below a recorded score.
+	UpdateAssessments(ctx context.Context, in *Assessments, opts ...grpc.CallOption) (*Assessments, error)
+	// DeleteAssessments also deletes the assessments' scores.
+	DeleteAssessments(ctx context.Context, in *AssessmentIds, opts ...grpc.CallOption) (*DeleteAssessmentsConfirmation, error)
+	// RecordScores records or replaces the scores of several students for
+	// one assessment in one call.
+	RecordScores(ctx context.Context, in *RecordScoresRequest, opts ...grpc.CallOption) (*Scores, error)
+	GetScores(ctx context.Context, in *AssessmentId, opts ...grpc.CallOption) (*Scores, error)
+	GetStudentGrades(ctx context.Context, in *StudentGradesRequest, opts ...grpc.CallOption) (*Grades, error)
+	GetClassGrades(ctx context.Context, in *ClassGradesRequest, opts ...grpc.CallOption) (*Grades, error)
+	GetGradingScale(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GradingScale, error)
+}
+
+type gradesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGradesServiceClient(cc grpc.ClientConnInterface) GradesServiceClient {
+	return &gradesServiceClient{cc}
+}
+
+func (c *gradesServiceClient) GetAssessments(ctx context.Context, in *GetAssessmentsRequest, opts ...grpc.CallOption) (*Assessments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assessments)
+	err := c.cc.Invoke(ctx, GradesService_GetAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) AddAssessments(ctx context.Context, in *Assessments, opts ...grpc.CallOption) (*Assessments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assessments)
+	err := c.cc.Invoke(ctx, GradesService_AddAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) UpdateAssessments(ctx context.Context, in *Assessments, opts ...grpc.CallOption) (*Assessments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assessments)
+	err := c.cc.Invoke(ctx, GradesService_UpdateAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) DeleteAssessments(ctx context.Context, in *AssessmentIds, opts ...grpc.CallOption) (*DeleteAssessmentsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAssessmentsConfirmation)
+	err := c.cc.Invoke(ctx, GradesService_DeleteAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) RecordScores(ctx context.Context, in *RecordScoresRequest, opts ...grpc.CallOption) (*Scores, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scores)
+	err := c.cc.Invoke(ctx, GradesService_RecordScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetScores(ctx context.Context, in *AssessmentId, opts ...grpc.CallOption) (*Scores, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scores)
+	err := c.cc.Invoke(ctx, GradesService_GetScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetStudentGrades(ctx context.Context, in *StudentGradesRequest, opts ...grpc.CallOption) (*Grades, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Grades)
+	err := c.cc.Invoke(ctx, GradesService_GetStudentGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetClassGrades(ctx context.Context, in *ClassGradesRequest, opts ...grpc.CallOption) (*Grades, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Grades)
+	err := c.cc.Invoke(ctx, GradesService_GetClassGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradesServiceClient) GetGradingScale(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GradingScale, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradingScale)
+	err := c.cc.Invoke(ctx, GradesService_GetGradingScale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GradesServiceServer is the server API for GradesService service.
+// All implementations must embed UnimplementedGradesServiceServer
+// for forward compatibility.
+//
+// GradesService is the gradebook. Teachers define assessments for a class and
+// subject and record scores against them; weighted averages and letter
+// grades are computed when read. Admins and managers can work with every
+// class. Other accounts can only change assessments and scores for a class
+// and subject their linked teacher has a teaching assignment for, and can
+// only read grades of classes that teacher teaches.
+type GradesServiceServer interface {
+	GetAssessments(context.Context, *GetAssessmentsRequest) (*Assessments, error)
+	AddAssessments(context.Context, *Assessments) (*Assessments, error)
+	// UpdateAssessments can't move an assessment to another class or
+	// subject, or lower its max score below a recorded score.
+	UpdateAssessments(context.Context, *Assessments) (*Assessments, error)
+	// DeleteAssessments also deletes the assessments' scores.
+	DeleteAssessments(context.Context, *AssessmentIds) (*DeleteAssessmentsConfirmation, error)
+	// RecordScores records or replaces the scores of several students for
+	// one assessment in one call.
+	RecordScores(context.Context, *RecordScoresRequest) (*Scores, error)
+	GetScores(context.Context, *AssessmentId) (*Scores, error)
+	GetStudentGrades(context.Context, *StudentGradesRequest) (*Grades, error)
+	GetClassGrades(context.Context, *ClassGradesRequest) (*Grades, error)
+	GetGradingScale(context.Context, *EmptyRequest) (*GradingScale, error)
+	mustEmbedUnimplementedGradesServiceServer()
+}
+
+// UnimplementedGradesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGradesServiceServer struct{}
+
+func (UnimplementedGradesServiceServer) GetAssessments(context.Context, *GetAssessmentsRequest) (*Assessments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) AddAssessments(context.Context, *Assessments) (*Assessments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) UpdateAssessments(context.Context, *Assessments) (*Assessments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) DeleteAssessments(context.Context, *AssessmentIds) (*DeleteAssessmentsConfirmation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssessments not implemented")
+}
+func (UnimplementedGradesServiceServer) RecordScores(context.Context, *RecordScoresRequest) (*Scores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordScores not implemented")
+}
+func (UnimplementedGradesServiceServer) GetScores(context.Context, *AssessmentId) (*Scores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScores not implemented")
+}
+func (UnimplementedGradesServiceServer) GetStudentGrades(context.Context, *StudentGradesRequest) (*Grades, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) GetClassGrades(context.Context, *ClassGradesRequest) (*Grades, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassGrades not implemented")
+}
+func (UnimplementedGradesServiceServer) GetGradingScale(context.Context, *EmptyRequest) (*GradingScale, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradingScale not implemented")
+}
+func (UnimplementedGradesServiceServer) mustEmbedUnimplementedGradesServiceServer() {}
+func (UnimplementedGradesServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeGradesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradesServiceServer will
+// result in compilation errors.
+type UnsafeGradesServiceServer interface {
+	mustEmbedUnimplementedGradesServiceServer()
+}
+
+func RegisterGradesServiceServer(s grpc.ServiceRegistrar, srv GradesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGradesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GradesService_ServiceDesc, srv)
+}
+
+func _GradesService_GetAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetAssessments(ctx, req.(*GetAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_AddAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Assessments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).AddAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_AddAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).AddAssessments(ctx, req.(*Assessments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_UpdateAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Assessments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).UpdateAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_UpdateAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).UpdateAssessments(ctx, req.(*Assessments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_DeleteAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssessmentIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).DeleteAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_DeleteAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).DeleteAssessments(ctx, req.(*AssessmentIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_RecordScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).RecordScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_RecordScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).RecordScores(ctx, req.(*RecordScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssessmentId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetScores(ctx, req.(*AssessmentId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetStudentGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetStudentGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetStudentGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetStudentGrades(ctx, req.(*StudentGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetClassGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetClassGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetClassGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetClassGrades(ctx, req.(*ClassGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradesService_GetGradingScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradesServiceServer).GetGradingScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradesService_GetGradingScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradesServiceServer).GetGradingScale(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GradesService_ServiceDesc is the grpc.ServiceDesc for GradesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GradesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.GradesService",
+	HandlerType: (*GradesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAssessments",
+			Handler:    _GradesService_GetAssessments_Handler,
+		},
+		{
+			MethodName: "AddAssessments",
+			Handler:    _GradesService_AddAssessments_Handler,
+		},
+		{
+			MethodName: "UpdateAssessments",
+			Handler:    _GradesService_UpdateAssessments_Handler,
+		},
+		{
+			MethodName: "DeleteAssessments",
+			Handler:    _GradesService_DeleteAssessments_Handler,
+		},
+		{
+			MethodName: "RecordScores",
+			Handler:    _GradesService_RecordScores_Handler,
+		},
+		{
+			MethodName: "GetScores",
+			Handler:    _GradesService_GetScores_Handler,
+		},
+		{
+			MethodName: "GetStudentGrades",
+			Handler:    _GradesService_GetStudentGrades_Handler,
+		},
+		{
+			MethodName: "GetClassGrades",
+			Handler:    _GradesService_GetClassGrades_Handler,
+		},
+		{
+			MethodName: "GetGradingScale",
+			Handler:    _GradesService_GetGradingScale_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "grades.proto",
+}
@@ -22,6 +22,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ClassTeacherRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// subject_id, if set, limits the result to the classes the teacher
+	// teaches that subject in
+	SubjectId     string `protobuf:"bytes,2,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassTeacherRequest) Reset() {
+	*x = ClassTeacherRequest{}
+	mi := &file_main_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassTeacherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassTeacherRequest) ProtoMessage() {}
+
+func (x *ClassTeacherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassTeacherRequest.ProtoReflect.Descriptor instead.
+func (*ClassTeacherRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClassTeacherRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClassTeacherRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
 type StudentCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -32,7 +86,7 @@ type StudentCount struct {
 
 func (x *StudentCount) Reset() {
 	*x = StudentCount{}
-	mi := &file_main_proto_msgTypes[0]
+	mi := &file_main_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +98,7 @@ func (x *StudentCount) String() string {
 func (*StudentCount) ProtoMessage() {}
 
 func (x *StudentCount) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[0]
+	mi := &file_main_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +111,7 @@ func (x *StudentCount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudentCount.ProtoReflect.Descriptor instead.
 func (*StudentCount) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{0}
+	return file_main_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *StudentCount) GetStatus() bool {
@@ -84,7 +138,7 @@ type DeleteTeachersConfirmation struct {
 
 func (x *DeleteTeachersConfirmation) Reset() {
 	*x = DeleteTeachersConfirmation{}
-	mi := &file_main_proto_msgTypes[1]
+	mi := &file_main_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -96,7 +150,7 @@ func (x *DeleteTeachersConfirmation) String() string {
 func (*DeleteTeachersConfirmation) ProtoMessage() {}
 
 func (x *DeleteTeachersConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[1]
+	mi := &file_main_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -109,7 +163,7 @@ func (x *DeleteTeachersConfirmation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTeachersConfirmation.ProtoReflect.Descriptor instead.
 func (*DeleteTeachersConfirmation) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{1}
+	return file_main_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *DeleteTeachersConfirmation) GetStatus() string {
@@ -135,7 +189,7 @@ type TeacherId struct {
 
 func (x *TeacherId) Reset() {
 	*x = TeacherId{}
-	mi := &file_main_proto_msgTypes[2]
+	mi := &file_main_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -147,7 +201,7 @@ func (x *TeacherId) String() string {
 func (*TeacherId) ProtoMessage() {}
 
 func (x *TeacherId) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[2]
+	mi := &file_main_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -160,7 +214,7 @@ func (x *TeacherId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeacherId.ProtoReflect.Descriptor instead.
 func (*TeacherId) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{2}
+	return file_main_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TeacherId) GetId() string {
@@ -179,7 +233,7 @@ type TeacherIds struct {
 
 func (x *TeacherIds) Reset() {
 	*x = TeacherIds{}
-	mi := &file_main_proto_msgTypes[3]
+	mi := &file_main_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -191,7 +245,7 @@ func (x *TeacherIds) String() string {
 func (*TeacherIds) ProtoMessage() {}
 
 func (x *TeacherIds) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[3]
+	mi := &file_main_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +258,7 @@ func (x *TeacherIds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeacherIds.ProtoReflect.Descriptor instead.
 func (*TeacherIds) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{3}
+	return file_main_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TeacherIds) GetIds() []*TeacherId {
@@ -224,7 +278,7 @@ type GetTeachersRequest struct {
 
 func (x *GetTeachersRequest) Reset() {
 	*x = GetTeachersRequest{}
-	mi := &file_main_proto_msgTypes[4]
+	mi := &file_main_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -236,7 +290,7 @@ func (x *GetTeachersRequest) String() string {
 func (*GetTeachersRequest) ProtoMessage() {}
 
 func (x *GetTeachersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[4]
+	mi := &file_main_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,7 +303,7 @@ func (x *GetTeachersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeachersRequest.ProtoReflect.Descriptor instead.
 func (*GetTeachersRequest) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{4}
+	return file_main_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetTeachersRequest) GetTeacher() *Teacher {
@@ -275,22 +329,26 @@ type Teacher struct {
 	LastName string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
 	// email must be a valid email address
 	// string email = 4;
-	//
-	//	string email = 4 [(validate.rules).string = {
-	//	    email: true
-	//	}];
+	// string email = 4 [(validate.rules).string = {
+	//     email: true
+	// }];
 	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	// class must not contain special characters but can contain spaces
+	// class is the legacy free-text class name, kept for reference; use
+	// class_id. It must not contain special characters but can contain spaces
 	Class string `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
-	// subject must not contain special characters but can contain spaces
-	Subject       string `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	// subject is the legacy free-text subject, kept for reference; subjects
+	// a teacher may teach are set with SetTeacherQualifications. It must not
+	// contain special characters but can contain spaces
+	Subject string `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	// class_id is the ID of the class the teacher is class teacher of
+	ClassId       string `protobuf:"bytes,7,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Teacher) Reset() {
 	*x = Teacher{}
-	mi := &file_main_proto_msgTypes[5]
+	mi := &file_main_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -302,7 +360,7 @@ func (x *Teacher) String() string {
 func (*Teacher) ProtoMessage() {}
 
 func (x *Teacher) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[5]
+	mi := &file_main_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +373,7 @@ func (x *Teacher) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Teacher.ProtoReflect.Descriptor instead.
 func (*Teacher) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{5}
+	return file_main_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Teacher) GetId() string {
@@ -360,6 +418,13 @@ func (x *Teacher) GetSubject() string {
 	return ""
 }
 
+func (x *Teacher) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
 type Teachers struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Teachers      []*Teacher             `protobuf:"bytes,1,rep,name=teachers,proto3" json:"teachers,omitempty"`
@@ -369,7 +434,7 @@ type Teachers struct {
 
 func (x *Teachers) Reset() {
 	*x = Teachers{}
-	mi := &file_main_proto_msgTypes[6]
+	mi := &file_main_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -381,7 +446,7 @@ func (x *Teachers) String() string {
 func (*Teachers) ProtoMessage() {}
 
 func (x *Teachers) ProtoReflect() protoreflect.Message {
-	mi := &file_main_proto_msgTypes[6]
+	mi := &file_main_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,7 +459,7 @@ func (x *Teachers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Teachers.ProtoReflect.Descriptor instead.
 func (*Teachers) Descriptor() ([]byte, []int) {
-	return file_main_proto_rawDescGZIP(), []int{6}
+	return file_main_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Teachers) GetTeachers() []*Teacher {
@@ -404,12 +469,289 @@ func (x *Teachers) GetTeachers() []*Teacher {
 	return nil
 }
 
+// TeachingAssignment records that a teacher teaches a subject to a class in
+// a term. A teacher can hold any number of them.
+type TeachingAssignment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherId string                 `protobuf:"bytes,2,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	ClassId   string                 `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// subject_id must be a subject the teacher is qualified for
+	SubjectId string `protobuf:"bytes,4,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	// term names the teaching period, e.g. "2025-2026" or "2025-2026 T1"
+	Term          string `protobuf:"bytes,5,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeachingAssignment) Reset() {
+	*x = TeachingAssignment{}
+	mi := &file_main_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeachingAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeachingAssignment) ProtoMessage() {}
+
+func (x *TeachingAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeachingAssignment.ProtoReflect.Descriptor instead.
+func (*TeachingAssignment) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TeachingAssignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TeachingAssignment) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *TeachingAssignment) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *TeachingAssignment) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *TeachingAssignment) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+type TeachingAssignments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*TeachingAssignment  `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeachingAssignments) Reset() {
+	*x = TeachingAssignments{}
+	mi := &file_main_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeachingAssignments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeachingAssignments) ProtoMessage() {}
+
+func (x *TeachingAssignments) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeachingAssignments.ProtoReflect.Descriptor instead.
+func (*TeachingAssignments) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TeachingAssignments) GetAssignments() []*TeachingAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type TeachingAssignmentId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeachingAssignmentId) Reset() {
+	*x = TeachingAssignmentId{}
+	mi := &file_main_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeachingAssignmentId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeachingAssignmentId) ProtoMessage() {}
+
+func (x *TeachingAssignmentId) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeachingAssignmentId.ProtoReflect.Descriptor instead.
+func (*TeachingAssignmentId) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TeachingAssignmentId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UnassignTeacherConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedId     string                 `protobuf:"bytes,2,opt,name=deleted_id,json=deletedId,proto3" json:"deleted_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignTeacherConfirmation) Reset() {
+	*x = UnassignTeacherConfirmation{}
+	mi := &file_main_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignTeacherConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignTeacherConfirmation) ProtoMessage() {}
+
+func (x *UnassignTeacherConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignTeacherConfirmation.ProtoReflect.Descriptor instead.
+func (*UnassignTeacherConfirmation) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UnassignTeacherConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UnassignTeacherConfirmation) GetDeletedId() string {
+	if x != nil {
+		return x.DeletedId
+	}
+	return ""
+}
+
+// ListAssignmentsRequest filters on every field set in assignment.
+type ListAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignment    *TeachingAssignment    `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	SortBy        []*SortField           `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentsRequest) Reset() {
+	*x = ListAssignmentsRequest{}
+	mi := &file_main_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentsRequest) ProtoMessage() {}
+
+func (x *ListAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_main_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_main_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListAssignmentsRequest) GetAssignment() *TeachingAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+func (x *ListAssignmentsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
 var File_main_proto protoreflect.FileDescriptor
 
 const file_main_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"main.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\x0estudents.proto\"K\n" +
+	"main.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\x0estudents.proto\"\x7f\n" +
+	"\x13ClassTeacherRequest\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\x12:\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tsubjectId\"K\n" +
 	"\fStudentCount\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12#\n" +
 	"\rstudent_count\x18\x02 \x01(\x05R\fstudentCount\"U\n" +
@@ -424,7 +766,7 @@ const file_main_proto_rawDesc = "" +
 	"\x03ids\x18\x01 \x03(\v2\x0f.main.TeacherIdB\b\xfaB\x05\x92\x01\x02\b\x01R\x03ids\"g\n" +
 	"\x12GetTeachersRequest\x12'\n" +
 	"\ateacher\x18\x01 \x01(\v2\r.main.TeacherR\ateacher\x12(\n" +
-	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\"\x81\x02\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\"\xb9\x02\n" +
 	"\aTeacher\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
 	"\n" +
@@ -433,16 +775,41 @@ const file_main_proto_rawDesc = "" +
 	"\x05email\x18\x04 \x01(\tB\n" +
 	"\xfaB\ar\x05\xd0\x01\x01`\x01R\x05email\x12,\n" +
 	"\x05class\x18\x05 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\x05class\x120\n" +
-	"\asubject\x18\x06 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\asubject\"5\n" +
+	"\asubject\x18\x06 \x01(\tB\x16\xfaB\x13r\x112\x0f^[A-Za-z0-9 ]*$R\asubject\x126\n" +
+	"\bclass_id\x18\a \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\"5\n" +
 	"\bTeachers\x12)\n" +
-	"\bteachers\x18\x01 \x03(\v2\r.main.TeacherR\bteachers2\xf5\x02\n" +
+	"\bteachers\x18\x01 \x03(\v2\r.main.TeacherR\bteachers\"\x83\x02\n" +
+	"\x12TeachingAssignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12:\n" +
+	"\n" +
+	"teacher_id\x18\x02 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tteacherId\x126\n" +
+	"\bclass_id\x18\x03 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\x12:\n" +
+	"\n" +
+	"subject_id\x18\x04 \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\tsubjectId\x12-\n" +
+	"\x04term\x18\x05 \x01(\tB\x19\xfaB\x16r\x14\x18 2\x10^[A-Za-z0-9 -]*$R\x04term\"Q\n" +
+	"\x13TeachingAssignments\x12:\n" +
+	"\vassignments\x18\x01 \x03(\v2\x18.main.TeachingAssignmentR\vassignments\"D\n" +
+	"\x14TeachingAssignmentId\x12,\n" +
+	"\x02id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\"T\n" +
+	"\x1bUnassignTeacherConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"deleted_id\x18\x02 \x01(\tR\tdeletedId\"|\n" +
+	"\x16ListAssignmentsRequest\x128\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\x18.main.TeachingAssignmentR\n" +
+	"assignment\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy2\xec\x04\n" +
 	"\x0fTeachersService\x127\n" +
 	"\vGetTeachers\x12\x18.main.GetTeachersRequest\x1a\x0e.main.Teachers\x12-\n" +
 	"\vAddTeachers\x12\x0e.main.Teachers\x1a\x0e.main.Teachers\x120\n" +
 	"\x0eUpdateTeachers\x12\x0e.main.Teachers\x1a\x0e.main.Teachers\x12D\n" +
-	"\x0eDeleteTeachers\x12\x10.main.TeacherIds\x1a .main.DeleteTeachersConfirmation\x12<\n" +
-	"\x19GetStudentsByClassTeacher\x12\x0f.main.TeacherId\x1a\x0e.main.Students\x12D\n" +
-	"\x1dGetStudentCountByClassTeacher\x12\x0f.main.TeacherId\x1a\x12.main.StudentCountB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+	"\x0eDeleteTeachers\x12\x10.main.TeacherIds\x1a .main.DeleteTeachersConfirmation\x12F\n" +
+	"\x19GetStudentsByClassTeacher\x12\x19.main.ClassTeacherRequest\x1a\x0e.main.Students\x12N\n" +
+	"\x1dGetStudentCountByClassTeacher\x12\x19.main.ClassTeacherRequest\x1a\x12.main.StudentCount\x12C\n" +
+	"\rAssignTeacher\x12\x18.main.TeachingAssignment\x1a\x18.main.TeachingAssignment\x12P\n" +
+	"\x0fUnassignTeacher\x12\x1a.main.TeachingAssignmentId\x1a!.main.UnassignTeacherConfirmation\x12J\n" +
+	"\x0fListAssignments\x12\x1c.main.ListAssignmentsRequest\x1a\x19.main.TeachingAssignmentsB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
 
 var (
 	file_main_proto_rawDescOnce sync.Once
@@ -456,40 +823,55 @@ func file_main_proto_rawDescGZIP() []byte {
 	return file_main_proto_rawDescData
 }
 
-var file_main_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_main_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_main_proto_goTypes = []any{
-	(*StudentCount)(nil),               // 0: main.StudentCount
-	(*DeleteTeachersConfirmation)(nil), // 1: main.DeleteTeachersConfirmation
-	(*TeacherId)(nil),                  // 2: main.TeacherId
-	(*TeacherIds)(nil),                 // 3: main.TeacherIds
-	(*GetTeachersRequest)(nil),         // 4: main.GetTeachersRequest
-	(*Teacher)(nil),                    // 5: main.Teacher
-	(*Teachers)(nil),                   // 6: main.Teachers
-	(*SortField)(nil),                  // 7: main.SortField
-	(*Students)(nil),                   // 8: main.Students
+	(*ClassTeacherRequest)(nil),         // 0: main.ClassTeacherRequest
+	(*StudentCount)(nil),                // 1: main.StudentCount
+	(*DeleteTeachersConfirmation)(nil),  // 2: main.DeleteTeachersConfirmation
+	(*TeacherId)(nil),                   // 3: main.TeacherId
+	(*TeacherIds)(nil),                  // 4: main.TeacherIds
+	(*GetTeachersRequest)(nil),          // 5: main.GetTeachersRequest
+	(*Teacher)(nil),                     // 6: main.Teacher
+	(*Teachers)(nil),                    // 7: main.Teachers
+	(*TeachingAssignment)(nil),          // 8: main.TeachingAssignment
+	(*TeachingAssignments)(nil),         // 9: main.TeachingAssignments
+	(*TeachingAssignmentId)(nil),        // 10: main.TeachingAssignmentId
+	(*UnassignTeacherConfirmation)(nil), // 11: main.UnassignTeacherConfirmation
+	(*ListAssignmentsRequest)(nil),      // 12: main.ListAssignmentsRequest
+	(*SortField)(nil),                   // 13: main.SortField
+	(*Students)(nil),                    // 14: main.Students
 }
 var file_main_proto_depIdxs = []int32{
-	2,  // 0: main.TeacherIds.ids:type_name -> main.TeacherId
-	5,  // 1: main.GetTeachersRequest.teacher:type_name -> main.Teacher
-	7,  // 2: main.GetTeachersRequest.sort_by:type_name -> main.SortField
-	5,  // 3: main.Teachers.teachers:type_name -> main.Teacher
-	4,  // 4: main.TeachersService.GetTeachers:input_type -> main.GetTeachersRequest
-	6,  // 5: main.TeachersService.AddTeachers:input_type -> main.Teachers
-	6,  // 6: main.TeachersService.UpdateTeachers:input_type -> main.Teachers
-	3,  // 7: main.TeachersService.DeleteTeachers:input_type -> main.TeacherIds
-	2,  // 8: main.TeachersService.GetStudentsByClassTeacher:input_type -> main.TeacherId
-	2,  // 9: main.TeachersService.GetStudentCountByClassTeacher:input_type -> main.TeacherId
-	6,  // 10: main.TeachersService.GetTeachers:output_type -> main.Teachers
-	6,  // 11: main.TeachersService.AddTeachers:output_type -> main.Teachers
-	6,  // 12: main.TeachersService.UpdateTeachers:output_type -> main.Teachers
-	1,  // 13: main.TeachersService.DeleteTeachers:output_type -> main.DeleteTeachersConfirmation
-	8,  // 14: main.TeachersService.GetStudentsByClassTeacher:output_type -> main.Students
-	0,  // 15: main.TeachersService.GetStudentCountByClassTeacher:output_type -> main.StudentCount
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	3,  // 0: main.TeacherIds.ids:type_name -> main.TeacherId
+	6,  // 1: main.GetTeachersRequest.teacher:type_name -> main.Teacher
+	13, // 2: main.GetTeachersRequest.sort_by:type_name -> main.SortField
+	6,  // 3: main.Teachers.teachers:type_name -> main.Teacher
+	8,  // 4: main.TeachingAssignments.assignments:type_name -> main.TeachingAssignment
+	8,  // 5: main.ListAssignmentsRequest.assignment:type_name -> main.TeachingAssignment
+	13, // 6: main.ListAssignmentsRequest.sort_by:type_name -> main.SortField
+	5,  // 7: main.TeachersService.GetTeachers:input_type -> main.GetTeachersRequest
+	7,  // 8: main.TeachersService.AddTeachers:input_type -> main.Teachers
+	7,  // 9: main.TeachersService.UpdateTeachers:input_type -> main.Teachers
+	4,  // 10: main.TeachersService.DeleteTeachers:input_type -> main.TeacherIds
+	0,  // 11: main.TeachersService.GetStudentsByClassTeacher:input_type -> main.ClassTeacherRequest
+	0,  // 12: main.TeachersService.GetStudentCountByClassTeacher:input_type -> main.ClassTeacherRequest
+	8,  // 13: main.TeachersService.AssignTeacher:input_type -> main.TeachingAssignment
+	10, // 14: main.TeachersService.UnassignTeacher:input_type -> main.TeachingAssignmentId
+	12, // 15: main.TeachersService.ListAssignments:input_type -> main.ListAssignmentsRequest
+	7,  // 16: main.TeachersService.GetTeachers:output_type -> main.Teachers
+	7,  // 17: main.TeachersService.AddTeachers:output_type -> main.Teachers
+	7,  // 18: main.TeachersService.UpdateTeachers:output_type -> main.Teachers
+	2,  // 19: main.TeachersService.DeleteTeachers:output_type -> main.DeleteTeachersConfirmation
+	14, // 20: main.TeachersService.GetStudentsByClassTeacher:output_type -> main.Students
+	1,  // 21: main.TeachersService.GetStudentCountByClassTeacher:output_type -> main.StudentCount
+	8,  // 22: main.TeachersService.AssignTeacher:output_type -> main.TeachingAssignment
+	11, // 23: main.TeachersService.UnassignTeacher:output_type -> main.UnassignTeacherConfirmation
+	9,  // 24: main.TeachersService.ListAssignments:output_type -> main.TeachingAssignments
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_main_proto_init() }
@@ -504,7 +886,7 @@ func file_main_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_main_proto_rawDesc), len(file_main_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,150 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on ClassTeacherRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClassTeacherRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClassTeacherRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClassTeacherRequestMultiError, or nil if none found.
+func (m *ClassTeacherRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClassTeacherRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := ClassTeacherRequestValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_ClassTeacherRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := ClassTeacherRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSubjectId() != "" {
+
+		if !_ClassTeacherRequest_SubjectId_Pattern.MatchString(m.GetSubjectId()) {
+			err := ClassTeacherRequestValidationError{
+				field:  "SubjectId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClassTeacherRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassTeacherRequestMultiError is an error wrapping multiple validation
+// errors returned by ClassTeacherRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ClassTeacherRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassTeacherRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassTeacherRequestMultiError) AllErrors() []error { return m }
+
+// ClassTeacherRequestValidationError is the validation error returned by
+// ClassTeacherRequest.Validate if the designated constraints aren't met.
+type ClassTeacherRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassTeacherRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassTeacherRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassTeacherRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassTeacherRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassTeacherRequestValidationError) ErrorName() string {
+	return "ClassTeacherRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClassTeacherRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClassTeacherRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassTeacherRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassTeacherRequestValidationError{}
+
+var _ClassTeacherRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _ClassTeacherRequest_SubjectId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
 // Validate checks the field values on StudentCount with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -758,6 +902,21 @@ func (m *Teacher) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetClassId() != "" {
+
+		if !_Teacher_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := TeacherValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return TeacherMultiError(errors)
 	}
@@ -893,6 +1052,8 @@ var _Teacher_Class_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
 
 var _Teacher_Subject_Pattern = regexp.MustCompile("^[A-Za-z0-9 ]*$")
 
+var _Teacher_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
 // Validate checks the field values on Teachers with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1025,3 +1186,717 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = TeachersValidationError{}
+
+// Validate checks the field values on TeachingAssignment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TeachingAssignment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeachingAssignment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TeachingAssignmentMultiError, or nil if none found.
+func (m *TeachingAssignment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeachingAssignment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetTeacherId() != "" {
+
+		if !_TeachingAssignment_TeacherId_Pattern.MatchString(m.GetTeacherId()) {
+			err := TeachingAssignmentValidationError{
+				field:  "TeacherId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetClassId() != "" {
+
+		if !_TeachingAssignment_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := TeachingAssignmentValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetSubjectId() != "" {
+
+		if !_TeachingAssignment_SubjectId_Pattern.MatchString(m.GetSubjectId()) {
+			err := TeachingAssignmentValidationError{
+				field:  "SubjectId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetTerm()) > 32 {
+		err := TeachingAssignmentValidationError{
+			field:  "Term",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TeachingAssignment_Term_Pattern.MatchString(m.GetTerm()) {
+		err := TeachingAssignmentValidationError{
+			field:  "Term",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 -]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TeachingAssignmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeachingAssignmentMultiError is an error wrapping multiple validation errors
+// returned by TeachingAssignment.ValidateAll() if the designated constraints
+// aren't met.
+type TeachingAssignmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeachingAssignmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeachingAssignmentMultiError) AllErrors() []error { return m }
+
+// TeachingAssignmentValidationError is the validation error returned by
+// TeachingAssignment.Validate if the designated constraints aren't met.
+type TeachingAssignmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeachingAssignmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeachingAssignmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeachingAssignmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeachingAssignmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeachingAssignmentValidationError) ErrorName() string {
+	return "TeachingAssignmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TeachingAssignmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeachingAssignment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeachingAssignmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeachingAssignmentValidationError{}
+
+var _TeachingAssignment_TeacherId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _TeachingAssignment_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _TeachingAssignment_SubjectId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _TeachingAssignment_Term_Pattern = regexp.MustCompile("^[A-Za-z0-9 -]*$")
+
+// Validate checks the field values on TeachingAssignments with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TeachingAssignments) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeachingAssignments with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TeachingAssignmentsMultiError, or nil if none found.
+func (m *TeachingAssignments) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeachingAssignments) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAssignments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TeachingAssignmentsValidationError{
+						field:  fmt.Sprintf("Assignments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TeachingAssignmentsValidationError{
+						field:  fmt.Sprintf("Assignments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TeachingAssignmentsValidationError{
+					field:  fmt.Sprintf("Assignments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TeachingAssignmentsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeachingAssignmentsMultiError is an error wrapping multiple validation
+// errors returned by TeachingAssignments.ValidateAll() if the designated
+// constraints aren't met.
+type TeachingAssignmentsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeachingAssignmentsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeachingAssignmentsMultiError) AllErrors() []error { return m }
+
+// TeachingAssignmentsValidationError is the validation error returned by
+// TeachingAssignments.Validate if the designated constraints aren't met.
+type TeachingAssignmentsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeachingAssignmentsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeachingAssignmentsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeachingAssignmentsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeachingAssignmentsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeachingAssignmentsValidationError) ErrorName() string {
+	return "TeachingAssignmentsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TeachingAssignmentsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeachingAssignments.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeachingAssignmentsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeachingAssignmentsValidationError{}
+
+// Validate checks the field values on TeachingAssignmentId with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TeachingAssignmentId) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeachingAssignmentId with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TeachingAssignmentIdMultiError, or nil if none found.
+func (m *TeachingAssignmentId) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeachingAssignmentId) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := TeachingAssignmentIdValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_TeachingAssignmentId_Id_Pattern.MatchString(m.GetId()) {
+		err := TeachingAssignmentIdValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TeachingAssignmentIdMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeachingAssignmentIdMultiError is an error wrapping multiple validation
+// errors returned by TeachingAssignmentId.ValidateAll() if the designated
+// constraints aren't met.
+type TeachingAssignmentIdMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeachingAssignmentIdMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeachingAssignmentIdMultiError) AllErrors() []error { return m }
+
+// TeachingAssignmentIdValidationError is the validation error returned by
+// TeachingAssignmentId.Validate if the designated constraints aren't met.
+type TeachingAssignmentIdValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeachingAssignmentIdValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeachingAssignmentIdValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeachingAssignmentIdValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeachingAssignmentIdValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeachingAssignmentIdValidationError) ErrorName() string {
+	return "TeachingAssignmentIdValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TeachingAssignmentIdValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeachingAssignmentId.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeachingAssignmentIdValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeachingAssignmentIdValidationError{}
+
+var _TeachingAssignmentId_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on UnassignTeacherConfirmation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnassignTeacherConfirmation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnassignTeacherConfirmation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnassignTeacherConfirmationMultiError, or nil if none found.
+func (m *UnassignTeacherConfirmation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnassignTeacherConfirmation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for DeletedId
+
+	if len(errors) > 0 {
+		return UnassignTeacherConfirmationMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnassignTeacherConfirmationMultiError is an error wrapping multiple
+// validation errors returned by UnassignTeacherConfirmation.ValidateAll() if
+// the designated constraints aren't met.
+type UnassignTeacherConfirmationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnassignTeacherConfirmationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnassignTeacherConfirmationMultiError) AllErrors() []error { return m }
+
+// UnassignTeacherConfirmationValidationError is the validation error returned
+// by UnassignTeacherConfirmation.Validate if the designated constraints
+// aren't met.
+type UnassignTeacherConfirmationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnassignTeacherConfirmationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnassignTeacherConfirmationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnassignTeacherConfirmationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnassignTeacherConfirmationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnassignTeacherConfirmationValidationError) ErrorName() string {
+	return "UnassignTeacherConfirmationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnassignTeacherConfirmationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnassignTeacherConfirmation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnassignTeacherConfirmationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnassignTeacherConfirmationValidationError{}
+
+// Validate checks the field values on ListAssignmentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAssignmentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAssignmentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAssignmentsRequestMultiError, or nil if none found.
+func (m *ListAssignmentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAssignmentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAssignment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAssignmentsRequestValidationError{
+					field:  "Assignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAssignmentsRequestValidationError{
+					field:  "Assignment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAssignment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAssignmentsRequestValidationError{
+				field:  "Assignment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAssignmentsRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAssignmentsRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAssignmentsRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAssignmentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAssignmentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAssignmentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAssignmentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAssignmentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAssignmentsRequestMultiError) AllErrors() []error { return m }
+
+// ListAssignmentsRequestValidationError is the validation error returned by
+// ListAssignmentsRequest.Validate if the designated constraints aren't met.
+type ListAssignmentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAssignmentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAssignmentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAssignmentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAssignmentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAssignmentsRequestValidationError) ErrorName() string {
+	return "ListAssignmentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAssignmentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAssignmentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAssignmentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAssignmentsRequestValidationError{}
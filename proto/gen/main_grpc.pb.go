@@ -25,6 +25,9 @@ const (
 	TeachersService_DeleteTeachers_FullMethodName                = "/main.TeachersService/DeleteTeachers"
 	TeachersService_GetStudentsByClassTeacher_FullMethodName     = "/main.TeachersService/GetStudentsByClassTeacher"
 	TeachersService_GetStudentCountByClassTeacher_FullMethodName = "/main.TeachersService/GetStudentCountByClassTeacher"
+	TeachersService_AssignTeacher_FullMethodName                 = "/main.TeachersService/AssignTeacher"
+	TeachersService_UnassignTeacher_FullMethodName               = "/main.TeachersService/UnassignTeacher"
+	TeachersService_ListAssignments_FullMethodName               = "/main.TeachersService/ListAssignments"
 )
 
 // TeachersServiceClient is the client API for TeachersService service.
@@ -35,8 +38,13 @@ type TeachersServiceClient interface {
 	AddTeachers(ctx context.Context, in *Teachers, opts ...grpc.CallOption) (*Teachers, error)
 	UpdateTeachers(ctx context.Context, in *Teachers, opts ...grpc.CallOption) (*Teachers, error)
 	DeleteTeachers(ctx context.Context, in *TeacherIds, opts ...grpc.CallOption) (*DeleteTeachersConfirmation, error)
-	GetStudentsByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*Students, error)
-	GetStudentCountByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*StudentCount, error)
+	// GetStudentsByClassTeacher returns the students of every class the
+	// teacher is class teacher of or is assigned to teach.
+	GetStudentsByClassTeacher(ctx context.Context, in *ClassTeacherRequest, opts ...grpc.CallOption) (*Students, error)
+	GetStudentCountByClassTeacher(ctx context.Context, in *ClassTeacherRequest, opts ...grpc.CallOption) (*StudentCount, error)
+	AssignTeacher(ctx context.Context, in *TeachingAssignment, opts ...grpc.CallOption) (*TeachingAssignment, error)
+	UnassignTeacher(ctx context.Context, in *TeachingAssignmentId, opts ...grpc.CallOption) (*UnassignTeacherConfirmation, error)
+	ListAssignments(ctx context.Context, in *ListAssignmentsRequest, opts ...grpc.CallOption) (*TeachingAssignments, error)
 }
 
 type teachersServiceClient struct {
@@ -87,7 +95,7 @@ func (c *teachersServiceClient) DeleteTeachers(ctx context.Context, in *TeacherI
 	return out, nil
 }
 
-func (c *teachersServiceClient) GetStudentsByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*Students, error) {
+func (c *teachersServiceClient) GetStudentsByClassTeacher(ctx context.Context, in *ClassTeacherRequest, opts ...grpc.CallOption) (*Students, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Students)
 	err := c.cc.Invoke(ctx, TeachersService_GetStudentsByClassTeacher_FullMethodName, in, out, cOpts...)
@@ -97,7 +105,7 @@ func (c *teachersServiceClient) GetStudentsByClassTeacher(ctx context.Context, i
 	return out, nil
 }
 
-func (c *teachersServiceClient) GetStudentCountByClassTeacher(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*StudentCount, error) {
+func (c *teachersServiceClient) GetStudentCountByClassTeacher(ctx context.Context, in *ClassTeacherRequest, opts ...grpc.CallOption) (*StudentCount, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StudentCount)
 	err := c.cc.Invoke(ctx, TeachersService_GetStudentCountByClassTeacher_FullMethodName, in, out, cOpts...)
@@ -107,6 +115,36 @@ func (c *teachersServiceClient) GetStudentCountByClassTeacher(ctx context.Contex
 	return out, nil
 }
 
+func (c *teachersServiceClient) AssignTeacher(ctx context.Context, in *TeachingAssignment, opts ...grpc.CallOption) (*TeachingAssignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeachingAssignment)
+	err := c.cc.Invoke(ctx, TeachersService_AssignTeacher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teachersServiceClient) UnassignTeacher(ctx context.Context, in *TeachingAssignmentId, opts ...grpc.CallOption) (*UnassignTeacherConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignTeacherConfirmation)
+	err := c.cc.Invoke(ctx, TeachersService_UnassignTeacher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teachersServiceClient) ListAssignments(ctx context.Context, in *ListAssignmentsRequest, opts ...grpc.CallOption) (*TeachingAssignments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeachingAssignments)
+	err := c.cc.Invoke(ctx, TeachersService_ListAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TeachersServiceServer is the server API for TeachersService service.
 // All implementations must embed UnimplementedTeachersServiceServer
 // for forward compatibility.
@@ -115,8 +153,13 @@ type TeachersServiceServer interface {
 	AddTeachers(context.Context, *Teachers) (*Teachers, error)
 	UpdateTeachers(context.Context, *Teachers) (*Teachers, error)
 	DeleteTeachers(context.Context, *TeacherIds) (*DeleteTeachersConfirmation, error)
-	GetStudentsByClassTeacher(context.Context, *TeacherId) (*Students, error)
-	GetStudentCountByClassTeacher(context.Context, *TeacherId) (*StudentCount, error)
+	// GetStudentsByClassTeacher returns the students of every class the
+	// teacher is class teacher of or is assigned to teach.
+	GetStudentsByClassTeacher(context.Context, *ClassTeacherRequest) (*Students, error)
+	GetStudentCountByClassTeacher(context.Context, *ClassTeacherRequest) (*StudentCount, error)
+	AssignTeacher(context.Context, *TeachingAssignment) (*TeachingAssignment, error)
+	UnassignTeacher(context.Context, *TeachingAssignmentId) (*UnassignTeacherConfirmation, error)
+	ListAssignments(context.Context, *ListAssignmentsRequest) (*TeachingAssignments, error)
 	mustEmbedUnimplementedTeachersServiceServer()
 }
 
@@ -139,12 +182,21 @@ func (UnimplementedTeachersServiceServer) UpdateTeachers(context.Context, *Teach
 func (UnimplementedTeachersServiceServer) DeleteTeachers(context.Context, *TeacherIds) (*DeleteTeachersConfirmation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeachers not implemented")
 }
-func (UnimplementedTeachersServiceServer) GetStudentsByClassTeacher(context.Context, *TeacherId) (*Students, error) {
+func (UnimplementedTeachersServiceServer) GetStudentsByClassTeacher(context.Context, *ClassTeacherRequest) (*Students, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStudentsByClassTeacher not implemented")
 }
-func (UnimplementedTeachersServiceServer) GetStudentCountByClassTeacher(context.Context, *TeacherId) (*StudentCount, error) {
+func (UnimplementedTeachersServiceServer) GetStudentCountByClassTeacher(context.Context, *ClassTeacherRequest) (*StudentCount, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStudentCountByClassTeacher not implemented")
 }
+func (UnimplementedTeachersServiceServer) AssignTeacher(context.Context, *TeachingAssignment) (*TeachingAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTeacher not implemented")
+}
+func (UnimplementedTeachersServiceServer) UnassignTeacher(context.Context, *TeachingAssignmentId) (*UnassignTeacherConfirmation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignTeacher not implemented")
+}
+func (UnimplementedTeachersServiceServer) ListAssignments(context.Context, *ListAssignmentsRequest) (*TeachingAssignments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignments not implemented")
+}
 func (UnimplementedTeachersServiceServer) mustEmbedUnimplementedTeachersServiceServer() {}
 func (UnimplementedTeachersServiceServer) testEmbeddedByValue()                         {}
 
@@ -239,7 +291,7 @@ func _TeachersService_DeleteTeachers_Handler(srv interface{}, ctx context.Contex
 }
 
 func _TeachersService_GetStudentsByClassTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TeacherId)
+	in := new(ClassTeacherRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -251,13 +303,13 @@ func _TeachersService_GetStudentsByClassTeacher_Handler(srv interface{}, ctx con
 		FullMethod: TeachersService_GetStudentsByClassTeacher_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeachersServiceServer).GetStudentsByClassTeacher(ctx, req.(*TeacherId))
+		return srv.(TeachersServiceServer).GetStudentsByClassTeacher(ctx, req.(*ClassTeacherRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _TeachersService_GetStudentCountByClassTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TeacherId)
+	in := new(ClassTeacherRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -269,7 +321,61 @@ func _TeachersService_GetStudentCountByClassTeacher_Handler(srv interface{}, ctx
 		FullMethod: TeachersService_GetStudentCountByClassTeacher_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeachersServiceServer).GetStudentCountByClassTeacher(ctx, req.(*TeacherId))
+		return srv.(TeachersServiceServer).GetStudentCountByClassTeacher(ctx, req.(*ClassTeacherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeachersService_AssignTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeachingAssignment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeachersServiceServer).AssignTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeachersService_AssignTeacher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeachersServiceServer).AssignTeacher(ctx, req.(*TeachingAssignment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeachersService_UnassignTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeachingAssignmentId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeachersServiceServer).UnassignTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeachersService_UnassignTeacher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeachersServiceServer).UnassignTeacher(ctx, req.(*TeachingAssignmentId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeachersService_ListAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeachersServiceServer).ListAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeachersService_ListAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeachersServiceServer).ListAssignments(ctx, req.(*ListAssignmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -305,6 +411,18 @@ var TeachersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStudentCountByClassTeacher",
 			Handler:    _TeachersService_GetStudentCountByClassTeacher_Handler,
 		},
+		{
+			MethodName: "AssignTeacher",
+			Handler:    _TeachersService_AssignTeacher_Handler,
+		},
+		{
+			MethodName: "UnassignTeacher",
+			Handler:    _TeachersService_UnassignTeacher_Handler,
+		},
+		{
+			MethodName: "ListAssignments",
+			Handler:    _TeachersService_ListAssignments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "main.proto",
@@ -0,0 +1,416 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: notifications.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetFailedNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFailedNotificationsRequest) Reset() {
+	*x = GetFailedNotificationsRequest{}
+	mi := &file_notifications_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFailedNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFailedNotificationsRequest) ProtoMessage() {}
+
+func (x *GetFailedNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFailedNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetFailedNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetFailedNotificationsRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+type NotificationIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationIds) Reset() {
+	*x = NotificationIds{}
+	mi := &file_notifications_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationIds) ProtoMessage() {}
+
+func (x *NotificationIds) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationIds.ProtoReflect.Descriptor instead.
+func (*NotificationIds) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationIds) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type RetryNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Confirmation  bool                   `protobuf:"varint,1,opt,name=confirmation,proto3" json:"confirmation,omitempty"`
+	RetriedIds    []string               `protobuf:"bytes,2,rep,name=retried_ids,json=retriedIds,proto3" json:"retried_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryNotificationsResponse) Reset() {
+	*x = RetryNotificationsResponse{}
+	mi := &file_notifications_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryNotificationsResponse) ProtoMessage() {}
+
+func (x *RetryNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*RetryNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RetryNotificationsResponse) GetConfirmation() bool {
+	if x != nil {
+		return x.Confirmation
+	}
+	return false
+}
+
+func (x *RetryNotificationsResponse) GetRetriedIds() []string {
+	if x != nil {
+		return x.RetriedIds
+	}
+	return nil
+}
+
+// Notification is an outbox entry. The message body is never exposed since it
+// may carry one-time tokens.
+type Notification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Recipient      string                 `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Subject        string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError      string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt  string                 `protobuf:"bytes,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SentAt         string                 `protobuf:"bytes,10,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_notifications_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *Notification) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Notification) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Notification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Notification) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Notification) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Notification) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Notification) GetSentAt() string {
+	if x != nil {
+		return x.SentAt
+	}
+	return ""
+}
+
+type Notifications struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notifications) Reset() {
+	*x = Notifications{}
+	mi := &file_notifications_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notifications) ProtoMessage() {}
+
+func (x *Notifications) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notifications.ProtoReflect.Descriptor instead.
+func (*Notifications) Descriptor() ([]byte, []int) {
+	return file_notifications_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Notifications) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+var File_notifications_proto protoreflect.FileDescriptor
+
+const file_notifications_proto_rawDesc = "" +
+	"\n" +
+	"\x13notifications.proto\x12\x04main\x1a\x17validate/validate.proto\"I\n" +
+	"\x1dGetFailedNotificationsRequest\x12(\n" +
+	"\trecipient\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\xd0\x01\x01`\x01R\trecipient\"H\n" +
+	"\x0fNotificationIds\x125\n" +
+	"\x03ids\x18\x01 \x03(\tB#\xfaB \x92\x01\x1d\b\x01\"\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x03ids\"a\n" +
+	"\x1aRetryNotificationsResponse\x12\"\n" +
+	"\fconfirmation\x18\x01 \x01(\bR\fconfirmation\x12\x1f\n" +
+	"\vretried_ids\x18\x02 \x03(\tR\n" +
+	"retriedIds\"\xb2\x02\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\x12\x1c\n" +
+	"\trecipient\x18\x03 \x01(\tR\trecipient\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x12&\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\tR\rnextAttemptAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x17\n" +
+	"\asent_at\x18\n" +
+	" \x01(\tR\x06sentAt\"I\n" +
+	"\rNotifications\x128\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x12.main.NotificationR\rnotifications2\xb9\x01\n" +
+	"\x14NotificationsService\x12R\n" +
+	"\x16GetFailedNotifications\x12#.main.GetFailedNotificationsRequest\x1a\x13.main.Notifications\x12M\n" +
+	"\x12RetryNotifications\x12\x15.main.NotificationIds\x1a .main.RetryNotificationsResponseB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_notifications_proto_rawDescOnce sync.Once
+	file_notifications_proto_rawDescData []byte
+)
+
+func file_notifications_proto_rawDescGZIP() []byte {
+	file_notifications_proto_rawDescOnce.Do(func() {
+		file_notifications_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_notifications_proto_rawDesc), len(file_notifications_proto_rawDesc)))
+	})
+	return file_notifications_proto_rawDescData
+}
+
+var file_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_notifications_proto_goTypes = []any{
+	(*GetFailedNotificationsRequest)(nil), // 0: main.GetFailedNotificationsRequest
+	(*NotificationIds)(nil),               // 1: main.NotificationIds
+	(*RetryNotificationsResponse)(nil),    // 2: main.RetryNotificationsResponse
+	(*Notification)(nil),                  // 3: main.Notification
+	(*Notifications)(nil),                 // 4: main.Notifications
+}
+var file_notifications_proto_depIdxs = []int32{
+	3, // 0: main.Notifications.notifications:type_name -> main.Notification
+	0, // 1: main.NotificationsService.GetFailedNotifications:input_type -> main.GetFailedNotificationsRequest
+	1, // 2: main.NotificationsService.RetryNotifications:input_type -> main.NotificationIds
+	4, // 3: main.NotificationsService.GetFailedNotifications:output_type -> main.Notifications
+	2, // 4: main.NotificationsService.RetryNotifications:output_type -> main.RetryNotificationsResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_notifications_proto_init() }
+func file_notifications_proto_init() {
+	if File_notifications_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notifications_proto_rawDesc), len(file_notifications_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_notifications_proto_goTypes,
+		DependencyIndexes: file_notifications_proto_depIdxs,
+		MessageInfos:      file_notifications_proto_msgTypes,
+	}.Build()
+	File_notifications_proto = out.File
+	file_notifications_proto_goTypes = nil
+	file_notifications_proto_depIdxs = nil
+}
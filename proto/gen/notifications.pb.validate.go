@@ -0,0 +1,703 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notifications.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetFailedNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFailedNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFailedNotificationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetFailedNotificationsRequestMultiError, or nil if none found.
+func (m *GetFailedNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFailedNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRecipient() != "" {
+
+		if err := m._validateEmail(m.GetRecipient()); err != nil {
+			err = GetFailedNotificationsRequestValidationError{
+				field:  "Recipient",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFailedNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetFailedNotificationsRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *GetFailedNotificationsRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// GetFailedNotificationsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetFailedNotificationsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetFailedNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFailedNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFailedNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// GetFailedNotificationsRequestValidationError is the validation error
+// returned by GetFailedNotificationsRequest.Validate if the designated
+// constraints aren't met.
+type GetFailedNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFailedNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFailedNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFailedNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFailedNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFailedNotificationsRequestValidationError) ErrorName() string {
+	return "GetFailedNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFailedNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFailedNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFailedNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFailedNotificationsRequestValidationError{}
+
+// Validate checks the field values on NotificationIds with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NotificationIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationIds with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationIdsMultiError, or nil if none found.
+func (m *NotificationIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) < 1 {
+		err := NotificationIdsValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) != 24 {
+			err := NotificationIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value length must be 24 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_NotificationIds_Ids_Pattern.MatchString(item) {
+			err := NotificationIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return NotificationIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationIdsMultiError is an error wrapping multiple validation errors
+// returned by NotificationIds.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationIdsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationIdsMultiError) AllErrors() []error { return m }
+
+// NotificationIdsValidationError is the validation error returned by
+// NotificationIds.Validate if the designated constraints aren't met.
+type NotificationIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationIdsValidationError) ErrorName() string { return "NotificationIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationIdsValidationError{}
+
+var _NotificationIds_Ids_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on RetryNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetryNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryNotificationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryNotificationsResponseMultiError, or nil if none found.
+func (m *RetryNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Confirmation
+
+	if len(errors) > 0 {
+		return RetryNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryNotificationsResponseMultiError is an error wrapping multiple
+// validation errors returned by RetryNotificationsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RetryNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryNotificationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// RetryNotificationsResponseValidationError is the validation error returned
+// by RetryNotificationsResponse.Validate if the designated constraints aren't met.
+type RetryNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryNotificationsResponseValidationError) ErrorName() string {
+	return "RetryNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryNotificationsResponseValidationError{}
+
+// Validate checks the field values on Notification with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Notification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Notification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotificationMultiError, or
+// nil if none found.
+func (m *Notification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Notification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for Recipient
+
+	// no validation rules for Subject
+
+	// no validation rules for Status
+
+	// no validation rules for Attempts
+
+	// no validation rules for LastError
+
+	// no validation rules for NextAttemptAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for SentAt
+
+	if len(errors) > 0 {
+		return NotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationMultiError is an error wrapping multiple validation errors
+// returned by Notification.ValidateAll() if the designated constraints aren't met.
+type NotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationMultiError) AllErrors() []error { return m }
+
+// NotificationValidationError is the validation error returned by
+// Notification.Validate if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
+// Validate checks the field values on Notifications with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Notifications) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Notifications with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotificationsMultiError, or
+// nil if none found.
+func (m *Notifications) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Notifications) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationsValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationsValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationsValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return NotificationsMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationsMultiError is an error wrapping multiple validation errors
+// returned by Notifications.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationsMultiError) AllErrors() []error { return m }
+
+// NotificationsValidationError is the validation error returned by
+// Notifications.Validate if the designated constraints aren't met.
+type NotificationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationsValidationError) ErrorName() string { return "NotificationsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotifications.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationsValidationError{}
@@ -0,0 +1,331 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: privacy.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PersonRef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind is one of student, teacher or exec
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonRef) Reset() {
+	*x = PersonRef{}
+	mi := &file_privacy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonRef) ProtoMessage() {}
+
+func (x *PersonRef) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonRef.ProtoReflect.Descriptor instead.
+func (*PersonRef) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PersonRef) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PersonRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PersonDataExport struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// archive is a JSON document holding every record found for the person,
+	// grouped by collection
+	Archive       []byte `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonDataExport) Reset() {
+	*x = PersonDataExport{}
+	mi := &file_privacy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonDataExport) ProtoMessage() {}
+
+func (x *PersonDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonDataExport.ProtoReflect.Descriptor instead.
+func (*PersonDataExport) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PersonDataExport) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *PersonDataExport) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+type ErasePersonDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *PersonRef             `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErasePersonDataRequest) Reset() {
+	*x = ErasePersonDataRequest{}
+	mi := &file_privacy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErasePersonDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasePersonDataRequest) ProtoMessage() {}
+
+func (x *ErasePersonDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasePersonDataRequest.ProtoReflect.Descriptor instead.
+func (*ErasePersonDataRequest) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ErasePersonDataRequest) GetPerson() *PersonRef {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *ErasePersonDataRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ErasureReceipt struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ReceiptId string                 `protobuf:"bytes,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	// payload is the JSON receipt that the signature covers
+	Payload       string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature     string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Algorithm     string `protobuf:"bytes,4,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErasureReceipt) Reset() {
+	*x = ErasureReceipt{}
+	mi := &file_privacy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErasureReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasureReceipt) ProtoMessage() {}
+
+func (x *ErasureReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_privacy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasureReceipt.ProtoReflect.Descriptor instead.
+func (*ErasureReceipt) Descriptor() ([]byte, []int) {
+	return file_privacy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ErasureReceipt) GetReceiptId() string {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return ""
+}
+
+func (x *ErasureReceipt) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *ErasureReceipt) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ErasureReceipt) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+var File_privacy_proto protoreflect.FileDescriptor
+
+const file_privacy_proto_rawDesc = "" +
+	"\n" +
+	"\rprivacy.proto\x12\x04main\x1a\x17validate/validate.proto\"l\n" +
+	"\tPersonRef\x121\n" +
+	"\x04kind\x18\x01 \x01(\tB\x1d\xfaB\x1ar\x18R\astudentR\ateacherR\x04execR\x04kind\x12,\n" +
+	"\x02id\x18\x02 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x02id\"D\n" +
+	"\x10PersonDataExport\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\aarchive\x18\x02 \x01(\fR\aarchive\"c\n" +
+	"\x16ErasePersonDataRequest\x121\n" +
+	"\x06person\x18\x01 \x01(\v2\x0f.main.PersonRefB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06person\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x85\x01\n" +
+	"\x0eErasureReceipt\x12\x1d\n" +
+	"\n" +
+	"receipt_id\x18\x01 \x01(\tR\treceiptId\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\tR\apayload\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x1c\n" +
+	"\talgorithm\x18\x04 \x01(\tR\talgorithm2\x94\x01\n" +
+	"\x0ePrivacyService\x12;\n" +
+	"\x10ExportPersonData\x12\x0f.main.PersonRef\x1a\x16.main.PersonDataExport\x12E\n" +
+	"\x0fErasePersonData\x12\x1c.main.ErasePersonDataRequest\x1a\x14.main.ErasureReceiptB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_privacy_proto_rawDescOnce sync.Once
+	file_privacy_proto_rawDescData []byte
+)
+
+func file_privacy_proto_rawDescGZIP() []byte {
+	file_privacy_proto_rawDescOnce.Do(func() {
+		file_privacy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_privacy_proto_rawDesc), len(file_privacy_proto_rawDesc)))
+	})
+	return file_privacy_proto_rawDescData
+}
+
+var file_privacy_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_privacy_proto_goTypes = []any{
+	(*PersonRef)(nil),              // 0: main.PersonRef
+	(*PersonDataExport)(nil),       // 1: main.PersonDataExport
+	(*ErasePersonDataRequest)(nil), // 2: main.ErasePersonDataRequest
+	(*ErasureReceipt)(nil),         // 3: main.ErasureReceipt
+}
+var file_privacy_proto_depIdxs = []int32{
+	0, // 0: main.ErasePersonDataRequest.person:type_name -> main.PersonRef
+	0, // 1: main.PrivacyService.ExportPersonData:input_type -> main.PersonRef
+	2, // 2: main.PrivacyService.ErasePersonData:input_type -> main.ErasePersonDataRequest
+	1, // 3: main.PrivacyService.ExportPersonData:output_type -> main.PersonDataExport
+	3, // 4: main.PrivacyService.ErasePersonData:output_type -> main.ErasureReceipt
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_privacy_proto_init() }
+func file_privacy_proto_init() {
+	if File_privacy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_privacy_proto_rawDesc), len(file_privacy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_privacy_proto_goTypes,
+		DependencyIndexes: file_privacy_proto_depIdxs,
+		MessageInfos:      file_privacy_proto_msgTypes,
+	}.Build()
+	File_privacy_proto = out.File
+	file_privacy_proto_goTypes = nil
+	file_privacy_proto_depIdxs = nil
+}
@@ -0,0 +1,533 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: privacy.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on PersonRef with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PersonRef) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PersonRef with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PersonRefMultiError, or nil
+// if none found.
+func (m *PersonRef) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PersonRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _PersonRef_Kind_InLookup[m.GetKind()]; !ok {
+		err := PersonRefValidationError{
+			field:  "Kind",
+			reason: "value must be in list [student teacher exec]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetId()) != 24 {
+		err := PersonRefValidationError{
+			field:  "Id",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_PersonRef_Id_Pattern.MatchString(m.GetId()) {
+		err := PersonRefValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PersonRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// PersonRefMultiError is an error wrapping multiple validation errors returned
+// by PersonRef.ValidateAll() if the designated constraints aren't met.
+type PersonRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PersonRefMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PersonRefMultiError) AllErrors() []error { return m }
+
+// PersonRefValidationError is the validation error returned by
+// PersonRef.Validate if the designated constraints aren't met.
+type PersonRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PersonRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PersonRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PersonRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PersonRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PersonRefValidationError) ErrorName() string { return "PersonRefValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PersonRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPersonRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PersonRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PersonRefValidationError{}
+
+var _PersonRef_Kind_InLookup = map[string]struct{}{
+	"student": {},
+	"teacher": {},
+	"exec":    {},
+}
+
+var _PersonRef_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on PersonDataExport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PersonDataExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PersonDataExport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PersonDataExportMultiError, or nil if none found.
+func (m *PersonDataExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PersonDataExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	// no validation rules for Archive
+
+	if len(errors) > 0 {
+		return PersonDataExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// PersonDataExportMultiError is an error wrapping multiple validation errors
+// returned by PersonDataExport.ValidateAll() if the designated constraints
+// aren't met.
+type PersonDataExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PersonDataExportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PersonDataExportMultiError) AllErrors() []error { return m }
+
+// PersonDataExportValidationError is the validation error returned by
+// PersonDataExport.Validate if the designated constraints aren't met.
+type PersonDataExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PersonDataExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PersonDataExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PersonDataExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PersonDataExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PersonDataExportValidationError) ErrorName() string { return "PersonDataExportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PersonDataExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPersonDataExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PersonDataExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PersonDataExportValidationError{}
+
+// Validate checks the field values on ErasePersonDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ErasePersonDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasePersonDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ErasePersonDataRequestMultiError, or nil if none found.
+func (m *ErasePersonDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasePersonDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPerson() == nil {
+		err := ErasePersonDataRequestValidationError{
+			field:  "Person",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPerson()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ErasePersonDataRequestValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ErasePersonDataRequestValidationError{
+					field:  "Person",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPerson()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ErasePersonDataRequestValidationError{
+				field:  "Person",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ErasePersonDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasePersonDataRequestMultiError is an error wrapping multiple validation
+// errors returned by ErasePersonDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ErasePersonDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasePersonDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasePersonDataRequestMultiError) AllErrors() []error { return m }
+
+// ErasePersonDataRequestValidationError is the validation error returned by
+// ErasePersonDataRequest.Validate if the designated constraints aren't met.
+type ErasePersonDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasePersonDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasePersonDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasePersonDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasePersonDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasePersonDataRequestValidationError) ErrorName() string {
+	return "ErasePersonDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ErasePersonDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasePersonDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasePersonDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasePersonDataRequestValidationError{}
+
+// Validate checks the field values on ErasureReceipt with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErasureReceipt) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasureReceipt with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErasureReceiptMultiError,
+// or nil if none found.
+func (m *ErasureReceipt) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasureReceipt) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReceiptId
+
+	// no validation rules for Payload
+
+	// no validation rules for Signature
+
+	// no validation rules for Algorithm
+
+	if len(errors) > 0 {
+		return ErasureReceiptMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasureReceiptMultiError is an error wrapping multiple validation errors
+// returned by ErasureReceipt.ValidateAll() if the designated constraints
+// aren't met.
+type ErasureReceiptMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasureReceiptMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasureReceiptMultiError) AllErrors() []error { return m }
+
+// ErasureReceiptValidationError is the validation error returned by
+// ErasureReceipt.Validate if the designated constraints aren't met.
+type ErasureReceiptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasureReceiptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasureReceiptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasureReceiptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasureReceiptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasureReceiptValidationError) ErrorName() string { return "ErasureReceiptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErasureReceiptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasureReceipt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasureReceiptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasureReceiptValidationError{}
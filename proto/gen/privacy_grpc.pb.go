@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: privacy.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PrivacyService_ExportPersonData_FullMethodName = "/main.PrivacyService/ExportPersonData"
+	PrivacyService_ErasePersonData_FullMethodName  = "/main.PrivacyService/ErasePersonData"
+)
+
+// PrivacyServiceClient is the client API for PrivacyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PrivacyServiceClient interface {
+	ExportPersonData(ctx context.Context, in *PersonRef, opts ...grpc.CallOption) (*PersonDataExport, error)
+	ErasePersonData(ctx context.Context, in *ErasePersonDataRequest, opts ...grpc.CallOption) (*ErasureReceipt, error)
+}
+
+type privacyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPrivacyServiceClient(cc grpc.ClientConnInterface) PrivacyServiceClient {
+	return &privacyServiceClient{cc}
+}
+
+func (c *privacyServiceClient) ExportPersonData(ctx context.Context, in *PersonRef, opts ...grpc.CallOption) (*PersonDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PersonDataExport)
+	err := c.cc.Invoke(ctx, PrivacyService_ExportPersonData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyServiceClient) ErasePersonData(ctx context.Context, in *ErasePersonDataRequest, opts ...grpc.CallOption) (*ErasureReceipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ErasureReceipt)
+	err := c.cc.Invoke(ctx, PrivacyService_ErasePersonData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrivacyServiceServer is the server API for PrivacyService service.
+// All implementations must embed UnimplementedPrivacyServiceServer
+// for forward compatibility.
+type PrivacyServiceServer interface {
+	ExportPersonData(context.Context, *PersonRef) (*PersonDataExport, error)
+	ErasePersonData(context.Context, *ErasePersonDataRequest) (*ErasureReceipt, error)
+	mustEmbedUnimplementedPrivacyServiceServer()
+}
+
+// UnimplementedPrivacyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPrivacyServiceServer struct{}
+
+func (UnimplementedPrivacyServiceServer) ExportPersonData(context.Context, *PersonRef) (*PersonDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPersonData not implemented")
+}
+func (UnimplementedPrivacyServiceServer) ErasePersonData(context.Context, *ErasePersonDataRequest) (*ErasureReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ErasePersonData not implemented")
+}
+func (UnimplementedPrivacyServiceServer) mustEmbedUnimplementedPrivacyServiceServer() {}
+func (UnimplementedPrivacyServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePrivacyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PrivacyServiceServer will
+// result in compilation errors.
+type UnsafePrivacyServiceServer interface {
+	mustEmbedUnimplementedPrivacyServiceServer()
+}
+
+func RegisterPrivacyServiceServer(s grpc.ServiceRegistrar, srv PrivacyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPrivacyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PrivacyService_ServiceDesc, srv)
+}
+
+func _PrivacyService_ExportPersonData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersonRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyServiceServer).ExportPersonData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrivacyService_ExportPersonData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyServiceServer).ExportPersonData(ctx, req.(*PersonRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyService_ErasePersonData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ErasePersonDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyServiceServer).ErasePersonData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrivacyService_ErasePersonData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyServiceServer).ErasePersonData(ctx, req.(*ErasePersonDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PrivacyService_ServiceDesc is the grpc.ServiceDesc for PrivacyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PrivacyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.PrivacyService",
+	HandlerType: (*PrivacyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportPersonData",
+			Handler:    _PrivacyService_ExportPersonData_Handler,
+		},
+		{
+			MethodName: "ErasePersonData",
+			Handler:    _PrivacyService_ErasePersonData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "privacy.proto",
+}
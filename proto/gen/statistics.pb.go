@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: statistics.proto
+
+package grpcapipb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SchoolStatistics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalStudents int32                  `protobuf:"varint,1,opt,name=total_students,json=totalStudents,proto3" json:"total_students,omitempty"`
+	TotalTeachers int32                  `protobuf:"varint,2,opt,name=total_teachers,json=totalTeachers,proto3" json:"total_teachers,omitempty"`
+	Classes       []*ClassStatistics     `protobuf:"bytes,3,rep,name=classes,proto3" json:"classes,omitempty"`
+	Subjects      []*SubjectStatistics   `protobuf:"bytes,4,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	// classes_without_teacher lists the IDs of classes no teacher is
+	// linked to
+	ClassesWithoutTeacher []string     `protobuf:"bytes,5,rep,name=classes_without_teacher,json=classesWithoutTeacher,proto3" json:"classes_without_teacher,omitempty"`
+	Execs                 []*ExecCount `protobuf:"bytes,6,rep,name=execs,proto3" json:"execs,omitempty"`
+	// generated_at is when the numbers were computed
+	GeneratedAt   string `protobuf:"bytes,7,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchoolStatistics) Reset() {
+	*x = SchoolStatistics{}
+	mi := &file_statistics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchoolStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchoolStatistics) ProtoMessage() {}
+
+func (x *SchoolStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchoolStatistics.ProtoReflect.Descriptor instead.
+func (*SchoolStatistics) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SchoolStatistics) GetTotalStudents() int32 {
+	if x != nil {
+		return x.TotalStudents
+	}
+	return 0
+}
+
+func (x *SchoolStatistics) GetTotalTeachers() int32 {
+	if x != nil {
+		return x.TotalTeachers
+	}
+	return 0
+}
+
+func (x *SchoolStatistics) GetClasses() []*ClassStatistics {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+func (x *SchoolStatistics) GetSubjects() []*SubjectStatistics {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *SchoolStatistics) GetClassesWithoutTeacher() []string {
+	if x != nil {
+		return x.ClassesWithoutTeacher
+	}
+	return nil
+}
+
+func (x *SchoolStatistics) GetExecs() []*ExecCount {
+	if x != nil {
+		return x.Execs
+	}
+	return nil
+}
+
+func (x *SchoolStatistics) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+// ClassStatistics counts the teachers of a class the same way
+// GetStudentsByClassTeacher finds a teacher's classes: their own class_id,
+// homeroom classes and teaching assignments.
+type ClassStatistics struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ClassId      string                 `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	GradeLevel   int32                  `protobuf:"varint,2,opt,name=grade_level,json=gradeLevel,proto3" json:"grade_level,omitempty"`
+	Section      string                 `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`
+	AcademicYear string                 `protobuf:"bytes,4,opt,name=academic_year,json=academicYear,proto3" json:"academic_year,omitempty"`
+	StudentCount int32                  `protobuf:"varint,5,opt,name=student_count,json=studentCount,proto3" json:"student_count,omitempty"`
+	TeacherCount int32                  `protobuf:"varint,6,opt,name=teacher_count,json=teacherCount,proto3" json:"teacher_count,omitempty"`
+	// students_per_teacher is 0 for classes without a teacher
+	StudentsPerTeacher float32 `protobuf:"fixed32,7,opt,name=students_per_teacher,json=studentsPerTeacher,proto3" json:"students_per_teacher,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ClassStatistics) Reset() {
+	*x = ClassStatistics{}
+	mi := &file_statistics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassStatistics) ProtoMessage() {}
+
+func (x *ClassStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassStatistics.ProtoReflect.Descriptor instead.
+func (*ClassStatistics) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClassStatistics) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *ClassStatistics) GetGradeLevel() int32 {
+	if x != nil {
+		return x.GradeLevel
+	}
+	return 0
+}
+
+func (x *ClassStatistics) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *ClassStatistics) GetAcademicYear() string {
+	if x != nil {
+		return x.AcademicYear
+	}
+	return ""
+}
+
+func (x *ClassStatistics) GetStudentCount() int32 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+func (x *ClassStatistics) GetTeacherCount() int32 {
+	if x != nil {
+		return x.TeacherCount
+	}
+	return 0
+}
+
+func (x *ClassStatistics) GetStudentsPerTeacher() float32 {
+	if x != nil {
+		return x.StudentsPerTeacher
+	}
+	return 0
+}
+
+type SubjectStatistics struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SubjectId string                 `protobuf:"bytes,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Code      string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// qualified_teachers may teach the subject, assigned_teachers do
+	QualifiedTeachers int32 `protobuf:"varint,4,opt,name=qualified_teachers,json=qualifiedTeachers,proto3" json:"qualified_teachers,omitempty"`
+	AssignedTeachers  int32 `protobuf:"varint,5,opt,name=assigned_teachers,json=assignedTeachers,proto3" json:"assigned_teachers,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubjectStatistics) Reset() {
+	*x = SubjectStatistics{}
+	mi := &file_statistics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubjectStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectStatistics) ProtoMessage() {}
+
+func (x *SubjectStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubjectStatistics.ProtoReflect.Descriptor instead.
+func (*SubjectStatistics) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubjectStatistics) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *SubjectStatistics) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SubjectStatistics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubjectStatistics) GetQualifiedTeachers() int32 {
+	if x != nil {
+		return x.QualifiedTeachers
+	}
+	return 0
+}
+
+func (x *SubjectStatistics) GetAssignedTeachers() int32 {
+	if x != nil {
+		return x.AssignedTeachers
+	}
+	return 0
+}
+
+type ExecCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Role  string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// status is active, inactive or pending
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Count         int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecCount) Reset() {
+	*x = ExecCount{}
+	mi := &file_statistics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecCount) ProtoMessage() {}
+
+func (x *ExecCount) ProtoReflect() protoreflect.Message {
+	mi := &file_statistics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecCount.ProtoReflect.Descriptor instead.
+func (*ExecCount) Descriptor() ([]byte, []int) {
+	return file_statistics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExecCount) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ExecCount) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExecCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_statistics_proto protoreflect.FileDescriptor
+
+const file_statistics_proto_rawDesc = "" +
+	"\n" +
+	"\x10statistics.proto\x12\x04main\x1a\vexecs.proto\"\xc8\x02\n" +
+	"\x10SchoolStatistics\x12%\n" +
+	"\x0etotal_students\x18\x01 \x01(\x05R\rtotalStudents\x12%\n" +
+	"\x0etotal_teachers\x18\x02 \x01(\x05R\rtotalTeachers\x12/\n" +
+	"\aclasses\x18\x03 \x03(\v2\x15.main.ClassStatisticsR\aclasses\x123\n" +
+	"\bsubjects\x18\x04 \x03(\v2\x17.main.SubjectStatisticsR\bsubjects\x126\n" +
+	"\x17classes_without_teacher\x18\x05 \x03(\tR\x15classesWithoutTeacher\x12%\n" +
+	"\x05execs\x18\x06 \x03(\v2\x0f.main.ExecCountR\x05execs\x12!\n" +
+	"\fgenerated_at\x18\a \x01(\tR\vgeneratedAt\"\x88\x02\n" +
+	"\x0fClassStatistics\x12\x19\n" +
+	"\bclass_id\x18\x01 \x01(\tR\aclassId\x12\x1f\n" +
+	"\vgrade_level\x18\x02 \x01(\x05R\n" +
+	"gradeLevel\x12\x18\n" +
+	"\asection\x18\x03 \x01(\tR\asection\x12#\n" +
+	"\racademic_year\x18\x04 \x01(\tR\facademicYear\x12#\n" +
+	"\rstudent_count\x18\x05 \x01(\x05R\fstudentCount\x12#\n" +
+	"\rteacher_count\x18\x06 \x01(\x05R\fteacherCount\x120\n" +
+	"\x14students_per_teacher\x18\a \x01(\x02R\x12studentsPerTeacher\"\xb6\x01\n" +
+	"\x11SubjectStatistics\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x01 \x01(\tR\tsubjectId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12-\n" +
+	"\x12qualified_teachers\x18\x04 \x01(\x05R\x11qualifiedTeachers\x12+\n" +
+	"\x11assigned_teachers\x18\x05 \x01(\x05R\x10assignedTeachers\"M\n" +
+	"\tExecCount\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count2P\n" +
+	"\x11StatisticsService\x12;\n" +
+	"\rGetStatistics\x12\x12.main.EmptyRequest\x1a\x16.main.SchoolStatisticsB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_statistics_proto_rawDescOnce sync.Once
+	file_statistics_proto_rawDescData []byte
+)
+
+func file_statistics_proto_rawDescGZIP() []byte {
+	file_statistics_proto_rawDescOnce.Do(func() {
+		file_statistics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_statistics_proto_rawDesc), len(file_statistics_proto_rawDesc)))
+	})
+	return file_statistics_proto_rawDescData
+}
+
+var file_statistics_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_statistics_proto_goTypes = []any{
+	(*SchoolStatistics)(nil),  // 0: main.SchoolStatistics
+	(*ClassStatistics)(nil),   // 1: main.ClassStatistics
+	(*SubjectStatistics)(nil), // 2: main.SubjectStatistics
+	(*ExecCount)(nil),         // 3: main.ExecCount
+	(*EmptyRequest)(nil),      // 4: main.EmptyRequest
+}
+var file_statistics_proto_depIdxs = []int32{
+	1, // 0: main.SchoolStatistics.classes:type_name -> main.ClassStatistics
+	2, // 1: main.SchoolStatistics.subjects:type_name -> main.SubjectStatistics
+	3, // 2: main.SchoolStatistics.execs:type_name -> main.ExecCount
+	4, // 3: main.StatisticsService.GetStatistics:input_type -> main.EmptyRequest
+	0, // 4: main.StatisticsService.GetStatistics:output_type -> main.SchoolStatistics
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_statistics_proto_init() }
+func file_statistics_proto_init() {
+	if File_statistics_proto != nil {
+		return
+	}
+	file_execs_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_statistics_proto_rawDesc), len(file_statistics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_statistics_proto_goTypes,
+		DependencyIndexes: file_statistics_proto_depIdxs,
+		MessageInfos:      file_statistics_proto_msgTypes,
+	}.Build()
+	File_statistics_proto = out.File
+	file_statistics_proto_goTypes = nil
+	file_statistics_proto_depIdxs = nil
+}
@@ -0,0 +1,575 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: statistics.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SchoolStatistics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SchoolStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SchoolStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SchoolStatisticsMultiError, or nil if none found.
+func (m *SchoolStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SchoolStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TotalStudents
+
+	// no validation rules for TotalTeachers
+
+	for idx, item := range m.GetClasses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchoolStatisticsValidationError{
+						field:  fmt.Sprintf("Classes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchoolStatisticsValidationError{
+						field:  fmt.Sprintf("Classes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchoolStatisticsValidationError{
+					field:  fmt.Sprintf("Classes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSubjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchoolStatisticsValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchoolStatisticsValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchoolStatisticsValidationError{
+					field:  fmt.Sprintf("Subjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetExecs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SchoolStatisticsValidationError{
+						field:  fmt.Sprintf("Execs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SchoolStatisticsValidationError{
+						field:  fmt.Sprintf("Execs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SchoolStatisticsValidationError{
+					field:  fmt.Sprintf("Execs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for GeneratedAt
+
+	if len(errors) > 0 {
+		return SchoolStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchoolStatisticsMultiError is an error wrapping multiple validation errors
+// returned by SchoolStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type SchoolStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchoolStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchoolStatisticsMultiError) AllErrors() []error { return m }
+
+// SchoolStatisticsValidationError is the validation error returned by
+// SchoolStatistics.Validate if the designated constraints aren't met.
+type SchoolStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchoolStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchoolStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchoolStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchoolStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchoolStatisticsValidationError) ErrorName() string { return "SchoolStatisticsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchoolStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSchoolStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchoolStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchoolStatisticsValidationError{}
+
+// Validate checks the field values on ClassStatistics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClassStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClassStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClassStatisticsMultiError, or nil if none found.
+func (m *ClassStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClassStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClassId
+
+	// no validation rules for GradeLevel
+
+	// no validation rules for Section
+
+	// no validation rules for AcademicYear
+
+	// no validation rules for StudentCount
+
+	// no validation rules for TeacherCount
+
+	// no validation rules for StudentsPerTeacher
+
+	if len(errors) > 0 {
+		return ClassStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClassStatisticsMultiError is an error wrapping multiple validation errors
+// returned by ClassStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type ClassStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClassStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClassStatisticsMultiError) AllErrors() []error { return m }
+
+// ClassStatisticsValidationError is the validation error returned by
+// ClassStatistics.Validate if the designated constraints aren't met.
+type ClassStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClassStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClassStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClassStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClassStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClassStatisticsValidationError) ErrorName() string { return "ClassStatisticsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClassStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClassStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClassStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClassStatisticsValidationError{}
+
+// Validate checks the field values on SubjectStatistics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubjectStatistics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubjectStatistics with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubjectStatisticsMultiError, or nil if none found.
+func (m *SubjectStatistics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubjectStatistics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubjectId
+
+	// no validation rules for Code
+
+	// no validation rules for Name
+
+	// no validation rules for QualifiedTeachers
+
+	// no validation rules for AssignedTeachers
+
+	if len(errors) > 0 {
+		return SubjectStatisticsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectStatisticsMultiError is an error wrapping multiple validation errors
+// returned by SubjectStatistics.ValidateAll() if the designated constraints
+// aren't met.
+type SubjectStatisticsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectStatisticsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectStatisticsMultiError) AllErrors() []error { return m }
+
+// SubjectStatisticsValidationError is the validation error returned by
+// SubjectStatistics.Validate if the designated constraints aren't met.
+type SubjectStatisticsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectStatisticsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectStatisticsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectStatisticsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectStatisticsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectStatisticsValidationError) ErrorName() string {
+	return "SubjectStatisticsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubjectStatisticsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubjectStatistics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectStatisticsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectStatisticsValidationError{}
+
+// Validate checks the field values on ExecCount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExecCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExecCountMultiError, or nil
+// if none found.
+func (m *ExecCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for Status
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ExecCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecCountMultiError is an error wrapping multiple validation errors returned
+// by ExecCount.ValidateAll() if the designated constraints aren't met.
+type ExecCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecCountMultiError) AllErrors() []error { return m }
+
+// ExecCountValidationError is the validation error returned by
+// ExecCount.Validate if the designated constraints aren't met.
+type ExecCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecCountValidationError) ErrorName() string { return "ExecCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecCountValidationError{}
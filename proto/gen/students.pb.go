@@ -7,6 +7,7 @@
 package grpcapipb
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -67,6 +68,71 @@ func (Order) EnumDescriptor() ([]byte, []int) {
 	return file_students_proto_rawDescGZIP(), []int{0}
 }
 
+type MergeStudentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// survivor_id is the record that is kept
+	SurvivorId string `protobuf:"bytes,1,opt,name=survivor_id,json=survivorId,proto3" json:"survivor_id,omitempty"`
+	// duplicate_id is the record merged into the survivor and then
+	// soft-deleted
+	DuplicateId string `protobuf:"bytes,2,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+	// fields_from_duplicate lists the fields whose value is taken from the
+	// duplicate; every other field keeps the survivor's value
+	FieldsFromDuplicate []string `protobuf:"bytes,3,rep,name=fields_from_duplicate,json=fieldsFromDuplicate,proto3" json:"fields_from_duplicate,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MergeStudentsRequest) Reset() {
+	*x = MergeStudentsRequest{}
+	mi := &file_students_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeStudentsRequest) ProtoMessage() {}
+
+func (x *MergeStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_students_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeStudentsRequest.ProtoReflect.Descriptor instead.
+func (*MergeStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_students_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MergeStudentsRequest) GetSurvivorId() string {
+	if x != nil {
+		return x.SurvivorId
+	}
+	return ""
+}
+
+func (x *MergeStudentsRequest) GetDuplicateId() string {
+	if x != nil {
+		return x.DuplicateId
+	}
+	return ""
+}
+
+func (x *MergeStudentsRequest) GetFieldsFromDuplicate() []string {
+	if x != nil {
+		return x.FieldsFromDuplicate
+	}
+	return nil
+}
+
 type DeleteStudentsConfirmation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -77,7 +143,7 @@ type DeleteStudentsConfirmation struct {
 
 func (x *DeleteStudentsConfirmation) Reset() {
 	*x = DeleteStudentsConfirmation{}
-	mi := &file_students_proto_msgTypes[0]
+	mi := &file_students_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -89,7 +155,7 @@ func (x *DeleteStudentsConfirmation) String() string {
 func (*DeleteStudentsConfirmation) ProtoMessage() {}
 
 func (x *DeleteStudentsConfirmation) ProtoReflect() protoreflect.Message {
-	mi := &file_students_proto_msgTypes[0]
+	mi := &file_students_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -102,7 +168,7 @@ func (x *DeleteStudentsConfirmation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteStudentsConfirmation.ProtoReflect.Descriptor instead.
 func (*DeleteStudentsConfirmation) Descriptor() ([]byte, []int) {
-	return file_students_proto_rawDescGZIP(), []int{0}
+	return file_students_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *DeleteStudentsConfirmation) GetStatus() string {
@@ -128,7 +194,7 @@ type StudentIds struct {
 
 func (x *StudentIds) Reset() {
 	*x = StudentIds{}
-	mi := &file_students_proto_msgTypes[1]
+	mi := &file_students_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -140,7 +206,7 @@ func (x *StudentIds) String() string {
 func (*StudentIds) ProtoMessage() {}
 
 func (x *StudentIds) ProtoReflect() protoreflect.Message {
-	mi := &file_students_proto_msgTypes[1]
+	mi := &file_students_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -153,7 +219,7 @@ func (x *StudentIds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StudentIds.ProtoReflect.Descriptor instead.
 func (*StudentIds) Descriptor() ([]byte, []int) {
-	return file_students_proto_rawDescGZIP(), []int{1}
+	return file_students_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *StudentIds) GetIds() []string {
@@ -175,7 +241,7 @@ type GetStudentsRequest struct {
 
 func (x *GetStudentsRequest) Reset() {
 	*x = GetStudentsRequest{}
-	mi := &file_students_proto_msgTypes[2]
+	mi := &file_students_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -187,7 +253,7 @@ func (x *GetStudentsRequest) String() string {
 func (*GetStudentsRequest) ProtoMessage() {}
 
 func (x *GetStudentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_students_proto_msgTypes[2]
+	mi := &file_students_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +266,7 @@ func (x *GetStudentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentsRequest.ProtoReflect.Descriptor instead.
 func (*GetStudentsRequest) Descriptor() ([]byte, []int) {
-	return file_students_proto_rawDescGZIP(), []int{2}
+	return file_students_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetStudentsRequest) GetStudent() *Student {
@@ -241,7 +307,7 @@ type SortField struct {
 
 func (x *SortField) Reset() {
 	*x = SortField{}
-	mi := &file_students_proto_msgTypes[3]
+	mi := &file_students_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -253,7 +319,7 @@ func (x *SortField) String() string {
 func (*SortField) ProtoMessage() {}
 
 func (x *SortField) ProtoReflect() protoreflect.Message {
-	mi := &file_students_proto_msgTypes[3]
+	mi := &file_students_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -266,7 +332,7 @@ func (x *SortField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SortField.ProtoReflect.Descriptor instead.
 func (*SortField) Descriptor() ([]byte, []int) {
-	return file_students_proto_rawDescGZIP(), []int{3}
+	return file_students_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SortField) GetField() string {
@@ -284,19 +350,25 @@ func (x *SortField) GetOrder() Order {
 }
 
 type Student struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Class         string                 `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email     string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// class is the legacy free-text class name, kept for reference; use
+	// class_id
+	Class string `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
+	// date_of_birth is a calendar date, YYYY-MM-DD
+	DateOfBirth string `protobuf:"bytes,6,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	// class_id is the ID of the student's class in ClassesService
+	ClassId       string `protobuf:"bytes,7,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Student) Reset() {
 	*x = Student{}
-	mi := &file_students_proto_msgTypes[4]
+	mi := &file_students_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -308,7 +380,7 @@ func (x *Student) String() string {
 func (*Student) ProtoMessage() {}
 
 func (x *Student) ProtoReflect() protoreflect.Message {
-	mi := &file_students_proto_msgTypes[4]
+	mi := &file_students_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,7 +393,7 @@ func (x *Student) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Student.ProtoReflect.Descriptor instead.
 func (*Student) Descriptor() ([]byte, []int) {
-	return file_students_proto_rawDescGZIP(), []int{4}
+	return file_students_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Student) GetId() string {
@@ -359,6 +431,20 @@ func (x *Student) GetClass() string {
 	return ""
 }
 
+func (x *Student) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *Student) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
 type Students struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Students      []*Student             `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
@@ -368,7 +454,7 @@ type Students struct {
 
 func (x *Students) Reset() {
 	*x = Students{}
-	mi := &file_students_proto_msgTypes[5]
+	mi := &file_students_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -380,7 +466,7 @@ func (x *Students) String() string {
 func (*Students) ProtoMessage() {}
 
 func (x *Students) ProtoReflect() protoreflect.Message {
-	mi := &file_students_proto_msgTypes[5]
+	mi := &file_students_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +479,7 @@ func (x *Students) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Students.ProtoReflect.Descriptor instead.
 func (*Students) Descriptor() ([]byte, []int) {
-	return file_students_proto_rawDescGZIP(), []int{5}
+	return file_students_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Students) GetStudents() []*Student {
@@ -407,14 +493,20 @@ var File_students_proto protoreflect.FileDescriptor
 
 const file_students_proto_rawDesc = "" +
 	"\n" +
-	"\x0estudents.proto\x12\x04main\"U\n" +
+	"\x0estudents.proto\x12\x04main\x1a\x17validate/validate.proto\"\x96\x02\n" +
+	"\x14MergeStudentsRequest\x12=\n" +
+	"\vsurvivor_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\n" +
+	"survivorId\x12?\n" +
+	"\fduplicate_id\x18\x02 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\vduplicateId\x12~\n" +
+	"\x15fields_from_duplicate\x18\x03 \x03(\tBJ\xfaBG\x92\x01D\x18\x01\"@r>R\n" +
+	"first_nameR\tlast_nameR\x05emailR\x05classR\rdate_of_birthR\bclass_idR\x13fieldsFromDuplicate\"U\n" +
 	"\x1aDeleteStudentsConfirmation\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
 	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
-	"deletedIds\"\x1e\n" +
+	"deletedIds\"C\n" +
 	"\n" +
-	"StudentIds\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\tR\x03ids\"\xa5\x01\n" +
+	"StudentIds\x125\n" +
+	"\x03ids\x18\x01 \x03(\tB#\xfaB \x92\x01\x1d\b\x01\"\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x03ids\"\xa5\x01\n" +
 	"\x12GetStudentsRequest\x12'\n" +
 	"\astudent\x18\x01 \x01(\v2\r.main.StudentR\astudent\x12(\n" +
 	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\x12\x1f\n" +
@@ -423,24 +515,27 @@ const file_students_proto_rawDesc = "" +
 	"\tpage_size\x18\x04 \x01(\rR\bpageSize\"D\n" +
 	"\tSortField\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12!\n" +
-	"\x05order\x18\x02 \x01(\x0e2\v.main.OrderR\x05order\"\x81\x01\n" +
+	"\x05order\x18\x02 \x01(\x0e2\v.main.OrderR\x05order\"\x85\x02\n" +
 	"\aStudent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
 	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x14\n" +
 	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
-	"\x05class\x18\x05 \x01(\tR\x05class\"5\n" +
+	"\x05class\x18\x05 \x01(\tR\x05class\x12J\n" +
+	"\rdate_of_birth\x18\x06 \x01(\tB&\xfaB#r!2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$\xd0\x01\x01R\vdateOfBirth\x126\n" +
+	"\bclass_id\x18\a \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{24}$\xd0\x01\x01R\aclassId\"5\n" +
 	"\bStudents\x12)\n" +
 	"\bstudents\x18\x01 \x03(\v2\r.main.StudentR\bstudents*\x1a\n" +
 	"\x05Order\x12\a\n" +
 	"\x03ASC\x10\x00\x12\b\n" +
-	"\x04DESC\x10\x012\xf1\x01\n" +
+	"\x04DESC\x10\x012\xad\x02\n" +
 	"\x0fStudentsService\x127\n" +
 	"\vGetStudents\x12\x18.main.GetStudentsRequest\x1a\x0e.main.Students\x12-\n" +
 	"\vAddStudents\x12\x0e.main.Students\x1a\x0e.main.Students\x120\n" +
 	"\x0eUpdateStudents\x12\x0e.main.Students\x1a\x0e.main.Students\x12D\n" +
-	"\x0eDeleteStudents\x12\x10.main.StudentIds\x1a .main.DeleteStudentsConfirmationB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+	"\x0eDeleteStudents\x12\x10.main.StudentIds\x1a .main.DeleteStudentsConfirmation\x12:\n" +
+	"\rMergeStudents\x12\x1a.main.MergeStudentsRequest\x1a\r.main.StudentB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
 
 var (
 	file_students_proto_rawDescOnce sync.Once
@@ -455,31 +550,34 @@ func file_students_proto_rawDescGZIP() []byte {
 }
 
 var file_students_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_students_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_students_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_students_proto_goTypes = []any{
 	(Order)(0),                         // 0: main.Order
-	(*DeleteStudentsConfirmation)(nil), // 1: main.DeleteStudentsConfirmation
-	(*StudentIds)(nil),                 // 2: main.StudentIds
-	(*GetStudentsRequest)(nil),         // 3: main.GetStudentsRequest
-	(*SortField)(nil),                  // 4: main.SortField
-	(*Student)(nil),                    // 5: main.Student
-	(*Students)(nil),                   // 6: main.Students
+	(*MergeStudentsRequest)(nil),       // 1: main.MergeStudentsRequest
+	(*DeleteStudentsConfirmation)(nil), // 2: main.DeleteStudentsConfirmation
+	(*StudentIds)(nil),                 // 3: main.StudentIds
+	(*GetStudentsRequest)(nil),         // 4: main.GetStudentsRequest
+	(*SortField)(nil),                  // 5: main.SortField
+	(*Student)(nil),                    // 6: main.Student
+	(*Students)(nil),                   // 7: main.Students
 }
 var file_students_proto_depIdxs = []int32{
-	5, // 0: main.GetStudentsRequest.student:type_name -> main.Student
-	4, // 1: main.GetStudentsRequest.sort_by:type_name -> main.SortField
+	6, // 0: main.GetStudentsRequest.student:type_name -> main.Student
+	5, // 1: main.GetStudentsRequest.sort_by:type_name -> main.SortField
 	0, // 2: main.SortField.order:type_name -> main.Order
-	5, // 3: main.Students.students:type_name -> main.Student
-	3, // 4: main.StudentsService.GetStudents:input_type -> main.GetStudentsRequest
-	6, // 5: main.StudentsService.AddStudents:input_type -> main.Students
-	6, // 6: main.StudentsService.UpdateStudents:input_type -> main.Students
-	2, // 7: main.StudentsService.DeleteStudents:input_type -> main.StudentIds
-	6, // 8: main.StudentsService.GetStudents:output_type -> main.Students
-	6, // 9: main.StudentsService.AddStudents:output_type -> main.Students
-	6, // 10: main.StudentsService.UpdateStudents:output_type -> main.Students
-	1, // 11: main.StudentsService.DeleteStudents:output_type -> main.DeleteStudentsConfirmation
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
+	6, // 3: main.Students.students:type_name -> main.Student
+	4, // 4: main.StudentsService.GetStudents:input_type -> main.GetStudentsRequest
+	7, // 5: main.StudentsService.AddStudents:input_type -> main.Students
+	7, // 6: main.StudentsService.UpdateStudents:input_type -> main.Students
+	3, // 7: main.StudentsService.DeleteStudents:input_type -> main.StudentIds
+	1, // 8: main.StudentsService.MergeStudents:input_type -> main.MergeStudentsRequest
+	7, // 9: main.StudentsService.GetStudents:output_type -> main.Students
+	7, // 10: main.StudentsService.AddStudents:output_type -> main.Students
+	7, // 11: main.StudentsService.UpdateStudents:output_type -> main.Students
+	2, // 12: main.StudentsService.DeleteStudents:output_type -> main.DeleteStudentsConfirmation
+	6, // 13: main.StudentsService.MergeStudents:output_type -> main.Student
+	9, // [9:14] is the sub-list for method output_type
+	4, // [4:9] is the sub-list for method input_type
 	4, // [4:4] is the sub-list for extension type_name
 	4, // [4:4] is the sub-list for extension extendee
 	0, // [0:4] is the sub-list for field type_name
@@ -496,7 +594,7 @@ func file_students_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_students_proto_rawDesc), len(file_students_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
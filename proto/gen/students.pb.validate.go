@@ -35,6 +35,198 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on MergeStudentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeStudentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeStudentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeStudentsRequestMultiError, or nil if none found.
+func (m *MergeStudentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeStudentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSurvivorId()) != 24 {
+		err := MergeStudentsRequestValidationError{
+			field:  "SurvivorId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_MergeStudentsRequest_SurvivorId_Pattern.MatchString(m.GetSurvivorId()) {
+		err := MergeStudentsRequestValidationError{
+			field:  "SurvivorId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDuplicateId()) != 24 {
+		err := MergeStudentsRequestValidationError{
+			field:  "DuplicateId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_MergeStudentsRequest_DuplicateId_Pattern.MatchString(m.GetDuplicateId()) {
+		err := MergeStudentsRequestValidationError{
+			field:  "DuplicateId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_MergeStudentsRequest_FieldsFromDuplicate_Unique := make(map[string]struct{}, len(m.GetFieldsFromDuplicate()))
+
+	for idx, item := range m.GetFieldsFromDuplicate() {
+		_, _ = idx, item
+
+		if _, exists := _MergeStudentsRequest_FieldsFromDuplicate_Unique[item]; exists {
+			err := MergeStudentsRequestValidationError{
+				field:  fmt.Sprintf("FieldsFromDuplicate[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_MergeStudentsRequest_FieldsFromDuplicate_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _MergeStudentsRequest_FieldsFromDuplicate_InLookup[item]; !ok {
+			err := MergeStudentsRequestValidationError{
+				field:  fmt.Sprintf("FieldsFromDuplicate[%v]", idx),
+				reason: "value must be in list [first_name last_name email class date_of_birth class_id]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MergeStudentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeStudentsRequestMultiError is an error wrapping multiple validation
+// errors returned by MergeStudentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MergeStudentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeStudentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeStudentsRequestMultiError) AllErrors() []error { return m }
+
+// MergeStudentsRequestValidationError is the validation error returned by
+// MergeStudentsRequest.Validate if the designated constraints aren't met.
+type MergeStudentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeStudentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeStudentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeStudentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeStudentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeStudentsRequestValidationError) ErrorName() string {
+	return "MergeStudentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeStudentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeStudentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeStudentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeStudentsRequestValidationError{}
+
+var _MergeStudentsRequest_SurvivorId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _MergeStudentsRequest_DuplicateId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _MergeStudentsRequest_FieldsFromDuplicate_InLookup = map[string]struct{}{
+	"first_name":    {},
+	"last_name":     {},
+	"email":         {},
+	"class":         {},
+	"date_of_birth": {},
+	"class_id":      {},
+}
+
 // Validate checks the field values on DeleteStudentsConfirmation with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -161,6 +353,45 @@ func (m *StudentIds) validate(all bool) error {
 
 	var errors []error
 
+	if len(m.GetIds()) < 1 {
+		err := StudentIdsValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) != 24 {
+			err := StudentIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value length must be 24 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_StudentIds_Ids_Pattern.MatchString(item) {
+			err := StudentIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return StudentIdsMultiError(errors)
 	}
@@ -238,6 +469,8 @@ var _ interface {
 	ErrorName() string
 } = StudentIdsValidationError{}
 
+var _StudentIds_Ids_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
 // Validate checks the field values on GetStudentsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -541,6 +774,36 @@ func (m *Student) validate(all bool) error {
 
 	// no validation rules for Class
 
+	if m.GetDateOfBirth() != "" {
+
+		if !_Student_DateOfBirth_Pattern.MatchString(m.GetDateOfBirth()) {
+			err := StudentValidationError{
+				field:  "DateOfBirth",
+				reason: "value does not match regex pattern \"^[0-9]{4}-[0-9]{2}-[0-9]{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetClassId() != "" {
+
+		if !_Student_ClassId_Pattern.MatchString(m.GetClassId()) {
+			err := StudentValidationError{
+				field:  "ClassId",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return StudentMultiError(errors)
 	}
@@ -618,6 +881,10 @@ var _ interface {
 	ErrorName() string
 } = StudentValidationError{}
 
+var _Student_DateOfBirth_Pattern = regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}$")
+
+var _Student_ClassId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
 // Validate checks the field values on Students with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -23,6 +23,7 @@ const (
 	StudentsService_AddStudents_FullMethodName    = "/main.StudentsService/AddStudents"
 	StudentsService_UpdateStudents_FullMethodName = "/main.StudentsService/UpdateStudents"
 	StudentsService_DeleteStudents_FullMethodName = "/main.StudentsService/DeleteStudents"
+	StudentsService_MergeStudents_FullMethodName  = "/main.StudentsService/MergeStudents"
 )
 
 // StudentsServiceClient is the client API for StudentsService service.
@@ -33,6 +34,9 @@ type StudentsServiceClient interface {
 	AddStudents(ctx context.Context, in *Students, opts ...grpc.CallOption) (*Students, error)
 	UpdateStudents(ctx context.Context, in *Students, opts ...grpc.CallOption) (*Students, error)
 	DeleteStudents(ctx context.Context, in *StudentIds, opts ...grpc.CallOption) (*DeleteStudentsConfirmation, error)
+	// MergeStudents folds a duplicate record into a surviving one and
+	// returns the survivor as stored after the merge.
+	MergeStudents(ctx context.Context, in *MergeStudentsRequest, opts ...grpc.CallOption) (*Student, error)
 }
 
 type studentsServiceClient struct {
@@ -83,6 +87,16 @@ func (c *studentsServiceClient) DeleteStudents(ctx context.Context, in *StudentI
 	return out, nil
 }
 
+func (c *studentsServiceClient) MergeStudents(ctx context.Context, in *MergeStudentsRequest, opts ...grpc.CallOption) (*Student, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Student)
+	err := c.cc.Invoke(ctx, StudentsService_MergeStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StudentsServiceServer is the server API for StudentsService service.
 // All implementations must embed UnimplementedStudentsServiceServer
 // for forward compatibility.
@@ -91,6 +105,9 @@ type StudentsServiceServer interface {
 	AddStudents(context.Context, *Students) (*Students, error)
 	UpdateStudents(context.Context, *Students) (*Students, error)
 	DeleteStudents(context.Context, *StudentIds) (*DeleteStudentsConfirmation, error)
+	// MergeStudents folds a duplicate record into a surviving one and
+	// returns the survivor as stored after the merge.
+	MergeStudents(context.Context, *MergeStudentsRequest) (*Student, error)
 	mustEmbedUnimplementedStudentsServiceServer()
 }
 
@@ -113,6 +130,9 @@ func (UnimplementedStudentsServiceServer) UpdateStudents(context.Context, *Stude
 func (UnimplementedStudentsServiceServer) DeleteStudents(context.Context, *StudentIds) (*DeleteStudentsConfirmation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStudents not implemented")
 }
+func (UnimplementedStudentsServiceServer) MergeStudents(context.Context, *MergeStudentsRequest) (*Student, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeStudents not implemented")
+}
 func (UnimplementedStudentsServiceServer) mustEmbedUnimplementedStudentsServiceServer() {}
 func (UnimplementedStudentsServiceServer) testEmbeddedByValue()                         {}
 
@@ -206,6 +226,24 @@ func _StudentsService_DeleteStudents_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudentsService_MergeStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentsServiceServer).MergeStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentsService_MergeStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentsServiceServer).MergeStudents(ctx, req.(*MergeStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StudentsService_ServiceDesc is the grpc.ServiceDesc for StudentsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +267,10 @@ var StudentsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStudents",
 			Handler:    _StudentsService_DeleteStudents_Handler,
 		},
+		{
+			MethodName: "MergeStudents",
+			Handler:    _StudentsService_MergeStudents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "students.proto",
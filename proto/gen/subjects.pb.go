@@ -0,0 +1,597 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.1
+// source: subjects.proto
+
+package grpcapipb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteSubjectsConfirmation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DeletedIds    []string               `protobuf:"bytes,2,rep,name=deleted_ids,json=deletedIds,proto3" json:"deleted_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubjectsConfirmation) Reset() {
+	*x = DeleteSubjectsConfirmation{}
+	mi := &file_subjects_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubjectsConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubjectsConfirmation) ProtoMessage() {}
+
+func (x *DeleteSubjectsConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_subjects_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubjectsConfirmation.ProtoReflect.Descriptor instead.
+func (*DeleteSubjectsConfirmation) Descriptor() ([]byte, []int) {
+	return file_subjects_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteSubjectsConfirmation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeleteSubjectsConfirmation) GetDeletedIds() []string {
+	if x != nil {
+		return x.DeletedIds
+	}
+	return nil
+}
+
+type SubjectIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubjectIds) Reset() {
+	*x = SubjectIds{}
+	mi := &file_subjects_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubjectIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectIds) ProtoMessage() {}
+
+func (x *SubjectIds) ProtoReflect() protoreflect.Message {
+	mi := &file_subjects_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubjectIds.ProtoReflect.Descriptor instead.
+func (*SubjectIds) Descriptor() ([]byte, []int) {
+	return file_subjects_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubjectIds) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetSubjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subject filters on every field set; grade_levels matches subjects
+	// offered in all the listed grades
+	Subject       *Subject     `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	SortBy        []*SortField `protobuf:"bytes,2,rep,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubjectsRequest) Reset() {
+	*x = GetSubjectsRequest{}
+	mi := &file_subjects_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubjectsRequest) ProtoMessage() {}
+
+func (x *GetSubjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subjects_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubjectsRequest.ProtoReflect.Descriptor instead.
+func (*GetSubjectsRequest) Descriptor() ([]byte, []int) {
+	return file_subjects_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSubjectsRequest) GetSubject() *Subject {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *GetSubjectsRequest) GetSortBy() []*SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return nil
+}
+
+type Subject struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// code is unique and stored upper case, e.g. "MATH-9"
+	Code       string  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name       string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Department string  `protobuf:"bytes,4,opt,name=department,proto3" json:"department,omitempty"`
+	Credits    float32 `protobuf:"fixed32,5,opt,name=credits,proto3" json:"credits,omitempty"`
+	// grade_levels lists the grades the subject is offered in
+	GradeLevels   []int32 `protobuf:"varint,6,rep,packed,name=grade_levels,json=gradeLevels,proto3" json:"grade_levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subject) Reset() {
+	*x = Subject{}
+	mi := &file_subjects_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subject) ProtoMessage() {}
+
+func (x *Subject) ProtoReflect() protoreflect.Message {
+	mi := &file_subjects_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subject.ProtoReflect.Descriptor instead.
+func (*Subject) Descriptor() ([]byte, []int) {
+	return file_subjects_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Subject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subject) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Subject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Subject) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *Subject) GetCredits() float32 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *Subject) GetGradeLevels() []int32 {
+	if x != nil {
+		return x.GradeLevels
+	}
+	return nil
+}
+
+type Subjects struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subjects      []*Subject             `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subjects) Reset() {
+	*x = Subjects{}
+	mi := &file_subjects_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subjects) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subjects) ProtoMessage() {}
+
+func (x *Subjects) ProtoReflect() protoreflect.Message {
+	mi := &file_subjects_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subjects.ProtoReflect.Descriptor instead.
+func (*Subjects) Descriptor() ([]byte, []int) {
+	return file_subjects_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Subjects) GetSubjects() []*Subject {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+type GetCurriculumRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GradeLevel    int32                  `protobuf:"varint,1,opt,name=grade_level,json=gradeLevel,proto3" json:"grade_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurriculumRequest) Reset() {
+	*x = GetCurriculumRequest{}
+	mi := &file_subjects_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurriculumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurriculumRequest) ProtoMessage() {}
+
+func (x *GetCurriculumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subjects_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurriculumRequest.ProtoReflect.Descriptor instead.
+func (*GetCurriculumRequest) Descriptor() ([]byte, []int) {
+	return file_subjects_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCurriculumRequest) GetGradeLevel() int32 {
+	if x != nil {
+		return x.GradeLevel
+	}
+	return 0
+}
+
+// Curriculum is the set of subjects taught in a grade level. Every subject
+// must be offered in that grade.
+type Curriculum struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	GradeLevel int32                  `protobuf:"varint,1,opt,name=grade_level,json=gradeLevel,proto3" json:"grade_level,omitempty"`
+	SubjectIds []string               `protobuf:"bytes,2,rep,name=subject_ids,json=subjectIds,proto3" json:"subject_ids,omitempty"`
+	// subjects holds the full records of subject_ids in responses and is
+	// ignored in requests
+	Subjects      []*Subject `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Curriculum) Reset() {
+	*x = Curriculum{}
+	mi := &file_subjects_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Curriculum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Curriculum) ProtoMessage() {}
+
+func (x *Curriculum) ProtoReflect() protoreflect.Message {
+	mi := &file_subjects_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Curriculum.ProtoReflect.Descriptor instead.
+func (*Curriculum) Descriptor() ([]byte, []int) {
+	return file_subjects_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Curriculum) GetGradeLevel() int32 {
+	if x != nil {
+		return x.GradeLevel
+	}
+	return 0
+}
+
+func (x *Curriculum) GetSubjectIds() []string {
+	if x != nil {
+		return x.SubjectIds
+	}
+	return nil
+}
+
+func (x *Curriculum) GetSubjects() []*Subject {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+type TeacherQualifications struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacher_id,json=teacherId,proto3" json:"teacher_id,omitempty"`
+	SubjectIds    []string               `protobuf:"bytes,2,rep,name=subject_ids,json=subjectIds,proto3" json:"subject_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeacherQualifications) Reset() {
+	*x = TeacherQualifications{}
+	mi := &file_subjects_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeacherQualifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeacherQualifications) ProtoMessage() {}
+
+func (x *TeacherQualifications) ProtoReflect() protoreflect.Message {
+	mi := &file_subjects_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeacherQualifications.ProtoReflect.Descriptor instead.
+func (*TeacherQualifications) Descriptor() ([]byte, []int) {
+	return file_subjects_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TeacherQualifications) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *TeacherQualifications) GetSubjectIds() []string {
+	if x != nil {
+		return x.SubjectIds
+	}
+	return nil
+}
+
+var File_subjects_proto protoreflect.FileDescriptor
+
+const file_subjects_proto_rawDesc = "" +
+	"\n" +
+	"\x0esubjects.proto\x12\x04main\x1a\x17validate/validate.proto\x1a\x0estudents.proto\x1a\n" +
+	"main.proto\"U\n" +
+	"\x1aDeleteSubjectsConfirmation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1f\n" +
+	"\vdeleted_ids\x18\x02 \x03(\tR\n" +
+	"deletedIds\"C\n" +
+	"\n" +
+	"SubjectIds\x125\n" +
+	"\x03ids\x18\x01 \x03(\tB#\xfaB \x92\x01\x1d\b\x01\"\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\x03ids\"g\n" +
+	"\x12GetSubjectsRequest\x12'\n" +
+	"\asubject\x18\x01 \x01(\v2\r.main.SubjectR\asubject\x12(\n" +
+	"\asort_by\x18\x02 \x03(\v2\x0f.main.SortFieldR\x06sortBy\"\x9a\x02\n" +
+	"\aSubject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
+	"\x04code\x18\x02 \x01(\tB\x1e\xfaB\x1br\x192\x14^[A-Za-z0-9-]{2,12}$\xd0\x01\x01R\x04code\x121\n" +
+	"\x04name\x18\x03 \x01(\tB\x1d\xfaB\x1ar\x18\x18@2\x14^[A-Za-z0-9 &,.'-]*$R\x04name\x12=\n" +
+	"\n" +
+	"department\x18\x04 \x01(\tB\x1d\xfaB\x1ar\x18\x18@2\x14^[A-Za-z0-9 &,.'-]*$R\n" +
+	"department\x12$\n" +
+	"\acredits\x18\x05 \x01(\x02B\n" +
+	"\xfaB\a\n" +
+	"\x05-\x00\x00\x00\x00R\acredits\x123\n" +
+	"\fgrade_levels\x18\x06 \x03(\x05B\x10\xfaB\r\x92\x01\n" +
+	"\x18\x01\"\x06\x1a\x04\x18\f(\x01R\vgradeLevels\"5\n" +
+	"\bSubjects\x12)\n" +
+	"\bsubjects\x18\x01 \x03(\v2\r.main.SubjectR\bsubjects\"B\n" +
+	"\x14GetCurriculumRequest\x12*\n" +
+	"\vgrade_level\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x01R\n" +
+	"gradeLevel\"\xa9\x01\n" +
+	"\n" +
+	"Curriculum\x12*\n" +
+	"\vgrade_level\x18\x01 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x01R\n" +
+	"gradeLevel\x12D\n" +
+	"\vsubject_ids\x18\x02 \x03(\tB#\xfaB \x92\x01\x1d\x18\x01\"\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\n" +
+	"subjectIds\x12)\n" +
+	"\bsubjects\x18\x03 \x03(\v2\r.main.SubjectR\bsubjects\"\x9a\x01\n" +
+	"\x15TeacherQualifications\x12;\n" +
+	"\n" +
+	"teacher_id\x18\x01 \x01(\tB\x1c\xfaB\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\tteacherId\x12D\n" +
+	"\vsubject_ids\x18\x02 \x03(\tB#\xfaB \x92\x01\x1d\x18\x01\"\x19r\x17\x10\x18\x18\x182\x11^[a-fA-F0-9]{24}$R\n" +
+	"subjectIds2\x85\x04\n" +
+	"\x0fSubjectsService\x127\n" +
+	"\vGetSubjects\x12\x18.main.GetSubjectsRequest\x1a\x0e.main.Subjects\x12-\n" +
+	"\vAddSubjects\x12\x0e.main.Subjects\x1a\x0e.main.Subjects\x120\n" +
+	"\x0eUpdateSubjects\x12\x0e.main.Subjects\x1a\x0e.main.Subjects\x12D\n" +
+	"\x0eDeleteSubjects\x12\x10.main.SubjectIds\x1a .main.DeleteSubjectsConfirmation\x12=\n" +
+	"\rGetCurriculum\x12\x1a.main.GetCurriculumRequest\x1a\x10.main.Curriculum\x123\n" +
+	"\rSetCurriculum\x12\x10.main.Curriculum\x1a\x10.main.Curriculum\x12H\n" +
+	"\x18GetTeacherQualifications\x12\x0f.main.TeacherId\x1a\x1b.main.TeacherQualifications\x12T\n" +
+	"\x18SetTeacherQualifications\x12\x1b.main.TeacherQualifications\x1a\x1b.main.TeacherQualificationsB\x16Z\x14/proto/gen;grpcapipbb\x06proto3"
+
+var (
+	file_subjects_proto_rawDescOnce sync.Once
+	file_subjects_proto_rawDescData []byte
+)
+
+func file_subjects_proto_rawDescGZIP() []byte {
+	file_subjects_proto_rawDescOnce.Do(func() {
+		file_subjects_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_subjects_proto_rawDesc), len(file_subjects_proto_rawDesc)))
+	})
+	return file_subjects_proto_rawDescData
+}
+
+var file_subjects_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_subjects_proto_goTypes = []any{
+	(*DeleteSubjectsConfirmation)(nil), // 0: main.DeleteSubjectsConfirmation
+	(*SubjectIds)(nil),                 // 1: main.SubjectIds
+	(*GetSubjectsRequest)(nil),         // 2: main.GetSubjectsRequest
+	(*Subject)(nil),                    // 3: main.Subject
+	(*Subjects)(nil),                   // 4: main.Subjects
+	(*GetCurriculumRequest)(nil),       // 5: main.GetCurriculumRequest
+	(*Curriculum)(nil),                 // 6: main.Curriculum
+	(*TeacherQualifications)(nil),      // 7: main.TeacherQualifications
+	(*SortField)(nil),                  // 8: main.SortField
+	(*TeacherId)(nil),                  // 9: main.TeacherId
+}
+var file_subjects_proto_depIdxs = []int32{
+	3,  // 0: main.GetSubjectsRequest.subject:type_name -> main.Subject
+	8,  // 1: main.GetSubjectsRequest.sort_by:type_name -> main.SortField
+	3,  // 2: main.Subjects.subjects:type_name -> main.Subject
+	3,  // 3: main.Curriculum.subjects:type_name -> main.Subject
+	2,  // 4: main.SubjectsService.GetSubjects:input_type -> main.GetSubjectsRequest
+	4,  // 5: main.SubjectsService.AddSubjects:input_type -> main.Subjects
+	4,  // 6: main.SubjectsService.UpdateSubjects:input_type -> main.Subjects
+	1,  // 7: main.SubjectsService.DeleteSubjects:input_type -> main.SubjectIds
+	5,  // 8: main.SubjectsService.GetCurriculum:input_type -> main.GetCurriculumRequest
+	6,  // 9: main.SubjectsService.SetCurriculum:input_type -> main.Curriculum
+	9,  // 10: main.SubjectsService.GetTeacherQualifications:input_type -> main.TeacherId
+	7,  // 11: main.SubjectsService.SetTeacherQualifications:input_type -> main.TeacherQualifications
+	4,  // 12: main.SubjectsService.GetSubjects:output_type -> main.Subjects
+	4,  // 13: main.SubjectsService.AddSubjects:output_type -> main.Subjects
+	4,  // 14: main.SubjectsService.UpdateSubjects:output_type -> main.Subjects
+	0,  // 15: main.SubjectsService.DeleteSubjects:output_type -> main.DeleteSubjectsConfirmation
+	6,  // 16: main.SubjectsService.GetCurriculum:output_type -> main.Curriculum
+	6,  // 17: main.SubjectsService.SetCurriculum:output_type -> main.Curriculum
+	7,  // 18: main.SubjectsService.GetTeacherQualifications:output_type -> main.TeacherQualifications
+	7,  // 19: main.SubjectsService.SetTeacherQualifications:output_type -> main.TeacherQualifications
+	12, // [12:20] is the sub-list for method output_type
+	4,  // [4:12] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_subjects_proto_init() }
+func file_subjects_proto_init() {
+	if File_subjects_proto != nil {
+		return
+	}
+	file_students_proto_init()
+	file_main_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subjects_proto_rawDesc), len(file_subjects_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_subjects_proto_goTypes,
+		DependencyIndexes: file_subjects_proto_depIdxs,
+		MessageInfos:      file_subjects_proto_msgTypes,
+	}.Build()
+	File_subjects_proto = out.File
+	file_subjects_proto_goTypes = nil
+	file_subjects_proto_depIdxs = nil
+}
@@ -0,0 +1,1259 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: subjects.proto
+
+package grpcapipb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DeleteSubjectsConfirmation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSubjectsConfirmation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSubjectsConfirmation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSubjectsConfirmationMultiError, or nil if none found.
+func (m *DeleteSubjectsConfirmation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSubjectsConfirmation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return DeleteSubjectsConfirmationMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSubjectsConfirmationMultiError is an error wrapping multiple
+// validation errors returned by DeleteSubjectsConfirmation.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteSubjectsConfirmationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSubjectsConfirmationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSubjectsConfirmationMultiError) AllErrors() []error { return m }
+
+// DeleteSubjectsConfirmationValidationError is the validation error returned
+// by DeleteSubjectsConfirmation.Validate if the designated constraints aren't met.
+type DeleteSubjectsConfirmationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSubjectsConfirmationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSubjectsConfirmationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSubjectsConfirmationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSubjectsConfirmationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSubjectsConfirmationValidationError) ErrorName() string {
+	return "DeleteSubjectsConfirmationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSubjectsConfirmationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSubjectsConfirmation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSubjectsConfirmationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSubjectsConfirmationValidationError{}
+
+// Validate checks the field values on SubjectIds with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubjectIds) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubjectIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubjectIdsMultiError, or
+// nil if none found.
+func (m *SubjectIds) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubjectIds) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) < 1 {
+		err := SubjectIdsValidationError{
+			field:  "Ids",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) != 24 {
+			err := SubjectIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value length must be 24 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_SubjectIds_Ids_Pattern.MatchString(item) {
+			err := SubjectIdsValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubjectIdsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectIdsMultiError is an error wrapping multiple validation errors
+// returned by SubjectIds.ValidateAll() if the designated constraints aren't met.
+type SubjectIdsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectIdsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectIdsMultiError) AllErrors() []error { return m }
+
+// SubjectIdsValidationError is the validation error returned by
+// SubjectIds.Validate if the designated constraints aren't met.
+type SubjectIdsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectIdsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectIdsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectIdsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectIdsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectIdsValidationError) ErrorName() string { return "SubjectIdsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubjectIdsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubjectIds.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectIdsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectIdsValidationError{}
+
+var _SubjectIds_Ids_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on GetSubjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSubjectsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSubjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSubjectsRequestMultiError, or nil if none found.
+func (m *GetSubjectsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSubjectsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSubjectsRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSubjectsRequestValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSubjectsRequestValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSortBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSubjectsRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSubjectsRequestValidationError{
+						field:  fmt.Sprintf("SortBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSubjectsRequestValidationError{
+					field:  fmt.Sprintf("SortBy[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSubjectsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSubjectsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetSubjectsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetSubjectsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSubjectsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSubjectsRequestMultiError) AllErrors() []error { return m }
+
+// GetSubjectsRequestValidationError is the validation error returned by
+// GetSubjectsRequest.Validate if the designated constraints aren't met.
+type GetSubjectsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSubjectsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSubjectsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSubjectsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSubjectsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSubjectsRequestValidationError) ErrorName() string {
+	return "GetSubjectsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSubjectsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSubjectsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSubjectsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSubjectsRequestValidationError{}
+
+// Validate checks the field values on Subject with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Subject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Subject with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SubjectMultiError, or nil if none found.
+func (m *Subject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Subject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetCode() != "" {
+
+		if !_Subject_Code_Pattern.MatchString(m.GetCode()) {
+			err := SubjectValidationError{
+				field:  "Code",
+				reason: "value does not match regex pattern \"^[A-Za-z0-9-]{2,12}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := SubjectValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Subject_Name_Pattern.MatchString(m.GetName()) {
+		err := SubjectValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 &,.'-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDepartment()) > 64 {
+		err := SubjectValidationError{
+			field:  "Department",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Subject_Department_Pattern.MatchString(m.GetDepartment()) {
+		err := SubjectValidationError{
+			field:  "Department",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9 &,.'-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCredits() < 0 {
+		err := SubjectValidationError{
+			field:  "Credits",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_Subject_GradeLevels_Unique := make(map[int32]struct{}, len(m.GetGradeLevels()))
+
+	for idx, item := range m.GetGradeLevels() {
+		_, _ = idx, item
+
+		if _, exists := _Subject_GradeLevels_Unique[item]; exists {
+			err := SubjectValidationError{
+				field:  fmt.Sprintf("GradeLevels[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_Subject_GradeLevels_Unique[item] = struct{}{}
+		}
+
+		if val := item; val < 1 || val > 12 {
+			err := SubjectValidationError{
+				field:  fmt.Sprintf("GradeLevels[%v]", idx),
+				reason: "value must be inside range [1, 12]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectMultiError is an error wrapping multiple validation errors returned
+// by Subject.ValidateAll() if the designated constraints aren't met.
+type SubjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectMultiError) AllErrors() []error { return m }
+
+// SubjectValidationError is the validation error returned by Subject.Validate
+// if the designated constraints aren't met.
+type SubjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectValidationError) ErrorName() string { return "SubjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectValidationError{}
+
+var _Subject_Code_Pattern = regexp.MustCompile("^[A-Za-z0-9-]{2,12}$")
+
+var _Subject_Name_Pattern = regexp.MustCompile("^[A-Za-z0-9 &,.'-]*$")
+
+var _Subject_Department_Pattern = regexp.MustCompile("^[A-Za-z0-9 &,.'-]*$")
+
+// Validate checks the field values on Subjects with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Subjects) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Subjects with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubjectsMultiError, or nil
+// if none found.
+func (m *Subjects) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Subjects) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSubjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubjectsValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubjectsValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubjectsValidationError{
+					field:  fmt.Sprintf("Subjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubjectsMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubjectsMultiError is an error wrapping multiple validation errors returned
+// by Subjects.ValidateAll() if the designated constraints aren't met.
+type SubjectsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubjectsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubjectsMultiError) AllErrors() []error { return m }
+
+// SubjectsValidationError is the validation error returned by
+// Subjects.Validate if the designated constraints aren't met.
+type SubjectsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubjectsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubjectsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubjectsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubjectsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubjectsValidationError) ErrorName() string { return "SubjectsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubjectsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubjects.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubjectsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubjectsValidationError{}
+
+// Validate checks the field values on GetCurriculumRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCurriculumRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCurriculumRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCurriculumRequestMultiError, or nil if none found.
+func (m *GetCurriculumRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCurriculumRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetGradeLevel(); val < 1 || val > 12 {
+		err := GetCurriculumRequestValidationError{
+			field:  "GradeLevel",
+			reason: "value must be inside range [1, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCurriculumRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCurriculumRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCurriculumRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurriculumRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCurriculumRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCurriculumRequestMultiError) AllErrors() []error { return m }
+
+// GetCurriculumRequestValidationError is the validation error returned by
+// GetCurriculumRequest.Validate if the designated constraints aren't met.
+type GetCurriculumRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCurriculumRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCurriculumRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCurriculumRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCurriculumRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCurriculumRequestValidationError) ErrorName() string {
+	return "GetCurriculumRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCurriculumRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCurriculumRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCurriculumRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCurriculumRequestValidationError{}
+
+// Validate checks the field values on Curriculum with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Curriculum) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Curriculum with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CurriculumMultiError, or
+// nil if none found.
+func (m *Curriculum) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Curriculum) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetGradeLevel(); val < 1 || val > 12 {
+		err := CurriculumValidationError{
+			field:  "GradeLevel",
+			reason: "value must be inside range [1, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_Curriculum_SubjectIds_Unique := make(map[string]struct{}, len(m.GetSubjectIds()))
+
+	for idx, item := range m.GetSubjectIds() {
+		_, _ = idx, item
+
+		if _, exists := _Curriculum_SubjectIds_Unique[item]; exists {
+			err := CurriculumValidationError{
+				field:  fmt.Sprintf("SubjectIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_Curriculum_SubjectIds_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) != 24 {
+			err := CurriculumValidationError{
+				field:  fmt.Sprintf("SubjectIds[%v]", idx),
+				reason: "value length must be 24 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_Curriculum_SubjectIds_Pattern.MatchString(item) {
+			err := CurriculumValidationError{
+				field:  fmt.Sprintf("SubjectIds[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetSubjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CurriculumValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CurriculumValidationError{
+						field:  fmt.Sprintf("Subjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CurriculumValidationError{
+					field:  fmt.Sprintf("Subjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CurriculumMultiError(errors)
+	}
+
+	return nil
+}
+
+// CurriculumMultiError is an error wrapping multiple validation errors
+// returned by Curriculum.ValidateAll() if the designated constraints aren't met.
+type CurriculumMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CurriculumMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CurriculumMultiError) AllErrors() []error { return m }
+
+// CurriculumValidationError is the validation error returned by
+// Curriculum.Validate if the designated constraints aren't met.
+type CurriculumValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CurriculumValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CurriculumValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CurriculumValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CurriculumValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CurriculumValidationError) ErrorName() string { return "CurriculumValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CurriculumValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCurriculum.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CurriculumValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CurriculumValidationError{}
+
+var _Curriculum_SubjectIds_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+// Validate checks the field values on TeacherQualifications with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TeacherQualifications) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeacherQualifications with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TeacherQualificationsMultiError, or nil if none found.
+func (m *TeacherQualifications) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeacherQualifications) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTeacherId()) != 24 {
+		err := TeacherQualificationsValidationError{
+			field:  "TeacherId",
+			reason: "value length must be 24 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if !_TeacherQualifications_TeacherId_Pattern.MatchString(m.GetTeacherId()) {
+		err := TeacherQualificationsValidationError{
+			field:  "TeacherId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_TeacherQualifications_SubjectIds_Unique := make(map[string]struct{}, len(m.GetSubjectIds()))
+
+	for idx, item := range m.GetSubjectIds() {
+		_, _ = idx, item
+
+		if _, exists := _TeacherQualifications_SubjectIds_Unique[item]; exists {
+			err := TeacherQualificationsValidationError{
+				field:  fmt.Sprintf("SubjectIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_TeacherQualifications_SubjectIds_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) != 24 {
+			err := TeacherQualificationsValidationError{
+				field:  fmt.Sprintf("SubjectIds[%v]", idx),
+				reason: "value length must be 24 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+		if !_TeacherQualifications_SubjectIds_Pattern.MatchString(item) {
+			err := TeacherQualificationsValidationError{
+				field:  fmt.Sprintf("SubjectIds[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TeacherQualificationsMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeacherQualificationsMultiError is an error wrapping multiple validation
+// errors returned by TeacherQualifications.ValidateAll() if the designated
+// constraints aren't met.
+type TeacherQualificationsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeacherQualificationsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeacherQualificationsMultiError) AllErrors() []error { return m }
+
+// TeacherQualificationsValidationError is the validation error returned by
+// TeacherQualifications.Validate if the designated constraints aren't met.
+type TeacherQualificationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeacherQualificationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeacherQualificationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeacherQualificationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeacherQualificationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeacherQualificationsValidationError) ErrorName() string {
+	return "TeacherQualificationsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TeacherQualificationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeacherQualifications.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeacherQualificationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeacherQualificationsValidationError{}
+
+var _TeacherQualifications_TeacherId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
+
+var _TeacherQualifications_SubjectIds_Pattern = regexp.MustCompile("^[a-fA-F0-9]{24}$")
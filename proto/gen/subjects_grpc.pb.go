@@ -0,0 +1,397 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: subjects.proto
+
+package grpcapipb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SubjectsService_GetSubjects_FullMethodName              = "/main.SubjectsService/GetSubjects"
+	SubjectsService_AddSubjects_FullMethodName              = "/main.SubjectsService/AddSubjects"
+	SubjectsService_UpdateSubjects_FullMethodName           = "/main.SubjectsService/UpdateSubjects"
+	SubjectsService_DeleteSubjects_FullMethodName           = "/main.SubjectsService/DeleteSubjects"
+	SubjectsService_GetCurriculum_FullMethodName            = "/main.SubjectsService/GetCurriculum"
+	SubjectsService_SetCurriculum_FullMethodName            = "/main.SubjectsService/SetCurriculum"
+	SubjectsService_GetTeacherQualifications_FullMethodName = "/main.SubjectsService/GetTeacherQualifications"
+	SubjectsService_SetTeacherQualifications_FullMethodName = "/main.SubjectsService/SetTeacherQualifications"
+)
+
+// SubjectsServiceClient is the client API for SubjectsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SubjectsServiceClient interface {
+	GetSubjects(ctx context.Context, in *GetSubjectsRequest, opts ...grpc.CallOption) (*Subjects, error)
+	AddSubjects(ctx context.Context, in *Subjects, opts ...grpc.CallOption) (*Subjects, error)
+	UpdateSubjects(ctx context.Context, in *Subjects, opts ...grpc.CallOption) (*Subjects, error)
+	// DeleteSubjects refuses subjects still used by a curriculum, a teacher
+	// qualification or a teaching assignment.
+	DeleteSubjects(ctx context.Context, in *SubjectIds, opts ...grpc.CallOption) (*DeleteSubjectsConfirmation, error)
+	GetCurriculum(ctx context.Context, in *GetCurriculumRequest, opts ...grpc.CallOption) (*Curriculum, error)
+	// SetCurriculum replaces the subject list of a grade level.
+	SetCurriculum(ctx context.Context, in *Curriculum, opts ...grpc.CallOption) (*Curriculum, error)
+	GetTeacherQualifications(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*TeacherQualifications, error)
+	// SetTeacherQualifications replaces the subjects a teacher may be
+	// assigned to teach.
+	SetTeacherQualifications(ctx context.Context, in *TeacherQualifications, opts ...grpc.CallOption) (*TeacherQualifications, error)
+}
+
+type subjectsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubjectsServiceClient(cc grpc.ClientConnInterface) SubjectsServiceClient {
+	return &subjectsServiceClient{cc}
+}
+
+func (c *subjectsServiceClient) GetSubjects(ctx context.Context, in *GetSubjectsRequest, opts ...grpc.CallOption) (*Subjects, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subjects)
+	err := c.cc.Invoke(ctx, SubjectsService_GetSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) AddSubjects(ctx context.Context, in *Subjects, opts ...grpc.CallOption) (*Subjects, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subjects)
+	err := c.cc.Invoke(ctx, SubjectsService_AddSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) UpdateSubjects(ctx context.Context, in *Subjects, opts ...grpc.CallOption) (*Subjects, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subjects)
+	err := c.cc.Invoke(ctx, SubjectsService_UpdateSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) DeleteSubjects(ctx context.Context, in *SubjectIds, opts ...grpc.CallOption) (*DeleteSubjectsConfirmation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSubjectsConfirmation)
+	err := c.cc.Invoke(ctx, SubjectsService_DeleteSubjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) GetCurriculum(ctx context.Context, in *GetCurriculumRequest, opts ...grpc.CallOption) (*Curriculum, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Curriculum)
+	err := c.cc.Invoke(ctx, SubjectsService_GetCurriculum_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) SetCurriculum(ctx context.Context, in *Curriculum, opts ...grpc.CallOption) (*Curriculum, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Curriculum)
+	err := c.cc.Invoke(ctx, SubjectsService_SetCurriculum_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) GetTeacherQualifications(ctx context.Context, in *TeacherId, opts ...grpc.CallOption) (*TeacherQualifications, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeacherQualifications)
+	err := c.cc.Invoke(ctx, SubjectsService_GetTeacherQualifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subjectsServiceClient) SetTeacherQualifications(ctx context.Context, in *TeacherQualifications, opts ...grpc.CallOption) (*TeacherQualifications, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeacherQualifications)
+	err := c.cc.Invoke(ctx, SubjectsService_SetTeacherQualifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubjectsServiceServer is the server API for SubjectsService service.
+// All implementations must embed UnimplementedSubjectsServiceServer
+// for forward compatibility.
+type SubjectsServiceServer interface {
+	GetSubjects(context.Context, *GetSubjectsRequest) (*Subjects, error)
+	AddSubjects(context.Context, *Subjects) (*Subjects, error)
+	UpdateSubjects(context.Context, *Subjects) (*Subjects, error)
+	// DeleteSubjects refuses subjects still used by a curriculum, a teacher
+	// qualification or a teaching assignment.
+	DeleteSubjects(context.Context, *SubjectIds) (*DeleteSubjectsConfirmation, error)
+	GetCurriculum(context.Context, *GetCurriculumRequest) (*Curriculum, error)
+	// SetCurriculum replaces the subject list of a grade level.
+	SetCurriculum(context.Context, *Curriculum) (*Curriculum, error)
+	GetTeacherQualifications(context.Context, *TeacherId) (*TeacherQualifications, error)
+	// SetTeacherQualifications replaces the subjects a teacher may be
+	// assigned to teach.
+	SetTeacherQualifications(context.Context, *TeacherQualifications) (*TeacherQualifications, error)
+	mustEmbedUnimplementedSubjectsServiceServer()
+}
+
+// UnimplementedSubjectsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSubjectsServiceServer struct{}
+
+func (UnimplementedSubjectsServiceServer) GetSubjects(context.Context, *GetSubjectsRequest) (*Subjects, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubjects not implemented")
+}
+func (UnimplementedSubjectsServiceServer) AddSubjects(context.Context, *Subjects) (*Subjects, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSubjects not implemented")
+}
+func (UnimplementedSubjectsServiceServer) UpdateSubjects(context.Context, *Subjects) (*Subjects, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubjects not implemented")
+}
+func (UnimplementedSubjectsServiceServer) DeleteSubjects(context.Context, *SubjectIds) (*DeleteSubjectsConfirmation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubjects not implemented")
+}
+func (UnimplementedSubjectsServiceServer) GetCurriculum(context.Context, *GetCurriculumRequest) (*Curriculum, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurriculum not implemented")
+}
+func (UnimplementedSubjectsServiceServer) SetCurriculum(context.Context, *Curriculum) (*Curriculum, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCurriculum not implemented")
+}
+func (UnimplementedSubjectsServiceServer) GetTeacherQualifications(context.Context, *TeacherId) (*TeacherQualifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeacherQualifications not implemented")
+}
+func (UnimplementedSubjectsServiceServer) SetTeacherQualifications(context.Context, *TeacherQualifications) (*TeacherQualifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeacherQualifications not implemented")
+}
+func (UnimplementedSubjectsServiceServer) mustEmbedUnimplementedSubjectsServiceServer() {}
+func (UnimplementedSubjectsServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeSubjectsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubjectsServiceServer will
+// result in compilation errors.
+type UnsafeSubjectsServiceServer interface {
+	mustEmbedUnimplementedSubjectsServiceServer()
+}
+
+func RegisterSubjectsServiceServer(s grpc.ServiceRegistrar, srv SubjectsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSubjectsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SubjectsService_ServiceDesc, srv)
+}
+
+func _SubjectsService_GetSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).GetSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_GetSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).GetSubjects(ctx, req.(*GetSubjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_AddSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Subjects)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).AddSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_AddSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).AddSubjects(ctx, req.(*Subjects))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_UpdateSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Subjects)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).UpdateSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_UpdateSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).UpdateSubjects(ctx, req.(*Subjects))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_DeleteSubjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).DeleteSubjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_DeleteSubjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).DeleteSubjects(ctx, req.(*SubjectIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_GetCurriculum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurriculumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).GetCurriculum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_GetCurriculum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).GetCurriculum(ctx, req.(*GetCurriculumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_SetCurriculum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Curriculum)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).SetCurriculum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_SetCurriculum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).SetCurriculum(ctx, req.(*Curriculum))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_GetTeacherQualifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeacherId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).GetTeacherQualifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_GetTeacherQualifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).GetTeacherQualifications(ctx, req.(*TeacherId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubjectsService_SetTeacherQualifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeacherQualifications)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubjectsServiceServer).SetTeacherQualifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubjectsService_SetTeacherQualifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubjectsServiceServer).SetTeacherQualifications(ctx, req.(*TeacherQualifications))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubjectsService_ServiceDesc is the grpc.ServiceDesc for SubjectsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubjectsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "main.SubjectsService",
+	HandlerType: (*SubjectsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSubjects",
+			Handler:    _SubjectsService_GetSubjects_Handler,
+		},
+		{
+			MethodName: "AddSubjects",
+			Handler:    _SubjectsService_AddSubjects_Handler,
+		},
+		{
+			MethodName: "UpdateSubjects",
+			Handler:    _SubjectsService_UpdateSubjects_Handler,
+		},
+		{
+			MethodName: "DeleteSubjects",
+			Handler:    _SubjectsService_DeleteSubjects_Handler,
+		},
+		{
+			MethodName: "GetCurriculum",
+			Handler:    _SubjectsService_GetCurriculum_Handler,
+		},
+		{
+			MethodName: "SetCurriculum",
+			Handler:    _SubjectsService_SetCurriculum_Handler,
+		},
+		{
+			MethodName: "GetTeacherQualifications",
+			Handler:    _SubjectsService_GetTeacherQualifications_Handler,
+		},
+		{
+			MethodName: "SetTeacherQualifications",
+			Handler:    _SubjectsService_SetTeacherQualifications_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "subjects.proto",
+}